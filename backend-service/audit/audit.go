@@ -0,0 +1,244 @@
+// Package audit keeps an append-only, hash-chained log of who viewed or
+// changed what, for access accounting
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Audited actions
+const (
+	ActionConnect         = "CONNECT"
+	ActionSessionJoin     = "SESSION_JOIN"
+	ActionMessageSend     = "MESSAGE_SEND"
+	ActionDraftView       = "DRAFT_VIEW"
+	ActionDraftReview     = "DRAFT_REVIEW"
+	ActionSessionTransfer = "SESSION_TRANSFER"
+	ActionAdmin           = "ADMIN_ACTION"
+	ActionPatientErase    = "PATIENT_ERASE"
+	ActionFHIRImport      = "FHIR_IMPORT"
+	ActionFHIRExport      = "FHIR_EXPORT"
+	ActionTrainingExport  = "TRAINING_EXPORT"
+)
+
+// Actor roles
+const (
+	RolePatient = "patient"
+	RoleDoctor  = "doctor"
+	RoleAdmin   = "admin"
+	RoleSystem  = "system"
+)
+
+// verifyBatchSize is the number of events Verify loads at a time
+const verifyBatchSize = 1000
+
+// genesisHash is the prev_hash of the first event
+var genesisHash = make([]byte, sha256.Size)
+
+// Event is an entry of the audit log. Details must not hold message
+// content or other PHI, only what is needed to account for the access.
+type Event struct {
+	Seq        int64
+	OccurredAt time.Time
+	Action     string
+	ActorRole  string
+	ActorID    string
+	PatientID  pgtype.UUID // Invalid when no patient is involved
+	SessionID  string
+	Resource   string
+	Details    map[string]string
+	PrevHash   []byte
+	Hash       []byte
+}
+
+// Log appends events to the audit_events table
+type Log struct {
+	db *pgxpool.Pool
+	q  *db.Queries
+}
+
+func NewLog(pool *pgxpool.Pool) *Log {
+	return &Log{db: pool, q: db.New(pool)}
+}
+
+// Append stores events at the end of the chain, in order, and returns them
+// with their sequence numbers, times and hashes set. Appends are serialized
+// with an advisory lock so the chain stays linear across backend instances;
+// a batch takes it once.
+func (l *Log) Append(ctx context.Context, events ...Event) ([]Event, error) {
+	details := make([][]byte, len(events))
+	for i := range events {
+		if events[i].Details == nil {
+			events[i].Details = map[string]string{}
+		}
+		var err error
+		if details[i], err = json.Marshal(events[i].Details); err != nil {
+			return events, fmt.Errorf("failed to encode details: %v", err)
+		}
+	}
+
+	tx, err := l.db.Begin(ctx)
+	if err != nil {
+		return events, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	q := l.q.WithTx(tx)
+
+	if err := q.LockAuditLog(ctx); err != nil {
+		return events, fmt.Errorf("failed to lock audit log: %v", err)
+	}
+	prevHash := genesisHash
+	last, err := q.GetLastAuditEvent(ctx)
+	switch {
+	case err == nil:
+		prevHash = last.Hash
+	case !errors.Is(err, pgx.ErrNoRows):
+		return events, fmt.Errorf("failed to load last audit event: %v", err)
+	}
+
+	// Postgres keeps microseconds, hash what will be read back
+	chain(prevHash, time.Now().UTC().Truncate(time.Microsecond), events)
+	for i := range events {
+		e := &events[i]
+		e.Seq, err = q.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+			OccurredAt: pgtype.Timestamptz{Time: e.OccurredAt, Valid: true},
+			Action:     e.Action,
+			ActorRole:  e.ActorRole,
+			ActorID:    e.ActorID,
+			PatientID:  e.PatientID,
+			SessionID:  e.SessionID,
+			Resource:   e.Resource,
+			Details:    details[i],
+			PrevHash:   e.PrevHash,
+			Hash:       e.Hash,
+		})
+		if err != nil {
+			return events, fmt.Errorf("failed to store audit event: %v", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return events, fmt.Errorf("failed to commit audit events: %v", err)
+	}
+	return events, nil
+}
+
+// chain links events, occurring at at, after the event whose hash is prev
+func chain(prev []byte, at time.Time, events []Event) {
+	for i := range events {
+		events[i].PrevHash = prev
+		events[i].OccurredAt = at
+		events[i].Hash = Hash(events[i])
+		prev = events[i].Hash
+	}
+}
+
+// Hash returns the SHA-256 of e's predecessor's hash and e's fields, each
+// prefixed with its length
+func Hash(e Event) []byte {
+	patientID := ""
+	if e.PatientID.Valid {
+		patientID = pg.ToUUID(e.PatientID).String()
+	}
+	// Sorted keys, so the details read back from JSONB hash the same
+	details, _ := json.Marshal(e.Details)
+
+	h := sha256.New()
+	h.Write(e.PrevHash)
+	for _, field := range []string{
+		e.OccurredAt.UTC().Format(time.RFC3339Nano),
+		e.Action, e.ActorRole, e.ActorID, patientID, e.SessionID, e.Resource, string(details),
+	} {
+		var n [4]byte
+		binary.BigEndian.PutUint32(n[:], uint32(len(field)))
+		h.Write(n[:])
+		h.Write([]byte(field))
+	}
+	return h.Sum(nil)
+}
+
+// FromRow converts a stored event
+func FromRow(row db.AuditEvent) (Event, error) {
+	e := Event{
+		Seq:        row.Seq,
+		OccurredAt: row.OccurredAt.Time.UTC(),
+		Action:     row.Action,
+		ActorRole:  row.ActorRole,
+		ActorID:    row.ActorID,
+		PatientID:  row.PatientID,
+		SessionID:  row.SessionID,
+		Resource:   row.Resource,
+		PrevHash:   row.PrevHash,
+		Hash:       row.Hash,
+	}
+	if err := json.Unmarshal(row.Details, &e.Details); err != nil {
+		return e, fmt.Errorf("invalid details of audit event %d: %v", row.Seq, err)
+	}
+	return e, nil
+}
+
+// checkLink returns why e does not follow the event whose hash is prev in the
+// chain, or "" if it does
+func checkLink(prev []byte, e Event) string {
+	switch {
+	case !bytes.Equal(e.PrevHash, prev):
+		return fmt.Sprintf("prev_hash %s does not match the hash of the event before it", hex.EncodeToString(e.PrevHash))
+	case !bytes.Equal(Hash(e), e.Hash):
+		return "hash does not match the event's contents"
+	}
+	return ""
+}
+
+// VerifyResult reports on the integrity of the chain
+type VerifyResult struct {
+	Checked         int64
+	Valid           bool
+	FirstInvalidSeq int64 // Set when the chain is broken
+	Reason          string
+}
+
+// Verify recomputes the hash chain from the first event. An event whose
+// contents were changed, or whose predecessor was removed, breaks it.
+func (l *Log) Verify(ctx context.Context) (VerifyResult, error) {
+	result := VerifyResult{Valid: true}
+	prev := genesisHash
+	var after int64
+	for {
+		rows, err := l.q.ListAuditEventsAfter(ctx, db.ListAuditEventsAfterParams{Seq: after, Limit: verifyBatchSize})
+		if err != nil {
+			return result, fmt.Errorf("failed to load audit events: %v", err)
+		}
+		for _, row := range rows {
+			result.Checked++
+			e, err := FromRow(row)
+			if err != nil {
+				result.Reason = err.Error()
+			} else {
+				result.Reason = checkLink(prev, e)
+			}
+			if result.Reason != "" {
+				result.Valid = false
+				result.FirstInvalidSeq = row.Seq
+				return result, nil
+			}
+			prev, after = e.Hash, e.Seq
+		}
+		if len(rows) < verifyBatchSize {
+			return result, nil
+		}
+	}
+}
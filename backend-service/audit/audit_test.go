@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// testChain returns n linked events, as Append would store them
+func testChain(n int) []Event {
+	patient := pg.NewUUID()
+	events := make([]Event, n)
+	for i := range events {
+		events[i] = Event{
+			Seq:       int64(i + 1),
+			Action:    ActionMessageSend,
+			ActorRole: RoleDoctor,
+			ActorID:   "doctor:1f2e3d4c5b6a7980",
+			PatientID: patient,
+			SessionID: "session-1",
+			Details:   map[string]string{"message_type": "DOCTOR"},
+		}
+	}
+	chain(genesisHash, time.Date(2024, 6, 1, 12, 0, 0, 123456000, time.UTC), events)
+	return events
+}
+
+// verify checks events as Verify does, returning the first broken sequence
+// number and why, or 0
+func verify(events []Event) (int64, string) {
+	prev := genesisHash
+	for _, e := range events {
+		if reason := checkLink(prev, e); reason != "" {
+			return e.Seq, reason
+		}
+		prev = e.Hash
+	}
+	return 0, ""
+}
+
+func TestVerifyChain(t *testing.T) {
+	tests := []struct {
+		name      string
+		tamper    func([]Event) []Event
+		wantFirst int64
+	}{
+		{"intact", func(e []Event) []Event { return e }, 0},
+		{"action changed", func(e []Event) []Event { e[2].Action = ActionDraftView; return e }, 3},
+		{"actor changed", func(e []Event) []Event { e[1].ActorID = "doctor:0000000000000000"; return e }, 2},
+		{"patient removed", func(e []Event) []Event { e[0].PatientID = pgtype.UUID{}; return e }, 1},
+		{"details changed", func(e []Event) []Event { e[3].Details["message_type"] = "PATIENT"; return e }, 4},
+		{"time changed", func(e []Event) []Event { e[4].OccurredAt = e[4].OccurredAt.Add(time.Second); return e }, 5},
+		{"event removed", func(e []Event) []Event { return append(e[:2:2], e[3:]...) }, 4},
+		{"events swapped", func(e []Event) []Event { e[1], e[2] = e[2], e[1]; return e }, 3},
+		{"rehashed without relinking", func(e []Event) []Event {
+			e[2].ActorID = "someone else"
+			e[2].Hash = Hash(e[2])
+			return e
+		}, 4},
+		{"first event not genesis", func(e []Event) []Event { return e[1:] }, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, reason := verify(tt.tamper(testChain(5)))
+			if first != tt.wantFirst {
+				t.Fatalf("first invalid event = %d (%s), want %d", first, reason, tt.wantFirst)
+			}
+		})
+	}
+}
+
+func TestHashIsStableForDetailOrder(t *testing.T) {
+	e := testChain(1)[0]
+	e.Details = map[string]string{"a": "1", "b": "2", "c": "3"}
+	want := Hash(e)
+	for i := 0; i < 10; i++ {
+		e.Details = map[string]string{"c": "3", "b": "2", "a": "1"}
+		if got := Hash(e); !bytes.Equal(got, want) {
+			t.Fatal("Hash() depends on the order details were added")
+		}
+	}
+}
+
+func TestHashSeparatesFields(t *testing.T) {
+	// Length prefixes keep field boundaries from shifting
+	a := testChain(1)[0]
+	a.ActorRole, a.ActorID = "doctor", "x"
+	b := a
+	b.ActorRole, b.ActorID = "docto", "rx"
+	if bytes.Equal(Hash(a), Hash(b)) {
+		t.Fatal("Hash() is the same when a field boundary moves")
+	}
+}
+
+func TestFromRowVerifies(t *testing.T) {
+	events := testChain(3)
+	prev := genesisHash
+	for _, e := range events {
+		details, err := json.Marshal(e.Details)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// What Postgres hands back: microseconds, JSONB details
+		got, err := FromRow(db.AuditEvent{
+			Seq:        e.Seq,
+			OccurredAt: pgtype.Timestamptz{Time: e.OccurredAt.In(time.FixedZone("CEST", 2*3600)), Valid: true},
+			Action:     e.Action,
+			ActorRole:  e.ActorRole,
+			ActorID:    e.ActorID,
+			PatientID:  e.PatientID,
+			SessionID:  e.SessionID,
+			Resource:   e.Resource,
+			Details:    details,
+			PrevHash:   e.PrevHash,
+			Hash:       e.Hash,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reason := checkLink(prev, got); reason != "" {
+			t.Fatalf("event %d read back does not verify: %s", e.Seq, reason)
+		}
+		prev = got.Hash
+	}
+}
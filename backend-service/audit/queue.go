@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"llm-qa-system/backend-service/logging"
+)
+
+const (
+	// maxBatch is the most events appended in one transaction
+	maxBatch = 100
+	// appendTimeout bounds one append to the log
+	appendTimeout = 5 * time.Second
+)
+
+// Queue appends events to a Log in the background, so the actions they
+// record do not wait on the log's lock. Events queued together are appended
+// in one batch. When the queue is full, Record appends synchronously rather
+// than drop the event.
+type Queue struct {
+	store  func(ctx context.Context, events ...Event) ([]Event, error)
+	events chan Event
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewQueue starts appending events recorded on the queue to log. size
+// bounds the events waiting.
+func NewQueue(log *Log, size int) *Queue {
+	return newQueue(log.Append, size)
+}
+
+func newQueue(store func(ctx context.Context, events ...Event) ([]Event, error), size int) *Queue {
+	q := &Queue{store: store, events: make(chan Event, size), done: make(chan struct{})}
+	go q.run()
+	return q
+}
+
+// Record queues e for the log. A failure to append is logged and does not
+// fail the action being audited.
+func (q *Queue) Record(e Event) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if !q.closed {
+		select {
+		case q.events <- e:
+			return
+		default:
+			slog.Warn("audit queue full, appending synchronously", "action", e.Action)
+		}
+	}
+	q.append([]Event{e})
+}
+
+// Close appends the events still queued, until ctx expires. Events recorded
+// afterwards are appended synchronously.
+func (q *Queue) Close(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.events)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *Queue) run() {
+	defer close(q.done)
+	for e := range q.events {
+		q.append(q.batch(e))
+	}
+}
+
+// batch returns first with the events queued behind it
+func (q *Queue) batch(first Event) []Event {
+	batch := []Event{first}
+	for len(batch) < maxBatch {
+		select {
+		case e, ok := <-q.events:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, e)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (q *Queue) append(events []Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), appendTimeout)
+	defer cancel()
+
+	if _, err := q.store(ctx, events...); err != nil {
+		for _, e := range events {
+			slog.Error("failed to record audit event", "action", e.Action, logging.SessionID(e.SessionID), logging.Err(err))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// store records the batches appended, blocking each until release is closed
+type store struct {
+	mu      sync.Mutex
+	batches [][]Event
+	release chan struct{}
+	started chan struct{} // Receives when an append begins
+}
+
+func newStore() *store {
+	return &store{release: make(chan struct{}), started: make(chan struct{}, 100)}
+}
+
+func (s *store) append(ctx context.Context, events ...Event) ([]Event, error) {
+	s.started <- struct{}{}
+	<-s.release
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, append([]Event(nil), events...))
+	return events, nil
+}
+
+func (s *store) actions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var actions []string
+	for _, batch := range s.batches {
+		for _, e := range batch {
+			actions = append(actions, e.Action)
+		}
+	}
+	return actions
+}
+
+func TestQueueBatchesInOrder(t *testing.T) {
+	s := newStore()
+	q := newQueue(s.append, 10)
+
+	// The first event is taken alone, the rest queue up behind it
+	q.Record(Event{Action: "1"})
+	<-s.started
+	for _, action := range []string{"2", "3", "4"} {
+		q.Record(Event{Action: action})
+	}
+	close(s.release)
+	if err := q.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := s.actions(); len(got) != 4 || got[0] != "1" || got[1] != "2" || got[2] != "3" || got[3] != "4" {
+		t.Fatalf("appended %v, want [1 2 3 4]", got)
+	}
+	if len(s.batches) != 2 || len(s.batches[1]) != 3 {
+		t.Fatalf("appended in batches of %v, want 1 then 3", batchSizes(s.batches))
+	}
+}
+
+func TestQueueFullAppendsSynchronously(t *testing.T) {
+	s := newStore()
+	q := newQueue(s.append, 1)
+
+	q.Record(Event{Action: "taken"})
+	<-s.started
+	q.Record(Event{Action: "queued"})
+
+	// The queue is full: Record waits for its own append instead of dropping
+	done := make(chan struct{})
+	go func() {
+		q.Record(Event{Action: "overflow"})
+		close(done)
+	}()
+	<-s.started
+	select {
+	case <-done:
+		t.Fatal("Record() returned before its event was appended")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(s.release)
+	<-done
+	if err := q.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.actions(); len(got) != 3 {
+		t.Fatalf("appended %v, want all 3 events", got)
+	}
+}
+
+func TestQueueClose(t *testing.T) {
+	s := newStore()
+	q := newQueue(s.append, 10)
+	q.Record(Event{Action: "in flight"})
+	<-s.started
+
+	// Close gives up at the deadline while an append is stuck
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Close() = %v, want the deadline", err)
+	}
+
+	close(s.release)
+	if err := q.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// Events recorded after Close are still appended
+	q.Record(Event{Action: "late"})
+	if got := s.actions(); len(got) != 2 || got[1] != "late" {
+		t.Fatalf("appended %v, want the late event too", got)
+	}
+}
+
+func batchSizes(batches [][]Event) []int {
+	sizes := make([]int, len(batches))
+	for i, batch := range batches {
+		sizes[i] = len(batch)
+	}
+	return sizes
+}
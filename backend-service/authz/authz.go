@@ -0,0 +1,115 @@
+// Package authz decides what each role may do, on the WebSocket and in the
+// gRPC services
+package authz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Role is the kind of participant or API client
+type Role string
+
+const (
+	RolePatient    Role = "patient"
+	RoleNurse      Role = "nurse"
+	RoleDoctor     Role = "doctor"
+	RoleSupervisor Role = "supervisor"
+	RoleAdmin      Role = "admin"
+	RoleDevice     Role = "device" // Wearables sending biometrics
+)
+
+// Permission is an action a role may be allowed
+type Permission string
+
+const (
+	SendPatientMessage   Permission = "patient_message:send"
+	TakeSessions         Permission = "session:take"    // Go on duty, join sessions, set availability
+	ReviewDraft          Permission = "draft:review"    // Accept or reject drafts
+	SendFreeText         Permission = "free_text:send"  // Doctor messages and modified drafts
+	HandOffSession       Permission = "session:handoff" // Hand one's own session to another doctor
+	ObserveSession       Permission = "session:observe"
+	ReassignSession      Permission = "session:reassign" // Hand off a session one observes
+	ManagePatients       Permission = "patients:manage"
+	ManageDoctors        Permission = "doctors:manage"
+	ManageMedicalHistory Permission = "medical_history:manage"
+	ManageTemplates      Permission = "templates:manage"
+	ReadAnalytics        Permission = "analytics:read"
+	ReadAuditLog         Permission = "audit:read"
+	IngestBiometrics     Permission = "biometrics:ingest"
+)
+
+// policy holds the permissions of each role
+var policy = map[Role][]Permission{
+	RolePatient: {SendPatientMessage},
+	RoleNurse:   {TakeSessions, ReviewDraft, HandOffSession},
+	RoleDoctor:  {TakeSessions, ReviewDraft, SendFreeText, HandOffSession},
+	RoleSupervisor: {
+		TakeSessions, ReviewDraft, SendFreeText, HandOffSession,
+		ObserveSession, ReassignSession, ReadAnalytics,
+	},
+	RoleAdmin: {
+		ManagePatients, ManageDoctors, ManageMedicalHistory, ManageTemplates,
+		ReadAnalytics, ReadAuditLog,
+	},
+	RoleDevice: {IngestBiometrics},
+}
+
+// ParseRole returns the role named s
+func ParseRole(s string) (Role, error) {
+	role := Role(s)
+	if _, ok := policy[role]; !ok {
+		return "", fmt.Errorf("invalid role: %s", s)
+	}
+	return role, nil
+}
+
+// Can reports whether the role has the permission
+func (r Role) Can(p Permission) bool {
+	for _, granted := range policy[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStaff reports whether the role answers patients: nurses, doctors and
+// supervisors take the doctor's place in a session
+func (r Role) IsStaff() bool {
+	return r.Can(TakeSessions)
+}
+
+// Principal is the authenticated caller of an RPC
+type Principal struct {
+	Role Role
+	ID   string // Role and token fingerprint, e.g. "admin:1f2e3d4c5b6a7980"
+}
+
+// PrincipalID identifies the bearer of an authenticated token in the audit
+// log, without revealing the token
+func PrincipalID(role Role, token string) string {
+	return string(role) + ":" + tokenFingerprint(token)
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying p
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal the interceptor stored in ctx
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// tokenFingerprint returns a short hash that tells tokens apart in logs and
+// the audit log without revealing them
+func tokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:8])
+}
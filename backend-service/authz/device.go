@@ -0,0 +1,53 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// deviceTokens are signed with auth.device_token_secret
+var deviceTokens = tokenKind{name: "device", prefix: "dv1."}
+
+// IssueDeviceToken returns a credential a wearable sends the readings of
+// patientID with until expires, signed with auth.device_token_secret.
+// cmd/device-token issues them.
+func IssueDeviceToken(secret []byte, patientID string, expires time.Time) string {
+	return deviceTokens.issue(secret, []string{patientID}, expires)
+}
+
+// device verifies a device token and returns the patient it is bound to
+func (a *Authenticator) device(token string) (uuid.UUID, error) {
+	claims, err := deviceTokens.verify(a.deviceSecret, token, 1)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	patientID, err := uuid.Parse(claims[0])
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid patient id in token: %v", err)
+	}
+	return patientID, nil
+}
+
+// DevicePatient checks that token may send the readings of patientID. A
+// device token is bound to one patient. While InsecureDev leaves the device
+// role open, any token may send readings of any patient.
+func (a *Authenticator) DevicePatient(token, patientID string) error {
+	if !strings.HasPrefix(token, deviceTokens.prefix) {
+		if a.open[RoleDevice] {
+			return nil
+		}
+		return errors.New("not a device token")
+	}
+	bound, err := a.device(token)
+	if err != nil {
+		return err
+	}
+	if claimed, err := uuid.Parse(patientID); err != nil || claimed != bound {
+		return errors.New("patient_id is not the token's patient")
+	}
+	return nil
+}
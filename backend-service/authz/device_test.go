@@ -0,0 +1,60 @@
+package authz
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDevicePatient(t *testing.T) {
+	const patientID = "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11"
+	const otherID = "0d7e6c1a-1111-4c8e-8f7e-2f0f8d9b1c11"
+	a, err := NewAuthenticator(fullAuthConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := []byte(testDeviceSecret)
+
+	tests := []struct {
+		name      string
+		token     string
+		patientID string
+		wantErr   string
+	}{
+		{"bound patient", testDeviceToken, patientID, ""},
+		{"bound patient in upper case", testDeviceToken, strings.ToUpper(patientID), ""},
+		{"other patient", testDeviceToken, otherID, "not the token's patient"},
+		{"invalid patient_id", testDeviceToken, "patient-1", "not the token's patient"},
+		{"other secret", IssueDeviceToken([]byte(strings.Repeat("x", 32)), patientID, time.Now().Add(time.Hour)), patientID, "signature"},
+		{"expired", IssueDeviceToken(secret, patientID, time.Now().Add(-time.Minute)), patientID, "expired"},
+		{"patient token", IssuePatientToken(secret, patientID, time.Now().Add(time.Hour)), patientID, "not a device token"},
+		{"listed token", "admin-token", patientID, "not a device token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.DevicePatient(tt.token, tt.patientID)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("DevicePatient() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("DevicePatient() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDevicePatientInsecureDev(t *testing.T) {
+	cfg := fullAuthConfig()
+	cfg.DeviceTokenSecret = ""
+	cfg.InsecureDev = true
+	a, err := NewAuthenticator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.DevicePatient("anything", "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11"); err != nil {
+		t.Errorf("DevicePatient() error = %v, want any token accepted", err)
+	}
+}
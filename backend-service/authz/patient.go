@@ -0,0 +1,31 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// patientTokens are signed with auth.patient_token_secret
+var patientTokens = tokenKind{name: "patient", prefix: "pt1."}
+
+// IssuePatientToken returns a credential proving its bearer is patientID
+// until expires, signed with auth.patient_token_secret. Whatever
+// authenticates patients issues it; cmd/patient-token does for testing.
+func IssuePatientToken(secret []byte, patientID string, expires time.Time) string {
+	return patientTokens.issue(secret, []string{patientID}, expires)
+}
+
+// PatientID verifies a patient token and returns the patient's user ID
+func (a *Authenticator) PatientID(token string) (string, error) {
+	claims, err := patientTokens.verify(a.patientSecret, token, 1)
+	if err != nil {
+		return "", err
+	}
+	patientID := claims[0]
+	if _, err := uuid.Parse(patientID); err != nil {
+		return "", fmt.Errorf("invalid patient id in token: %v", err)
+	}
+	return patientID, nil
+}
@@ -0,0 +1,69 @@
+package authz
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPatientID(t *testing.T) {
+	const patientID = "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11"
+	secret := []byte(strings.Repeat("s", 32))
+	cfg := fullAuthConfig()
+	cfg.PatientTokenSecret = string(secret)
+	a, err := NewAuthenticator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := IssuePatientToken(secret, patientID, time.Now().Add(time.Hour))
+
+	tampered := func(token string) string {
+		// Claim another patient under the original signature
+		_, signature, _ := strings.Cut(strings.TrimPrefix(token, patientTokens.prefix), ".")
+		other := IssuePatientToken(secret, "0d7e6c1a-1111-4c8e-8f7e-2f0f8d9b1c11", time.Now().Add(time.Hour))
+		payload, _, _ := strings.Cut(strings.TrimPrefix(other, patientTokens.prefix), ".")
+		return patientTokens.prefix + payload + "." + signature
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"valid", valid, ""},
+		{"other secret", IssuePatientToken([]byte(strings.Repeat("x", 32)), patientID, time.Now().Add(time.Hour)), "signature"},
+		{"expired", IssuePatientToken(secret, patientID, time.Now().Add(-time.Minute)), "expired"},
+		{"tampered patient", tampered(valid), "signature"},
+		{"not a uuid", IssuePatientToken(secret, "patient-1", time.Now().Add(time.Hour)), "invalid patient id"},
+		{"staff token", "doctor-token", "not a patient token"},
+		{"bare patient id", patientID, "not a patient token"},
+		{"missing signature", strings.SplitN(valid, ".", 3)[0] + "." + strings.SplitN(valid, ".", 3)[1], "malformed"},
+		{"empty", "", "not a patient token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.PatientID(tt.token)
+			if tt.wantErr == "" {
+				if err != nil || got != patientID {
+					t.Fatalf("PatientID() = %q, %v, want %q", got, err, patientID)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("PatientID() = %q, %v, want an error containing %q", got, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPatientIDWithoutSecret(t *testing.T) {
+	a, err := NewAuthenticator(fullAuthConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Any token, even one signed with an empty secret, is refused
+	token := IssuePatientToken(nil, "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11", time.Now().Add(time.Hour))
+	if _, err := a.PatientID(token); err == nil {
+		t.Fatal("PatientID() succeeded without a configured secret")
+	}
+}
@@ -0,0 +1,107 @@
+package authz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+)
+
+func TestStaffID(t *testing.T) {
+	const doctorID = "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11"
+	const otherID = "0d7e6c1a-1111-4c8e-8f7e-2f0f8d9b1c11"
+	secret := []byte(strings.Repeat("s", 32))
+	cfg := fullAuthConfig()
+	cfg.StaffTokenSecret = string(secret)
+	a, err := NewAuthenticator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := IssueStaffToken(secret, RoleDoctor, doctorID, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		role    Role
+		token   string
+		claimed string
+		wantErr string
+	}{
+		{"signed", RoleDoctor, valid, "", ""},
+		{"signed with its own id claimed", RoleDoctor, valid, doctorID, ""},
+		{"signed with another id claimed", RoleDoctor, valid, otherID, "not the token's user"},
+		{"other role", RoleSupervisor, valid, "", "role doctor"},
+		{"other secret", RoleDoctor, IssueStaffToken([]byte(strings.Repeat("x", 32)), RoleDoctor, doctorID, time.Now().Add(time.Hour)), "", "signature"},
+		{"expired", RoleDoctor, IssueStaffToken(secret, RoleDoctor, doctorID, time.Now().Add(-time.Minute)), "", "expired"},
+		{"not staff", RoleDoctor, IssueStaffToken(secret, RoleAdmin, doctorID, time.Now().Add(time.Hour)), "", "invalid staff role"},
+		{"not a uuid", RoleDoctor, IssueStaffToken(secret, RoleDoctor, "doctor-1", time.Now().Add(time.Hour)), "", "invalid user id"},
+		{"patient token", RoleDoctor, IssuePatientToken(secret, doctorID, time.Now().Add(time.Hour)), doctorID, "invalid doctor token"},
+		{"listed token", RoleDoctor, "doctor-token", doctorID, "carries no user id"},
+		{"listed token of another role", RoleDoctor, "nurse-token", doctorID, "invalid doctor token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.StaffID(tt.role, tt.token, tt.claimed)
+			if tt.wantErr == "" {
+				if err != nil || got != doctorID {
+					t.Fatalf("StaffID() = %q, %v, want %q", got, err, doctorID)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("StaffID() = %q, %v, want an error containing %q", got, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStaffIDInsecureDev(t *testing.T) {
+	a, err := NewAuthenticator(config.AuthConfig{DoctorTokens: []string{"doctor-token"}, InsecureDev: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const doctorID = "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11"
+
+	tests := []struct {
+		name    string
+		role    Role
+		token   string
+		claimed string
+		wantErr bool
+	}{
+		{"open role takes the claimed id", RoleNurse, "any", doctorID, false},
+		{"open role without an id", RoleNurse, "any", "", true},
+		{"open role with an invalid id", RoleNurse, "any", "doctor-1", true},
+		{"listed token still carries no id", RoleDoctor, "doctor-token", doctorID, true},
+		{"unsigned staff token without a secret", RoleNurse, IssueStaffToken(nil, RoleNurse, doctorID, time.Now().Add(time.Hour)), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.StaffID(tt.role, tt.token, tt.claimed)
+			if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.claimed) {
+				t.Fatalf("StaffID() = %q, %v, want error %v", got, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorizeSignedStaffToken(t *testing.T) {
+	secret := []byte(strings.Repeat("s", 32))
+	cfg := fullAuthConfig()
+	cfg.StaffTokenSecret = string(secret)
+	a, err := NewAuthenticator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := IssueStaffToken(secret, RoleSupervisor, "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11", time.Now().Add(time.Hour))
+
+	if p, err := a.Authorize(token, ReadAnalytics); err != nil || p.Role != RoleSupervisor {
+		t.Fatalf("Authorize() = %+v, %v, want a supervisor", p, err)
+	}
+	if _, err := a.Authorize(token, ManagePatients); err == nil {
+		t.Fatal("Authorize() granted a supervisor token an admin permission")
+	}
+	if !a.Authenticate(RoleSupervisor, token) || a.Authenticate(RoleDoctor, token) {
+		t.Fatal("Authenticate() did not match the signed token's role")
+	}
+}
@@ -0,0 +1,225 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"llm-qa-system/backend-service/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tokenRoles is the order roles are tried in for a token that is not
+// configured, when several open roles have the permission asked for. Roles
+// are only open with InsecureDev.
+var tokenRoles = []Role{RoleAdmin, RoleDevice, RoleSupervisor, RoleDoctor, RoleNurse}
+
+// Authenticator maps bearer tokens to roles
+type Authenticator struct {
+	roles map[string]Role
+	open  map[Role]bool // Roles accepting any non-empty token, only with InsecureDev
+	// Signs patient tokens; patients connect anonymously without it
+	patientSecret []byte
+	// Signs staff tokens, the only ones that say which staff member connects
+	staffSecret []byte
+	// Signs device tokens, each bound to the patient whose readings it sends
+	deviceSecret []byte
+}
+
+// NewAuthenticator builds the token table of cfg. Every role needs tokens,
+// devices a secret to sign theirs: a role without any is refused, unless
+// cfg.InsecureDev is set for local development, where it accepts any
+// non-empty token.
+func NewAuthenticator(cfg config.AuthConfig) (*Authenticator, error) {
+	a := &Authenticator{
+		roles:         make(map[string]Role),
+		open:          make(map[Role]bool),
+		patientSecret: []byte(cfg.PatientTokenSecret),
+		staffSecret:   []byte(cfg.StaffTokenSecret),
+		deviceSecret:  []byte(cfg.DeviceTokenSecret),
+	}
+	if cfg.PatientTokenSecret == "" {
+		slog.Warn("no patient token secret configured, patients can only connect anonymously")
+	}
+	if cfg.StaffTokenSecret == "" && !cfg.InsecureDev {
+		slog.Warn("no staff token secret configured, staff cannot connect to sessions")
+	}
+	lists := []struct {
+		role   Role
+		name   string
+		tokens []string
+	}{
+		{RoleNurse, "auth.nurse_tokens", cfg.NurseTokens},
+		{RoleDoctor, "auth.doctor_tokens", cfg.DoctorTokens},
+		{RoleSupervisor, "auth.supervisor_tokens", cfg.SupervisorTokens},
+		{RoleAdmin, "auth.admin_tokens", cfg.AdminTokens},
+	}
+
+	var missing []string
+	for _, list := range lists {
+		for _, token := range list.tokens {
+			a.roles[token] = list.role
+		}
+		if len(list.tokens) == 0 {
+			missing = append(missing, list.name)
+			a.open[list.role] = true
+		}
+	}
+	if cfg.DeviceTokenSecret == "" {
+		missing = append(missing, "auth.device_token_secret")
+		a.open[RoleDevice] = true
+	}
+	if len(missing) == 0 {
+		return a, nil
+	}
+	if !cfg.InsecureDev {
+		return nil, fmt.Errorf("no tokens configured in %s: configure tokens for every role, or start with -insecure-dev for local development", strings.Join(missing, ", "))
+	}
+	for _, name := range missing {
+		slog.Warn("INSECURE: running with -insecure-dev, any non-empty token is accepted for the role", "list", name)
+	}
+	return a, nil
+}
+
+// Authenticate reports whether token may connect as role
+func (a *Authenticator) Authenticate(role Role, token string) bool {
+	if token == "" {
+		return false
+	}
+	if signed, ok, err := a.signed(token); ok {
+		return err == nil && signed == role
+	}
+	if configured, ok := a.roles[token]; ok {
+		return configured == role
+	}
+	return a.open[role]
+}
+
+// Authorize returns the principal of token if its role has perm
+func (a *Authenticator) Authorize(token string, perm Permission) (Principal, error) {
+	if token == "" {
+		return Principal{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	role, ok := a.roles[token]
+	if !ok {
+		signed, isSigned, err := a.signed(token)
+		if err != nil {
+			return Principal{}, status.Error(codes.Unauthenticated, "invalid bearer token")
+		}
+		role, ok = signed, isSigned
+	}
+	if !ok {
+		for _, r := range tokenRoles {
+			if a.open[r] && r.Can(perm) {
+				role, ok = r, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return Principal{}, status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	if !role.Can(perm) {
+		return Principal{}, status.Errorf(codes.PermissionDenied, "role %s does not have permission %s", role, perm)
+	}
+	return Principal{Role: role, ID: PrincipalID(role, token)}, nil
+}
+
+// signed verifies a signed staff or device token and returns its role. ok
+// is false for tokens of neither kind.
+func (a *Authenticator) signed(token string) (role Role, ok bool, err error) {
+	switch {
+	case strings.HasPrefix(token, staffTokens.prefix):
+		role, _, err = a.staff(token)
+		return role, true, err
+	case strings.HasPrefix(token, deviceTokens.prefix):
+		_, err = a.device(token)
+		return RoleDevice, true, err
+	}
+	return "", false, nil
+}
+
+// MethodPermissions maps full gRPC method names to the permission they
+// require. Services listed in Public need none.
+type MethodPermissions struct {
+	Methods map[string]Permission
+	Public  []string // Service names, e.g. "grpc.health.v1.Health"
+}
+
+// check authorizes the caller of method, returning ctx with its principal
+func (a *Authenticator) check(ctx context.Context, perms MethodPermissions, method string) (context.Context, error) {
+	perm, ok := perms.Methods[method]
+	if !ok {
+		for _, service := range perms.Public {
+			if strings.HasPrefix(method, "/"+service+"/") {
+				return ctx, nil
+			}
+		}
+		// Deny RPCs added without a permission
+		return nil, status.Errorf(codes.PermissionDenied, "no permission is defined for %s", method)
+	}
+
+	p, err := a.Authorize(MetadataToken(ctx), perm)
+	if err != nil {
+		slog.Warn("rpc refused", "method", method, "reason", status.Convert(err).Message())
+		return nil, err
+	}
+	return NewContext(ctx, p), nil
+}
+
+// UnaryServerInterceptor refuses RPCs whose caller lacks the method's
+// permission and stores the caller's principal in the context
+func UnaryServerInterceptor(a *Authenticator, perms MethodPermissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.check(ctx, perms, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming form of UnaryServerInterceptor
+func StreamServerInterceptor(a *Authenticator, perms MethodPermissions) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.check(ss.Context(), perms, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &principalStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// principalStream replaces the context of a server stream
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
+
+// MetadataToken returns the bearer token of the authorization metadata
+func MetadataToken(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			return BearerToken(values[0])
+		}
+	}
+	return ""
+}
+
+// BearerToken extracts the token from an "Authorization: Bearer" value
+func BearerToken(header string) string {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,211 @@
+package authz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testDeviceSecret = "device-secret-device-secret-1234"
+
+// testDeviceToken is a device token of fullAuthConfig
+var testDeviceToken = IssueDeviceToken([]byte(testDeviceSecret), "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11", time.Now().Add(time.Hour))
+
+func fullAuthConfig() config.AuthConfig {
+	return config.AuthConfig{
+		NurseTokens:       []string{"nurse-token"},
+		DoctorTokens:      []string{"doctor-token"},
+		SupervisorTokens:  []string{"supervisor-token"},
+		AdminTokens:       []string{"admin-token"},
+		DeviceTokenSecret: testDeviceSecret,
+	}
+}
+
+func TestNewAuthenticatorRequiresEveryRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(*config.AuthConfig)
+		wantErr string
+	}{
+		{"all roles configured", func(*config.AuthConfig) {}, ""},
+		{"no admin tokens", func(c *config.AuthConfig) { c.AdminTokens = nil }, "auth.admin_tokens"},
+		{"no device token secret", func(c *config.AuthConfig) { c.DeviceTokenSecret = "" }, "auth.device_token_secret"},
+		{"no nurse tokens", func(c *config.AuthConfig) { c.NurseTokens = nil }, "auth.nurse_tokens"},
+		{"nothing configured", func(c *config.AuthConfig) { *c = config.AuthConfig{} }, "auth.doctor_tokens"},
+		{"insecure dev", func(c *config.AuthConfig) { *c = config.AuthConfig{InsecureDev: true} }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := fullAuthConfig()
+			tt.edit(&cfg)
+			a, err := NewAuthenticator(cfg)
+			if tt.wantErr == "" {
+				if err != nil || a == nil {
+					t.Fatalf("NewAuthenticator() = %v, %v, want an authenticator", a, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("NewAuthenticator() error = %v, want one naming %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	a, err := NewAuthenticator(fullAuthConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		role  Role
+		token string
+		want  bool
+	}{
+		{RoleDoctor, "doctor-token", true},
+		{RoleNurse, "nurse-token", true},
+		{RoleSupervisor, "supervisor-token", true},
+		{RoleDoctor, "nurse-token", false},
+		{RoleSupervisor, "doctor-token", false},
+		{RoleDoctor, "unknown", false},
+		{RoleAdmin, "anything", false},
+		{RoleDoctor, "", false},
+	}
+	for _, tt := range tests {
+		if got := a.Authenticate(tt.role, tt.token); got != tt.want {
+			t.Errorf("Authenticate(%s, %q) = %v, want %v", tt.role, tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	a, err := NewAuthenticator(fullAuthConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		token    string
+		perm     Permission
+		wantRole Role
+		wantCode codes.Code
+	}{
+		{"admin-token", ManagePatients, RoleAdmin, codes.OK},
+		{"admin-token", ReadAuditLog, RoleAdmin, codes.OK},
+		{"supervisor-token", ReadAnalytics, RoleSupervisor, codes.OK},
+		{testDeviceToken, IngestBiometrics, RoleDevice, codes.OK},
+		{testDeviceToken, ManagePatients, "", codes.PermissionDenied},
+		{IssueDeviceToken([]byte("other"), "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11", time.Now().Add(time.Hour)), IngestBiometrics, "", codes.Unauthenticated},
+		{"doctor-token", ReadAnalytics, "", codes.PermissionDenied},
+		{"nurse-token", ManageTemplates, "", codes.PermissionDenied},
+		{"unknown", ManagePatients, "", codes.Unauthenticated},
+		{"unknown", IngestBiometrics, "", codes.Unauthenticated},
+		{"", ReadAnalytics, "", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		p, err := a.Authorize(tt.token, tt.perm)
+		if code := status.Code(err); code != tt.wantCode {
+			t.Errorf("Authorize(%q, %s) code = %v, want %v", tt.token, tt.perm, code, tt.wantCode)
+			continue
+		}
+		if p.Role != tt.wantRole {
+			t.Errorf("Authorize(%q, %s) role = %q, want %q", tt.token, tt.perm, p.Role, tt.wantRole)
+		}
+		if err == nil && (!strings.HasPrefix(p.ID, string(tt.wantRole)+":") || strings.Contains(p.ID, tt.token)) {
+			t.Errorf("Authorize(%q, %s) ID = %q, want a fingerprint of the token", tt.token, tt.perm, p.ID)
+		}
+	}
+}
+
+func TestAuthorizeInsecureDev(t *testing.T) {
+	cfg := fullAuthConfig()
+	cfg.AdminTokens = nil
+	cfg.InsecureDev = true
+	a, err := NewAuthenticator(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the open role accepts unknown tokens
+	if p, err := a.Authorize("unknown", ManagePatients); err != nil || p.Role != RoleAdmin {
+		t.Errorf("Authorize(unknown, ManagePatients) = %v, %v, want admin", p, err)
+	}
+	if _, err := a.Authorize("unknown", IngestBiometrics); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Authorize(unknown, IngestBiometrics) error = %v, want Unauthenticated", err)
+	}
+	// Configured tokens keep their role
+	if _, err := a.Authorize(testDeviceToken, ManagePatients); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Authorize(device token, ManagePatients) error = %v, want PermissionDenied", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	a, err := NewAuthenticator(fullAuthConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	perms := MethodPermissions{
+		Methods: map[string]Permission{"/medical.PatientService/GetPatient": ManagePatients},
+		Public:  []string{"grpc.health.v1.Health"},
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+	}{
+		{"permitted", withToken("admin-token"), "/medical.PatientService/GetPatient", codes.OK},
+		{"wrong role", withToken("doctor-token"), "/medical.PatientService/GetPatient", codes.PermissionDenied},
+		{"no token", context.Background(), "/medical.PatientService/GetPatient", codes.Unauthenticated},
+		{"public service", context.Background(), "/grpc.health.v1.Health/Check", codes.OK},
+		{"method without permission", withToken("admin-token"), "/medical.PatientService/Unlisted", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.check(tt.ctx, perms, tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("check() code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRolePermissions(t *testing.T) {
+	tests := []struct {
+		role Role
+		perm Permission
+		want bool
+	}{
+		{RolePatient, SendPatientMessage, true},
+		{RolePatient, TakeSessions, false},
+		{RoleNurse, ReviewDraft, true},
+		{RoleNurse, SendFreeText, false},
+		{RoleDoctor, SendFreeText, true},
+		{RoleDoctor, ObserveSession, false},
+		{RoleSupervisor, ReassignSession, true},
+		{RoleSupervisor, ManagePatients, false},
+		{RoleAdmin, ReadAuditLog, true},
+		{RoleAdmin, TakeSessions, false},
+		{RoleDevice, IngestBiometrics, true},
+		{RoleDevice, ReadAnalytics, false},
+	}
+	for _, tt := range tests {
+		if got := tt.role.Can(tt.perm); got != tt.want {
+			t.Errorf("%s.Can(%s) = %v, want %v", tt.role, tt.perm, got, tt.want)
+		}
+	}
+
+	if _, err := ParseRole("root"); err == nil {
+		t.Error("ParseRole(root) succeeded, want an error")
+	}
+}
@@ -2,61 +2,243 @@ package main
 
 import (
 	"bufio"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"log"
 	"net/url"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
 	pb "llm-qa-system/backend-service/src/proto"
 
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// codec encodes WebSocketMessages in the frames of the client's subprotocol:
+// protojson text frames for medqa.v1, binary protobuf for medqa.v1+proto
+type codec struct {
+	binary bool
+}
+
+func (c codec) protocol() string {
+	if c.binary {
+		return "medqa.v1+proto"
+	}
+	return "medqa.v1"
+}
+
+func (c codec) frameType() int {
+	if c.binary {
+		return websocket.BinaryMessage
+	}
+	return websocket.TextMessage
+}
+
+func (c codec) marshal(msg proto.Message) ([]byte, error) {
+	if c.binary {
+		return proto.Marshal(msg)
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+}
+
+func (c codec) unmarshal(data []byte, msg proto.Message) error {
+	if c.binary {
+		return proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+}
+
 type DoctorClient struct {
-	conn        *websocket.Conn
-	sessionID   string
-	latestDraft *pb.AIDraftReady
+	conn      *websocket.Conn
+	lobby     *websocket.Conn // On-duty connection, nil when the session was given with -session
+	wire      codec
+	sessionID string
+	writeMu   sync.Mutex // Commands and acknowledgements are written concurrently
+	mu        sync.Mutex
+	drafts    []*pb.AIDraftReady // Pending drafts, most urgent first
+	sent      map[string]string  // Messages and reviewed drafts awaiting read receipts, by client message ID
+	nextID    int
+}
+
+// send writes msg to conn
+func (c *DoctorClient) send(conn *websocket.Conn, msg *pb.WebSocketMessage) error {
+	data, err := c.wire.marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %v", err)
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return conn.WriteMessage(c.wire.frameType(), data)
+}
+
+// acknowledge tells the server a patient message was shown, which for this
+// client means it was read
+func (c *DoctorClient) acknowledge(messageID string) {
+	err := c.send(c.conn, &pb.WebSocketMessage{
+		Type: pb.MessageType_MESSAGE_ACK,
+		Payload: &pb.WebSocketMessage_Ack{
+			Ack: &pb.MessageAck{MessageId: messageID, Status: pb.DeliveryStatus_DELIVERY_READ},
+		},
+	})
+	if err != nil {
+		log.Printf("ack error: %v", err)
+	}
+}
+
+// track remembers content under clientID, or a new ID when it is empty, for
+// its read receipt
+func (c *DoctorClient) track(clientID, content string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if clientID == "" {
+		c.nextID++
+		clientID = strconv.Itoa(c.nextID)
+	}
+	c.sent[clientID] = content
+	return clientID
+}
+
+// readReceipt returns and forgets the content a read receipt is about
+func (c *DoctorClient) readReceipt(r *pb.DeliveryReceipt) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content, ok := c.sent[r.ClientMessageId]
+	delete(c.sent, r.ClientMessageId)
+	return content, ok
+}
+
+// addDraft queues a draft, keeping emergencies at the top
+func (c *DoctorClient) addDraft(draft *pb.AIDraftReady) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, d := range c.drafts {
+		if d.MessageId == draft.MessageId {
+			return
+		}
+	}
+	c.drafts = append(c.drafts, draft)
+	sort.SliceStable(c.drafts, func(i, j int) bool {
+		return c.drafts[i].Urgency > c.drafts[j].Urgency
+	})
+}
+
+// nextDraft returns the draft at the top of the queue
+func (c *DoctorClient) nextDraft() *pb.AIDraftReady {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.drafts) == 0 {
+		return nil
+	}
+	return c.drafts[0]
+}
+
+// removeDraft drops a reviewed draft from the queue
+func (c *DoctorClient) removeDraft(messageID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, d := range c.drafts {
+		if d.MessageId == messageID {
+			c.drafts = append(c.drafts[:i], c.drafts[i+1:]...)
+			return
+		}
+	}
+}
+
+// printQueue lists pending drafts in review order
+func (c *DoctorClient) printQueue() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.drafts) == 0 {
+		fmt.Println("No drafts waiting for review")
+		return
+	}
+	for i, d := range c.drafts {
+		fmt.Printf("%d. [%s] %s: %s\n", i+1, urgencyLabel(d.Urgency), d.MessageId, d.OriginalMessage)
+	}
+}
+
+func urgencyLabel(u pb.UrgencyLevel) string {
+	switch u {
+	case pb.UrgencyLevel_URGENCY_EMERGENCY:
+		return "EMERGENCY"
+	case pb.UrgencyLevel_URGENCY_URGENT:
+		return "URGENT"
+	default:
+		return "ROUTINE"
+	}
 }
 
 func main() {
 	addr := flag.String("addr", "localhost:8080", "server address")
-	sessionID := flag.String("session", "", "session ID to join")
-	token := flag.String("token", "doctor123", "doctor authentication token")
+	sessionID := flag.String("session", "", "session ID to join (omit to go on duty and wait for an assignment)")
+	doctorID := flag.String("doctor-id", "", "doctor user ID, only for a server run with -insecure-dev; a staff token carries it")
+	token := flag.String("token", "doctor123", "staff token from cmd/staff-token")
+	role := flag.String("role", "doctor", "staff role the token belongs to: nurse, doctor or supervisor")
+	observe := flag.Bool("observe", false, "watch the session read-only as a supervisor (requires -session)")
+	useTLS := flag.Bool("tls", false, "connect with TLS (wss://)")
+	caFile := flag.String("ca", "", "CA certificate used to verify the server")
+	binary := flag.Bool("binary", false, "exchange binary protobuf frames (medqa.v1+proto) instead of JSON")
+	compress := flag.Bool("compress", false, "negotiate permessage-deflate compression")
 	flag.Parse()
 
-	if *sessionID == "" {
-		log.Fatal("session ID is required")
+	if *observe && *sessionID == "" {
+		log.Fatal("observing requires a session ID")
+	}
+
+	wire := codec{binary: *binary}
+	dialer, scheme, err := newDialer(*useTLS, *caFile, wire, *compress)
+	if err != nil {
+		log.Fatal("tls:", err)
+	}
+
+	client := &DoctorClient{sessionID: *sessionID, wire: wire, sent: make(map[string]string)}
+
+	// Without a session, go on duty and join the first session assigned
+	if client.sessionID == "" {
+		lobby, assigned, err := goOnDuty(dialer, scheme, *addr, *role, *token, *doctorID, wire)
+		if err != nil {
+			log.Fatal("on duty:", err)
+		}
+		defer lobby.Close()
+		client.lobby = lobby
+		client.sessionID = assigned
 	}
 
 	// Connect to WebSocket server
-	u := url.URL{Scheme: "ws", Host: *addr, Path: "/ws"}
+	u := url.URL{Scheme: scheme, Host: *addr, Path: "/ws"}
 	q := u.Query()
-	q.Set("role", "doctor")
-	q.Set("session", *sessionID)
+	q.Set("role", *role)
+	q.Set("session", client.sessionID)
 	q.Set("token", *token)
+	if *doctorID != "" {
+		q.Set("doctor_id", *doctorID)
+	}
+	if *observe {
+		q.Set("observe", "1")
+	}
 	u.RawQuery = q.Encode()
 
-	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	c, _, err := dialer.Dial(u.String(), nil)
 	if err != nil {
 		log.Fatal("dial:", err)
 	}
 	defer c.Close()
-
-	client := &DoctorClient{
-		conn:      c,
-		sessionID: *sessionID,
-	}
+	client.conn = c
 
 	fmt.Printf("Connected to session: %s\n", client.sessionID)
 
-	// Configure protojson
-	marshaler := protojson.MarshalOptions{UseProtoNames: true}
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
-
 	// Handle incoming messages
 	go func() {
 		for {
@@ -67,7 +249,7 @@ func main() {
 			}
 
 			var wsMsg pb.WebSocketMessage
-			if err := unmarshaler.Unmarshal(rawMsg, &wsMsg); err != nil {
+			if err := wire.unmarshal(rawMsg, &wsMsg); err != nil {
 				log.Printf("unmarshal error: %v", err)
 				continue
 			}
@@ -77,11 +259,82 @@ func main() {
 				if msg := wsMsg.GetMessage(); msg != nil {
 					fmt.Printf("\nPatient: %s\n", msg.Content)
 					fmt.Print("> ")
+					if msg.MessageId != "" && !*observe {
+						client.acknowledge(msg.MessageId)
+					}
+				}
+			case pb.MessageType_DELIVERY_RECEIPT:
+				r := wsMsg.GetReceipt()
+				if r == nil || r.Status != pb.DeliveryStatus_DELIVERY_READ {
+					continue
+				}
+				if content, ok := client.readReceipt(r); ok {
+					fmt.Printf("\nRead by the patient: %q\n", content)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_DOCTOR_MESSAGE:
+				// Only observers receive the doctor's replies
+				if msg := wsMsg.GetMessage(); msg != nil {
+					fmt.Printf("\nDoctor: %s\n", msg.Content)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_VITAL_ALERT:
+				if alert := wsMsg.GetVitalAlert(); alert != nil {
+					fmt.Printf("\n[%s] Vital alert (%s): %s\n", urgencyLabel(alert.Urgency), alert.Rule, alert.Description)
+					for _, r := range alert.Readings {
+						value := fmt.Sprintf("%g", r.Value)
+						if r.SecondaryValue != 0 {
+							value += fmt.Sprintf("/%g", r.SecondaryValue)
+						}
+						fmt.Printf("  %s %s %s at %s\n", r.TypeId, value, r.Unit, r.MeasuredAt.AsTime().Local().Format("15:04:05"))
+					}
+					fmt.Print("> ")
+				}
+			case pb.MessageType_ERROR:
+				if e := wsMsg.GetError(); e != nil {
+					fmt.Printf("\nError: %s\n", e.Message)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_SYSTEM_MESSAGE:
+				if msg := wsMsg.GetMessage(); msg != nil {
+					fmt.Printf("\nSystem: %s\n", msg.Content)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_SESSION_JOINED:
+				if e := wsMsg.GetSessionEvent(); e != nil {
+					if e.ProtocolVersion > 0 {
+						fmt.Printf("\nJoined session %s (protocol v%d)\n", e.SessionId, e.ProtocolVersion)
+					} else {
+						fmt.Printf("\n%s joined the session\n", participantLabel(e))
+					}
+					fmt.Print("> ")
+				}
+			case pb.MessageType_PARTICIPANT_LEFT:
+				if e := wsMsg.GetSessionEvent(); e != nil {
+					fmt.Printf("\n%s left the session (%s)\n", participantLabel(e), e.Reason)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_TYPING:
+				if t := wsMsg.GetTyping(); t != nil && t.Typing {
+					fmt.Printf("\n%s is typing...\n", t.Role)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_PRESENCE:
+				if p := wsMsg.GetPresence(); p != nil {
+					fmt.Printf("\n%s %s is %s\n", p.Role, p.ParticipantId, presenceLabels[p.Status])
+					fmt.Print("> ")
+				}
+			case pb.MessageType_SESSION_CLOSED:
+				if e := wsMsg.GetSessionEvent(); e != nil {
+					fmt.Printf("\nSession closed: %s\n", e.Reason)
 				}
 			case pb.MessageType_AI_DRAFT_READY:
 				if draft := wsMsg.GetAiDraft(); draft != nil {
-					client.latestDraft = draft
-					fmt.Printf("\nAI Draft ready:\n%s\n", draft.Draft)
+					client.addDraft(draft)
+					fmt.Printf("\n[%s] AI Draft ready:\n%s\n", urgencyLabel(draft.Urgency), draft.Draft)
+					if len(draft.TriageReasons) > 0 {
+						fmt.Printf("Triage: %s\n", strings.Join(draft.TriageReasons, ", "))
+					}
 					fmt.Println("Use 'review <accept|modify|reject> [content]' to review")
 					fmt.Print("> ")
 				}
@@ -91,7 +344,11 @@ func main() {
 
 	// Handle commands
 	reader := bufio.NewReader(os.Stdin)
-	fmt.Println("Commands: review <accept|modify|reject> [content], send <message>, quit")
+	if *observe {
+		fmt.Println("Observing read-only. Commands: handoff <doctor_id> [note] (reassigns the session), queue, quit")
+	} else {
+		fmt.Println("Commands: review <accept|modify|reject> [content], queue, send <message>, handoff <doctor_id> [note], status <available|busy|away>, presence <online|away>, quit")
+	}
 
 	for {
 		fmt.Print("> ")
@@ -108,13 +365,94 @@ func main() {
 		}
 
 		switch parts[0] {
+		case "queue":
+			client.printQueue()
+
+		case "status":
+			if client.lobby == nil {
+				fmt.Println("Not on duty, start without -session to receive assignments")
+				continue
+			}
+			if len(parts) < 2 {
+				fmt.Println("Usage: status <available|busy|away>")
+				continue
+			}
+
+			availability, ok := availabilities[parts[1]]
+			if !ok {
+				fmt.Println("Invalid status. Use available, busy, or away")
+				continue
+			}
+
+			wsMsg := &pb.WebSocketMessage{
+				Type: pb.MessageType_DOCTOR_STATUS,
+				Payload: &pb.WebSocketMessage_DoctorStatus{
+					DoctorStatus: &pb.DoctorStatus{Availability: availability},
+				},
+			}
+
+			if err := client.send(client.lobby, wsMsg); err != nil {
+				log.Printf("write error: %v", err)
+				continue
+			}
+
+		case "presence":
+			if len(parts) < 2 {
+				fmt.Println("Usage: presence <online|away>")
+				continue
+			}
+
+			status, ok := presences[parts[1]]
+			if !ok {
+				fmt.Println("Invalid presence. Use online or away")
+				continue
+			}
+
+			// Presence covers every connection, so report it on all of them
+			wsMsg := &pb.WebSocketMessage{
+				Type: pb.MessageType_PRESENCE,
+				Payload: &pb.WebSocketMessage_Presence{
+					Presence: &pb.Presence{Status: status},
+				},
+			}
+			for _, conn := range []*websocket.Conn{c, client.lobby} {
+				if conn == nil {
+					continue
+				}
+				if err := client.send(conn, wsMsg); err != nil {
+					log.Printf("write error: %v", err)
+				}
+			}
+
+		case "handoff":
+			if len(parts) < 2 {
+				fmt.Println("Usage: handoff <doctor_id> [note]")
+				continue
+			}
+
+			wsMsg := &pb.WebSocketMessage{
+				Type: pb.MessageType_SESSION_HANDOFF,
+				Payload: &pb.WebSocketMessage_Handoff{
+					Handoff: &pb.SessionHandoff{
+						ToDoctorId: parts[1],
+						Note:       strings.Join(parts[2:], " "),
+					},
+				},
+			}
+
+			if err := client.send(c, wsMsg); err != nil {
+				log.Printf("write error: %v", err)
+				continue
+			}
+
 		case "review":
 			if len(parts) < 2 {
 				fmt.Println("Usage: review <accept|modify|reject> [content]")
 				continue
 			}
 
-			if client.latestDraft == nil {
+			draft := client.nextDraft()
+			if draft == nil {
 				fmt.Println("No draft available to review")
 				continue
 			}
@@ -123,14 +461,14 @@ func main() {
 			wsMsg.Type = pb.MessageType_DRAFT_REVIEW
 
 			review := &pb.DraftReview{
-				MessageId: client.latestDraft.MessageId,
+				MessageId: draft.MessageId,
 				Timestamp: timestamppb.Now(),
 			}
 
 			switch parts[1] {
 			case "accept":
 				review.Action = pb.ReviewAction_ACCEPT
-				review.Content = client.latestDraft.Draft
+				review.Content = draft.Draft
 			case "modify":
 				if len(parts) < 3 {
 					fmt.Println("Content required for modify")
@@ -146,17 +484,16 @@ func main() {
 			}
 
 			wsMsg.Payload = &pb.WebSocketMessage_Review{Review: review}
-
-			jsonBytes, err := marshaler.Marshal(&wsMsg)
-			if err != nil {
-				log.Printf("marshal error: %v", err)
-				continue
+			if review.Action != pb.ReviewAction_REJECT {
+				// The answer's receipts refer to it by the draft's ID
+				client.track(draft.MessageId, review.Content)
 			}
 
-			if err := c.WriteMessage(websocket.TextMessage, jsonBytes); err != nil {
+			if err := client.send(c, &wsMsg); err != nil {
 				log.Printf("write error: %v", err)
 				continue
 			}
+			client.removeDraft(draft.MessageId)
 
 		case "send":
 			if len(parts) < 2 {
@@ -164,26 +501,160 @@ func main() {
 				continue
 			}
 
+			content := strings.Join(parts[1:], " ")
 			wsMsg := &pb.WebSocketMessage{
 				Type: pb.MessageType_DOCTOR_MESSAGE,
 				Payload: &pb.WebSocketMessage_Message{
 					Message: &pb.Message{
-						Content:   strings.Join(parts[1:], " "),
-						Timestamp: timestamppb.Now(),
+						Content:         content,
+						Timestamp:       timestamppb.Now(),
+						ClientMessageId: client.track("", content),
 					},
 				},
 			}
 
-			jsonBytes, err := marshaler.Marshal(wsMsg)
-			if err != nil {
-				log.Printf("marshal error: %v", err)
+			if err := client.send(c, wsMsg); err != nil {
+				log.Printf("write error: %v", err)
 				continue
 			}
+		}
+	}
+}
 
-			if err := c.WriteMessage(websocket.TextMessage, jsonBytes); err != nil {
-				log.Printf("write error: %v", err)
+var availabilities = map[string]pb.DoctorAvailability{
+	"available": pb.DoctorAvailability_AVAILABILITY_AVAILABLE,
+	"busy":      pb.DoctorAvailability_AVAILABILITY_BUSY,
+	"away":      pb.DoctorAvailability_AVAILABILITY_AWAY,
+}
+
+var presences = map[string]pb.PresenceStatus{
+	"online": pb.PresenceStatus_PRESENCE_ONLINE,
+	"away":   pb.PresenceStatus_PRESENCE_AWAY,
+}
+
+var presenceLabels = map[pb.PresenceStatus]string{
+	pb.PresenceStatus_PRESENCE_ONLINE: "online",
+	pb.PresenceStatus_PRESENCE_AWAY:   "away",
+}
+
+// goOnDuty opens the on-duty connection and waits for the first session
+// assignment. Later assignments are printed while the doctor is in session.
+func goOnDuty(dialer *websocket.Dialer, scheme, addr, role, token, doctorID string, wire codec) (*websocket.Conn, string, error) {
+	u := url.URL{Scheme: scheme, Host: addr, Path: "/ws"}
+	q := u.Query()
+	q.Set("role", role)
+	q.Set("token", token)
+	if doctorID != "" {
+		q.Set("doctor_id", doctorID)
+	}
+	u.RawQuery = q.Encode()
+
+	lobby, _, err := dialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fmt.Println("On duty, waiting for a session assignment...")
+
+	assigned := make(chan string, 1)
+	go func() {
+		first := true
+		for {
+			_, rawMsg, err := lobby.ReadMessage()
+			if err != nil {
+				log.Printf("on duty read error: %v", err)
+				close(assigned)
+				return
+			}
+
+			var wsMsg pb.WebSocketMessage
+			if err := wire.unmarshal(rawMsg, &wsMsg); err != nil {
+				log.Printf("unmarshal error: %v", err)
 				continue
 			}
+
+			switch wsMsg.Type {
+			case pb.MessageType_SESSION_ASSIGNMENT:
+				a := wsMsg.GetAssignment()
+				if a == nil {
+					continue
+				}
+				if a.Withdrawn {
+					fmt.Printf("\nAssignment withdrawn: %s\n", a.SessionId)
+					continue
+				}
+				fmt.Printf("\n[%s] Session %s assigned (%s)\n", urgencyLabel(a.Urgency), a.SessionId, a.DepartmentId)
+				if a.FromDoctorId != "" {
+					fmt.Printf("Handed off by doctor %s: %s\n", a.FromDoctorId, a.HandoffNote)
+				}
+				if first {
+					first = false
+					assigned <- a.SessionId
+				} else {
+					fmt.Print("> ")
+				}
+			case pb.MessageType_REVIEW_ESCALATION:
+				if e := wsMsg.GetEscalation(); e != nil {
+					fmt.Printf("\n[%s] Escalated: draft %s in session %s is overdue for review\n", urgencyLabel(e.Urgency), e.MessageId, e.SessionId)
+					if !first {
+						fmt.Print("> ")
+					}
+				}
+			case pb.MessageType_SYSTEM_MESSAGE:
+				if msg := wsMsg.GetMessage(); msg != nil {
+					fmt.Printf("\nSystem: %s\n", msg.Content)
+				}
+			}
+		}
+	}()
+
+	sessionID, ok := <-assigned
+	if !ok {
+		lobby.Close()
+		return nil, "", fmt.Errorf("connection closed before a session was assigned")
+	}
+	return lobby, sessionID, nil
+}
+
+// participantLabel names the participant of a session event
+func participantLabel(e *pb.SessionEvent) string {
+	label := e.Role
+	if e.Observer {
+		label += " (observer)"
+	}
+	if e.ParticipantId != "" {
+		label += " " + e.ParticipantId
+	}
+	return label
+}
+
+// newDialer returns a WebSocket dialer offering wire's subprotocol, and the
+// URL scheme. With useTLS the connection uses wss:// and trusts caFile in
+// addition to the system roots.
+func newDialer(useTLS bool, caFile string, wire codec, compress bool) (*websocket.Dialer, string, error) {
+	dialer := *websocket.DefaultDialer
+	dialer.Subprotocols = []string{wire.protocol()}
+	dialer.EnableCompression = compress
+	if !useTLS {
+		return &dialer, "ws", nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read CA file: %v", err)
 		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, "", fmt.Errorf("no certificates found in %s", caFile)
+		}
+		tlsCfg.RootCAs = pool
 	}
+
+	dialer.TLSClientConfig = tlsCfg
+	return &dialer, "wss", nil
 }
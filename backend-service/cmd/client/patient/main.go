@@ -2,54 +2,179 @@ package main
 
 import (
 	"bufio"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"log"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 
 	pb "llm-qa-system/backend-service/src/proto"
 
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// codec encodes WebSocketMessages in the frames of the client's subprotocol:
+// protojson text frames for medqa.v1, binary protobuf for medqa.v1+proto
+type codec struct {
+	binary bool
+}
+
+func (c codec) protocol() string {
+	if c.binary {
+		return "medqa.v1+proto"
+	}
+	return "medqa.v1"
+}
+
+func (c codec) frameType() int {
+	if c.binary {
+		return websocket.BinaryMessage
+	}
+	return websocket.TextMessage
+}
+
+func (c codec) marshal(msg proto.Message) ([]byte, error) {
+	if c.binary {
+		return proto.Marshal(msg)
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+}
+
+func (c codec) unmarshal(data []byte, msg proto.Message) error {
+	if c.binary {
+		return proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+}
+
 type PatientClient struct {
 	conn      *websocket.Conn
+	wire      codec
 	sessionID string
+	writeMu   sync.Mutex // Input and acknowledgements are written concurrently
+	mu        sync.Mutex
+	sent      map[string]string // Messages awaiting receipts by client message ID
+	nextID    int
+	lastSeq   uint64 // Of the last message acknowledged, for resuming
+}
+
+// send writes msg to the server
+func (c *PatientClient) send(msg *pb.WebSocketMessage) error {
+	data, err := c.wire.marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %v", err)
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn.WriteMessage(c.wire.frameType(), data)
+}
+
+// acknowledge tells the server a message was shown, which for this client
+// means it was read
+func (c *PatientClient) acknowledge(msg *pb.Message) {
+	err := c.send(&pb.WebSocketMessage{
+		Type: pb.MessageType_MESSAGE_ACK,
+		Payload: &pb.WebSocketMessage_Ack{
+			Ack: &pb.MessageAck{MessageId: msg.MessageId, Status: pb.DeliveryStatus_DELIVERY_READ},
+		},
+	})
+	if err != nil {
+		log.Printf("ack error: %v", err)
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if msg.Seq > c.lastSeq {
+		c.lastSeq = msg.Seq
+	}
+}
+
+// resumeHint tells the patient how to reconnect to the session
+func (c *PatientClient) resumeHint() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return fmt.Sprintf("-session %s -last-seq %d", c.sessionID, c.lastSeq)
+}
+
+// track remembers content under a new client message ID for its receipts
+func (c *PatientClient) track(content string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextID++
+	id := strconv.Itoa(c.nextID)
+	c.sent[id] = content
+	return id
+}
+
+// receipt returns the content of the message a receipt is about; read
+// messages are forgotten
+func (c *PatientClient) receipt(r *pb.DeliveryReceipt) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content, ok := c.sent[r.ClientMessageId]
+	if r.Status == pb.DeliveryStatus_DELIVERY_READ {
+		delete(c.sent, r.ClientMessageId)
+	}
+	return content, ok
 }
 
 func main() {
 	addr := flag.String("addr", "localhost:8080", "server address")
+	useTLS := flag.Bool("tls", false, "connect with TLS (wss://)")
+	caFile := flag.String("ca", "", "CA certificate used to verify the server")
+	binary := flag.Bool("binary", false, "exchange binary protobuf frames (medqa.v1+proto) instead of JSON")
+	compress := flag.Bool("compress", false, "negotiate permessage-deflate compression")
+	token := flag.String("token", "", "patient token (see cmd/patient-token), identifies the patient and keeps a transcript of the conversation")
+	department := flag.String("department", "", "department to route the conversation to, e.g. DEPT_CARDIOLOGY (default: chosen by triage)")
+	session := flag.String("session", "", "session to resume after a dropped connection (requires -token)")
+	lastSeq := flag.Uint64("last-seq", 0, "seq of the last doctor message read before the connection dropped")
 	flag.Parse()
 
+	wire := codec{binary: *binary}
+	dialer, scheme, err := newDialer(*useTLS, *caFile, wire, *compress)
+	if err != nil {
+		log.Fatal("tls:", err)
+	}
+
 	// Connect to WebSocket server
-	u := url.URL{Scheme: "ws", Host: *addr, Path: "/ws"}
+	u := url.URL{Scheme: scheme, Host: *addr, Path: "/ws"}
 	q := u.Query()
 	q.Set("role", "patient")
+	if *department != "" {
+		q.Set("department", *department)
+	}
+	if *token != "" {
+		q.Set("token", *token)
+	}
+	if *session != "" {
+		q.Set("session", *session)
+		q.Set("last_seq", strconv.FormatUint(*lastSeq, 10))
+	}
 	u.RawQuery = q.Encode()
 
-	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	c, _, err := dialer.Dial(u.String(), nil)
 	if err != nil {
 		log.Fatal("dial:", err)
 	}
 	defer c.Close()
 
-	client := &PatientClient{conn: c}
+	client := &PatientClient{conn: c, wire: wire, sent: make(map[string]string), lastSeq: *lastSeq}
 
-	// Get session ID from server
-	var sessionResp map[string]string
-	if err := c.ReadJSON(&sessionResp); err != nil {
+	// The server opens the connection with SESSION_STARTED
+	started, err := readSessionStarted(c, wire)
+	if err != nil {
 		log.Fatal("read session:", err)
 	}
-	client.sessionID = sessionResp["session_id"]
-	fmt.Printf("Connected to session: %s\n", client.sessionID)
-
-	// Configure protojson
-	marshaler := protojson.MarshalOptions{UseProtoNames: true}
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	client.sessionID = started.SessionId
+	fmt.Printf("Connected to session: %s (protocol v%d)\n", client.sessionID, started.ProtocolVersion)
 
 	// Handle incoming messages
 	go func() {
@@ -57,11 +182,14 @@ func main() {
 			_, rawMsg, err := c.ReadMessage()
 			if err != nil {
 				log.Printf("read error: %v", err)
+				if *token != "" {
+					fmt.Printf("To resume the session, reconnect with -token and %s\n", client.resumeHint())
+				}
 				return
 			}
 
 			var wsMsg pb.WebSocketMessage
-			if err := unmarshaler.Unmarshal(rawMsg, &wsMsg); err != nil {
+			if err := wire.unmarshal(rawMsg, &wsMsg); err != nil {
 				log.Printf("unmarshal error: %v", err)
 				continue
 			}
@@ -71,6 +199,38 @@ func main() {
 				if msg := wsMsg.GetMessage(); msg != nil {
 					fmt.Printf("\nDoctor: %s\n", msg.Content)
 					fmt.Print("> ")
+					if msg.MessageId != "" {
+						client.acknowledge(msg)
+					}
+				}
+			case pb.MessageType_DELIVERY_RECEIPT:
+				r := wsMsg.GetReceipt()
+				if r == nil || r.Status != pb.DeliveryStatus_DELIVERY_READ {
+					continue
+				}
+				if content, ok := client.receipt(r); ok {
+					fmt.Printf("\nRead by the doctor: %q\n", content)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_SYSTEM_MESSAGE:
+				if msg := wsMsg.GetMessage(); msg != nil {
+					fmt.Printf("\nSystem: %s\n", msg.Content)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_SESSION_JOINED:
+				if e := wsMsg.GetSessionEvent(); e != nil && !e.Observer {
+					fmt.Printf("\nA %s joined the conversation\n", e.Role)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_TYPING:
+				if t := wsMsg.GetTyping(); t != nil && t.Typing {
+					fmt.Printf("\nThe %s is typing...\n", t.Role)
+					fmt.Print("> ")
+				}
+			case pb.MessageType_PRESENCE:
+				if p := wsMsg.GetPresence(); p != nil {
+					fmt.Printf("\nThe %s is %s\n", p.Role, presenceLabel(p.Status))
+					fmt.Print("> ")
 				}
 			}
 		}
@@ -97,21 +257,71 @@ func main() {
 			Type: pb.MessageType_PATIENT_MESSAGE,
 			Payload: &pb.WebSocketMessage_Message{
 				Message: &pb.Message{
-					Content:   input,
-					Timestamp: timestamppb.Now(),
+					Content:         input,
+					Timestamp:       timestamppb.Now(),
+					ClientMessageId: client.track(input),
 				},
 			},
 		}
 
-		jsonBytes, err := marshaler.Marshal(wsMsg)
-		if err != nil {
-			log.Printf("marshal error: %v", err)
-			continue
-		}
-
-		if err := c.WriteMessage(websocket.TextMessage, jsonBytes); err != nil {
+		if err := client.send(wsMsg); err != nil {
 			log.Printf("write error: %v", err)
 			return
 		}
 	}
 }
+
+// presenceLabel describes a presence status in a sentence
+func presenceLabel(status pb.PresenceStatus) string {
+	if status == pb.PresenceStatus_PRESENCE_AWAY {
+		return "away"
+	}
+	return "back"
+}
+
+// readSessionStarted reads the event that opens a patient's connection
+func readSessionStarted(c *websocket.Conn, wire codec) (*pb.SessionEvent, error) {
+	_, rawMsg, err := c.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	var wsMsg pb.WebSocketMessage
+	if err := wire.unmarshal(rawMsg, &wsMsg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal message: %v", err)
+	}
+	if wsMsg.Type != pb.MessageType_SESSION_STARTED || wsMsg.GetSessionEvent() == nil {
+		return nil, fmt.Errorf("expected SESSION_STARTED, got %s", wsMsg.Type)
+	}
+	return wsMsg.GetSessionEvent(), nil
+}
+
+// newDialer returns a WebSocket dialer offering wire's subprotocol, and the
+// URL scheme. With useTLS the connection uses wss:// and trusts caFile in
+// addition to the system roots.
+func newDialer(useTLS bool, caFile string, wire codec, compress bool) (*websocket.Dialer, string, error) {
+	dialer := *websocket.DefaultDialer
+	dialer.Subprotocols = []string{wire.protocol()}
+	dialer.EnableCompression = compress
+	if !useTLS {
+		return &dialer, "ws", nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read CA file: %v", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, "", fmt.Errorf("no certificates found in %s", caFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	dialer.TLSClientConfig = tlsCfg
+	return &dialer, "wss", nil
+}
@@ -0,0 +1,44 @@
+// Command device-token issues the token a wearable or home device sends a
+// patient's biometrics with, signed with auth.device_token_secret. The token
+// is bound to the patient, so it cannot send readings of anyone else.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	patientID := flag.String("patient-id", "", "user ID of the patient whose readings the device sends")
+	ttl := flag.Duration("ttl", 90*24*time.Hour, "how long the token is valid")
+	flag.Parse()
+
+	if _, err := uuid.Parse(*patientID); err != nil {
+		log.Fatal("invalid -patient-id:", err)
+	}
+	if *ttl <= 0 {
+		log.Fatal("-ttl must be positive")
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	if cfg.Auth.DeviceTokenSecret == "" {
+		log.Fatal("auth.device_token_secret is not configured")
+	}
+
+	fmt.Println(authz.IssueDeviceToken([]byte(cfg.Auth.DeviceTokenSecret), *patientID, time.Now().Add(*ttl)))
+}
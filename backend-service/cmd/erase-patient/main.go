@@ -0,0 +1,84 @@
+// Command erase-patient exports everything stored about a patient to a file,
+// then erases it, for right-to-erasure requests
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/encryption"
+	"llm-qa-system/backend-service/retention"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	patientID := flag.String("patient-id", "", "user ID of the patient to erase")
+	out := flag.String("out", "", "file to write the patient's data to before erasing it")
+	flag.Parse()
+
+	id, err := pg.ParseUUID(*patientID)
+	if err != nil {
+		log.Fatal("invalid -patient-id:", err)
+	}
+	if *out == "" {
+		log.Fatal("-out is required")
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.Database.URL)
+	if err != nil {
+		log.Fatal("connect:", err)
+	}
+	defer pool.Close()
+	cipher, err := encryption.FromConfig(pool, cfg.Encryption)
+	if err != nil {
+		log.Fatal("encryption:", err)
+	}
+
+	f, err := os.OpenFile(*out, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+	if err != nil {
+		log.Fatal("create output:", err)
+	}
+	result, err := retention.ExportAndErase(ctx, pool, cipher, id, f)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		log.Fatalf("write output: %v (the erasure was committed)", closeErr)
+	}
+	if err != nil {
+		os.Remove(*out)
+		log.Fatal("erase:", err)
+	}
+
+	_, err = audit.NewLog(pool).Append(ctx, audit.Event{
+		Action:    audit.ActionPatientErase,
+		ActorRole: audit.RoleSystem,
+		ActorID:   "erase-patient",
+		PatientID: id,
+		Resource:  *patientID,
+		Details: map[string]string{
+			"conditions":      strconv.FormatInt(result.Conditions, 10),
+			"biometrics":      strconv.FormatInt(result.Biometrics, 10),
+			"messages":        strconv.FormatInt(result.Messages, 10),
+			"ai_interactions": strconv.FormatInt(result.AIInteractions, 10),
+		},
+	})
+	if err != nil {
+		log.Printf("failed to record audit event: %v", err)
+	}
+
+	fmt.Printf("Exported to %s\n", *out)
+	fmt.Printf("Deleted %d conditions, %d readings and %d messages; de-identified %d AI interactions\n",
+		result.Conditions, result.Biometrics, result.Messages, result.AIInteractions)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/evaluation"
+	"llm-qa-system/backend-service/server"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const usage = `usage:
+  evaluate run [-config file] [-concurrency N] [-prompt-template file] [-out file] cases.jsonl
+  evaluate compare [-max-cases N] [-out file] baseline.jsonl candidate.jsonl`
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+
+	switch os.Args[1] {
+	case "run":
+		runCases(os.Args[2:])
+	case "compare":
+		compareRuns(os.Args[2:])
+	default:
+		log.Fatal(usage)
+	}
+}
+
+// runCases asks the LLM service for the draft of every case and writes the
+// scored results
+func runCases(args []string) {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	configPath := flags.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	concurrency := flags.Int("concurrency", 4, "drafts requested at a time")
+	templatePath := flags.String("prompt-template", "", "file holding the instructions to test (default: the service default)")
+	out := flags.String("out", "", "file to write the results to (default: stdout)")
+	flags.Parse(args)
+	if flags.NArg() != 1 || *concurrency < 1 {
+		log.Fatal(usage)
+	}
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		log.Fatal("open cases:", err)
+	}
+	cases, err := evaluation.LoadCases(f)
+	f.Close()
+	if err != nil {
+		log.Fatal("load cases:", err)
+	}
+	var template string
+	if *templatePath != "" {
+		data, err := os.ReadFile(*templatePath)
+		if err != nil {
+			log.Fatal("read prompt template:", err)
+		}
+		template = strings.TrimSpace(string(data))
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	conn, err := dial(cfg.LLM)
+	if err != nil {
+		log.Fatal("connect:", err)
+	}
+	defer conn.Close()
+
+	runner := &evaluation.Runner{
+		Client:         pb.NewMedicalQAServiceClient(conn),
+		Concurrency:    *concurrency,
+		RequestTimeout: cfg.LLM.RequestTimeout,
+		PromptTemplate: template,
+	}
+	results := runner.Run(context.Background(), cases)
+
+	w, closeOut := output(*out)
+	defer closeOut()
+	if err := evaluation.WriteResults(w, results); err != nil {
+		log.Fatal(err)
+	}
+
+	summary := evaluation.Summarize(results)
+	fmt.Fprintf(os.Stderr, "%d cases, %d errors, similarity %.3f, keyword coverage %.3f, refusal accuracy %.3f\n",
+		summary.Cases, summary.Errors, summary.MeanSimilarity, summary.MeanKeywordCoverage, summary.RefusalAccuracy)
+}
+
+// compareRuns writes a Markdown report comparing two result files
+func compareRuns(args []string) {
+	flags := flag.NewFlagSet("compare", flag.ExitOnError)
+	maxCases := flags.Int("max-cases", 20, "changed cases to list")
+	out := flags.String("out", "", "file to write the report to (default: stdout)")
+	flags.Parse(args)
+	if flags.NArg() != 2 {
+		log.Fatal(usage)
+	}
+
+	baseline := loadResults(flags.Arg(0))
+	candidate := loadResults(flags.Arg(1))
+	w, closeOut := output(*out)
+	defer closeOut()
+	err := evaluation.WriteReport(w, runName(flags.Arg(0)), runName(flags.Arg(1)),
+		evaluation.Compare(baseline, candidate), *maxCases)
+	if err != nil {
+		log.Fatal("write report:", err)
+	}
+}
+
+// dial connects to the LLM service the way the backend does
+func dial(cfg config.LLMConfig) (*grpc.ClientConn, error) {
+	tlsCfg, err := server.NewClientTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	creds := insecure.NewCredentials()
+	if tlsCfg != nil {
+		creds = credentials.NewTLS(tlsCfg)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, cfg.Addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LLM service at %s: %v", cfg.Addr, err)
+	}
+	return conn, nil
+}
+
+func loadResults(path string) []evaluation.Result {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal("open results:", err)
+	}
+	defer f.Close()
+	results, err := evaluation.LoadResults(f)
+	if err != nil {
+		log.Fatalf("load %s: %v", path, err)
+	}
+	return results
+}
+
+// runName names a run after its results file
+func runName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// output returns the file to write to and a function closing it, stdout
+// when path is empty
+func output(path string) (io.Writer, func()) {
+	if path == "" {
+		return os.Stdout, func() {}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		log.Fatal("create output:", err)
+	}
+	return f, func() {
+		if err := f.Close(); err != nil {
+			log.Fatal("close output:", err)
+		}
+	}
+}
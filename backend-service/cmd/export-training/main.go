@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/encryption"
+	"llm-qa-system/backend-service/src/db"
+	"llm-qa-system/backend-service/training"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	format := flag.String("format", training.FormatChat, "dataset format: chat or preference")
+	start := flag.String("start", "", "only drafts created on or after this date (YYYY-MM-DD or RFC 3339)")
+	end := flag.String("end", "", "only drafts created before this date (default: now)")
+	reviewStatus := flag.String("status", "", "only drafts with this review status: approved, modified or rejected")
+	department := flag.String("department", "", "only drafts reviewed by doctors of this department, e.g. DEPT_CARDIOLOGY")
+	out := flag.String("out", "", "file to write the dataset to (default: stdout)")
+	reviewOut := flag.String("review", "", "file to write examples with words that may be names to, for review (default: drop them)")
+	flag.Parse()
+
+	filter := training.Filter{
+		Start:        time.Unix(0, 0),
+		End:          time.Now(),
+		ReviewStatus: *reviewStatus,
+		DepartmentID: *department,
+	}
+	var err error
+	if *start != "" {
+		if filter.Start, err = parseTime(*start); err != nil {
+			log.Fatal("invalid -start:", err)
+		}
+	}
+	if *end != "" {
+		if filter.End, err = parseTime(*end); err != nil {
+			log.Fatal("invalid -end:", err)
+		}
+	}
+	switch *reviewStatus {
+	case "", db.ReviewApproved, db.ReviewModified, db.ReviewRejected:
+	default:
+		log.Fatalf("invalid -status %q", *reviewStatus)
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.Database.URL)
+	if err != nil {
+		log.Fatal("connect:", err)
+	}
+	defer pool.Close()
+	cipher, err := encryption.FromConfig(pool, cfg.Encryption)
+	if err != nil {
+		log.Fatal("encryption:", err)
+	}
+
+	// Recorded before anything is written, so no export goes unaccounted for
+	_, err = audit.NewLog(pool).Append(ctx, audit.Event{
+		Action:    audit.ActionTrainingExport,
+		ActorRole: audit.RoleSystem,
+		ActorID:   "export-training",
+		Details: map[string]string{
+			"format":        *format,
+			"start":         filter.Start.UTC().Format(time.RFC3339),
+			"end":           filter.End.UTC().Format(time.RFC3339),
+			"review_status": filter.ReviewStatus,
+			"department_id": filter.DepartmentID,
+			"review":        strconv.FormatBool(*reviewOut != ""),
+		},
+	})
+	if err != nil {
+		log.Fatal("record audit event:", err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.OpenFile(*out, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			log.Fatal("create output:", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	var review io.Writer
+	if *reviewOut != "" {
+		f, err := os.OpenFile(*reviewOut, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			log.Fatal("create review file:", err)
+		}
+		defer f.Close()
+		review = f
+	}
+
+	result, err := training.Export(ctx, db.NewEncrypted(db.New(pool), cipher), filter, *format, w, review)
+	if err != nil {
+		log.Fatal("export:", err)
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d examples, skipped %d drafts, held back %d for review\n", result.Exported, result.Skipped, result.Flagged)
+}
+
+// parseTime accepts a date or an RFC 3339 timestamp
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/encryption"
+	"llm-qa-system/backend-service/fhir"
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const usage = `usage:
+  fhir import [-config file] bundle.json
+  fhir export [-config file] -patient-id ID [-out file]`
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+
+	switch os.Args[1] {
+	case "import":
+		runImport(os.Args[2:])
+	case "export":
+		runExport(os.Args[2:])
+	default:
+		log.Fatal(usage)
+	}
+}
+
+// runImport stores the patient record of a FHIR Bundle file
+func runImport(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	configPath := flags.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		log.Fatal(usage)
+	}
+
+	data, err := os.ReadFile(flags.Arg(0))
+	if err != nil {
+		log.Fatal("read bundle:", err)
+	}
+	var bundle fhir.Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		log.Fatal("parse bundle:", err)
+	}
+
+	ctx := context.Background()
+	pool, cipher := connect(ctx, *configPath)
+	defer pool.Close()
+
+	result, err := fhir.Import(ctx, pool, cipher, &bundle)
+	if err != nil {
+		log.Fatal("import:", err)
+	}
+	_, err = audit.NewLog(pool).Append(ctx, audit.Event{
+		Action:    audit.ActionFHIRImport,
+		ActorRole: audit.RoleSystem,
+		ActorID:   "fhir",
+		PatientID: result.PatientID,
+		Resource:  pg.ToUUID(result.PatientID).String(),
+		Details: map[string]string{
+			"patient_created": strconv.FormatBool(result.PatientCreated),
+			"conditions":      strconv.Itoa(result.Conditions),
+			"observations":    strconv.Itoa(result.Observations),
+		},
+	})
+	if err != nil {
+		log.Printf("failed to record audit event: %v", err)
+	}
+
+	action := "existing"
+	if result.PatientCreated {
+		action = "created"
+	}
+	fmt.Printf("Patient %s (%s)\n", pg.ToUUID(result.PatientID), action)
+	fmt.Printf("Conditions: %d imported, %d already recorded\n", result.Conditions, result.DuplicateConditions)
+	fmt.Printf("Observations: %d imported, %d already recorded\n", result.Observations, result.DuplicateObservations)
+	for _, reason := range result.Skipped {
+		fmt.Printf("Skipped %s\n", reason)
+	}
+}
+
+// runExport writes a patient's record as a FHIR Bundle
+func runExport(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	configPath := flags.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	patientID := flags.String("patient-id", "", "user ID of the patient to export")
+	out := flags.String("out", "", "file to write the bundle to (default: stdout)")
+	flags.Parse(args)
+
+	id, err := pg.ParseUUID(*patientID)
+	if err != nil {
+		log.Fatal("invalid -patient-id:", err)
+	}
+
+	ctx := context.Background()
+	pool, cipher := connect(ctx, *configPath)
+	defer pool.Close()
+
+	bundle, err := fhir.Export(ctx, db.NewEncrypted(db.New(pool), cipher), id)
+	if err != nil {
+		log.Fatal("export:", err)
+	}
+	// Nothing leaves the database unaccounted for
+	_, err = audit.NewLog(pool).Append(ctx, audit.Event{
+		Action:    audit.ActionFHIRExport,
+		ActorRole: audit.RoleSystem,
+		ActorID:   "fhir",
+		PatientID: id,
+		Resource:  *patientID,
+		Details:   map[string]string{"entries": strconv.Itoa(len(bundle.Entry))},
+	})
+	if err != nil {
+		log.Fatal("record audit event:", err)
+	}
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		log.Fatal("encode bundle:", err)
+	}
+	data = append(data, '\n')
+
+	if *out == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	if err := os.WriteFile(*out, data, 0o600); err != nil {
+		log.Fatal("write bundle:", err)
+	}
+}
+
+// connect opens the configured database and returns it with the configured
+// cipher of encrypted columns
+func connect(ctx context.Context, configPath string) (*pgxpool.Pool, db.FieldCipher) {
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	pool, err := pgxpool.New(ctx, cfg.Database.URL)
+	if err != nil {
+		log.Fatal("connect:", err)
+	}
+	cipher, err := encryption.FromConfig(pool, cfg.Encryption)
+	if err != nil {
+		log.Fatal("encryption:", err)
+	}
+	return pool, cipher
+}
@@ -0,0 +1,44 @@
+// Command patient-token issues a patient token for testing, signed with
+// auth.patient_token_secret. In production patients get theirs from whatever
+// authenticates them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	patientID := flag.String("patient-id", "", "user ID of the patient the token identifies")
+	ttl := flag.Duration("ttl", 24*time.Hour, "how long the token is valid")
+	flag.Parse()
+
+	if _, err := uuid.Parse(*patientID); err != nil {
+		log.Fatal("invalid -patient-id:", err)
+	}
+	if *ttl <= 0 {
+		log.Fatal("-ttl must be positive")
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	if cfg.Auth.PatientTokenSecret == "" {
+		log.Fatal("auth.patient_token_secret is not configured")
+	}
+
+	fmt.Println(authz.IssuePatientToken([]byte(cfg.Auth.PatientTokenSecret), *patientID, time.Now().Add(*ttl)))
+}
@@ -0,0 +1,53 @@
+// Command reencrypt rewrites message content, AI drafts and medical history
+// notes that are still plaintext or encrypted under a retired key encryption
+// key, after encryption was enabled or the keyfile's current key changed
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/encryption"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	batchSize := flag.Int("batch-size", 500, "rows read per query")
+	flag.Parse()
+
+	if *batchSize < 1 {
+		log.Fatal("-batch-size must be at least 1")
+	}
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if !cfg.Encryption.Enabled {
+		log.Fatal("encryption is not enabled in the configuration")
+	}
+	provider, err := encryption.LoadKeyFile(cfg.Encryption.KeyFile)
+	if err != nil {
+		log.Fatal("encryption:", err)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, cfg.Database.URL)
+	if err != nil {
+		log.Fatal("connect:", err)
+	}
+	defer pool.Close()
+
+	results, err := encryption.NewCipher(pool, provider).Reencrypt(ctx, int32(*batchSize))
+	for _, result := range results {
+		fmt.Printf("%s: %d checked, %d re-encrypted\n", result.Column, result.Checked, result.Reencrypted)
+	}
+	if err != nil {
+		log.Fatal("reencrypt:", err)
+	}
+}
@@ -0,0 +1,50 @@
+// Command staff-token issues the token a nurse, doctor or supervisor
+// connects to /ws with, signed with auth.staff_token_secret. The token
+// carries the staff member's user ID, which the server records their
+// reviews and messages under.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+
+	"github.com/google/uuid"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	role := flag.String("role", "doctor", "staff role: nurse, doctor or supervisor")
+	userID := flag.String("user-id", "", "user ID of the staff member the token identifies")
+	ttl := flag.Duration("ttl", 12*time.Hour, "how long the token is valid")
+	flag.Parse()
+
+	staffRole, err := authz.ParseRole(*role)
+	if err != nil || !staffRole.IsStaff() {
+		log.Fatalf("invalid -role %q: want nurse, doctor or supervisor", *role)
+	}
+	if _, err := uuid.Parse(*userID); err != nil {
+		log.Fatal("invalid -user-id:", err)
+	}
+	if *ttl <= 0 {
+		log.Fatal("-ttl must be positive")
+	}
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+	if cfg.Auth.StaffTokenSecret == "" {
+		log.Fatal("auth.staff_token_secret is not configured")
+	}
+
+	fmt.Println(authz.IssueStaffToken([]byte(cfg.Auth.StaffTokenSecret), staffRole, *userID, time.Now().Add(*ttl)))
+}
@@ -0,0 +1,213 @@
+// Package encryption encrypts message content and clinical notes at rest
+// with envelope encryption: values are encrypted with AES-256-GCM data keys,
+// which are stored wrapped by a key encryption key of a KeyProvider.
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// valuePrefix starts every encrypted value, followed by the data key ID and
+// the base64 nonce and ciphertext: enc:v2:<data key ID>:<base64>. Values are
+// bound to the table, column and row they are stored in.
+const valuePrefix = "enc:v2:"
+
+const dataKeySize = 32
+
+// dataKey is an unwrapped data key
+type dataKey struct {
+	id    string
+	keyID string // The KEK that wraps it
+	aead  cipher.AEAD
+}
+
+// Cipher encrypts values with the newest data key of the provider's current
+// KEK, creating one when there is none. It implements db.FieldCipher.
+type Cipher struct {
+	provider KeyProvider
+	q        *db.Queries
+
+	mu      sync.Mutex
+	current *dataKey
+	keys    map[string]*dataKey // By ID
+}
+
+func NewCipher(pool *pgxpool.Pool, provider KeyProvider) *Cipher {
+	return &Cipher{provider: provider, q: db.New(pool), keys: make(map[string]*dataKey)}
+}
+
+// FromConfig returns the cipher configured by cfg, or nil if encryption is
+// disabled
+func FromConfig(pool *pgxpool.Pool, cfg config.EncryptionConfig) (db.FieldCipher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	provider, err := LoadKeyFile(cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return NewCipher(pool, provider), nil
+}
+
+// Encrypt encrypts plaintext with the current data key, for storage in field
+func (c *Cipher) Encrypt(ctx context.Context, field db.Field, plaintext string) (string, error) {
+	if !field.Row.Valid {
+		return "", fmt.Errorf("no row to bind %s.%s to", field.Table, field.Column)
+	}
+	key, err := c.currentKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := seal(key.aead, []byte(plaintext), additionalData(key.id, field))
+	if err != nil {
+		return "", err
+	}
+	return valuePrefix + key.id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value Encrypt returned for field. Values stored in
+// another field fail to decrypt. Other values are plaintext stored before
+// encryption was enabled and are returned as is.
+func (c *Cipher) Decrypt(ctx context.Context, field db.Field, value string) (string, error) {
+	rest, ok := strings.CutPrefix(value, valuePrefix)
+	if !ok {
+		return value, nil
+	}
+	id, encoded, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", errors.New("malformed encrypted value")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %v", err)
+	}
+	key, err := c.key(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(key.aead, sealed, additionalData(key.id, field))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt %s.%s: %v", field.Table, field.Column, err)
+	}
+	return string(plaintext), nil
+}
+
+// IsEncrypted reports whether value was returned by Encrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, valuePrefix)
+}
+
+// NeedsReencryption reports whether value is plaintext or encrypted with a
+// data key whose KEK is no longer current
+func (c *Cipher) NeedsReencryption(ctx context.Context, value string) (bool, error) {
+	if !strings.HasPrefix(value, valuePrefix) {
+		return true, nil
+	}
+	id, _, _ := strings.Cut(strings.TrimPrefix(value, valuePrefix), ":")
+	key, err := c.key(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return key.keyID != c.provider.CurrentKeyID(), nil
+}
+
+// additionalData binds a value to the data key and the field it is stored in
+func additionalData(keyID string, field db.Field) []byte {
+	return []byte(strings.Join([]string{keyID, field.Table, field.Column, pg.ToUUID(field.Row).String()}, "\x00"))
+}
+
+// currentKey returns the data key to encrypt with
+func (c *Cipher) currentKey(ctx context.Context) (*dataKey, error) {
+	keyID := c.provider.CurrentKeyID()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.current != nil && c.current.keyID == keyID {
+		return c.current, nil
+	}
+
+	row, err := c.q.GetLatestDataKey(ctx, keyID)
+	switch {
+	case err == nil:
+	case errors.Is(err, pgx.ErrNoRows):
+		if row, err = c.createKey(ctx, keyID); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("failed to load data key: %v", err)
+	}
+	key, err := c.unwrap(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	c.current = key
+	c.keys[key.id] = key
+	return key, nil
+}
+
+// createKey stores a new data key wrapped by the KEK keyID
+func (c *Cipher) createKey(ctx context.Context, keyID string) (db.EncryptionDataKey, error) {
+	plain := make([]byte, dataKeySize)
+	if _, err := rand.Read(plain); err != nil {
+		return db.EncryptionDataKey{}, fmt.Errorf("failed to generate data key: %v", err)
+	}
+	wrapped, err := c.provider.WrapKey(ctx, keyID, plain)
+	if err != nil {
+		return db.EncryptionDataKey{}, fmt.Errorf("failed to wrap data key: %v", err)
+	}
+	id, err := c.q.CreateDataKey(ctx, db.CreateDataKeyParams{KeyID: keyID, WrappedKey: wrapped})
+	if err != nil {
+		return db.EncryptionDataKey{}, fmt.Errorf("failed to store data key: %v", err)
+	}
+	return db.EncryptionDataKey{ID: id, KeyID: keyID, WrappedKey: wrapped}, nil
+}
+
+// key returns the data key with the given ID
+func (c *Cipher) key(ctx context.Context, id string) (*dataKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if key, ok := c.keys[id]; ok {
+		return key, nil
+	}
+
+	uuid, err := pg.ParseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data key ID %q: %v", id, err)
+	}
+	row, err := c.q.GetDataKey(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load data key %s: %v", id, err)
+	}
+	key, err := c.unwrap(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	c.keys[id] = key
+	return key, nil
+}
+
+func (c *Cipher) unwrap(ctx context.Context, row db.EncryptionDataKey) (*dataKey, error) {
+	id := pg.ToUUID(row.ID).String()
+	plain, err := c.provider.UnwrapKey(ctx, row.KeyID, row.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key %s with key %q: %v", id, row.KeyID, err)
+	}
+	aead, err := newAEAD(plain)
+	if err != nil {
+		return nil, err
+	}
+	return &dataKey{id: id, keyID: row.KeyID, aead: aead}, nil
+}
@@ -0,0 +1,251 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// writeKeyFile writes a keyfile with random KEKs and returns its path
+func writeKeyFile(t *testing.T, current string, ids ...string) string {
+	t.Helper()
+	file := keyFile{Current: current, Keys: make(map[string]string)}
+	for _, id := range ids {
+		key := make([]byte, dataKeySize)
+		if _, err := rand.Read(key); err != nil {
+			t.Fatal(err)
+		}
+		file.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+	data, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "keys.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newTestCipher returns a cipher over a local keyfile whose data keys are
+// cached, so it never needs the database
+func newTestCipher(t *testing.T, current string, ids ...string) *Cipher {
+	t.Helper()
+	provider, err := LoadKeyFile(writeKeyFile(t, current, ids...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCipher(nil, provider)
+	addDataKey(t, c, current)
+	return c
+}
+
+// addDataKey caches a new data key wrapped by the KEK keyID, current if that
+// KEK is
+func addDataKey(t *testing.T, c *Cipher, keyID string) *dataKey {
+	t.Helper()
+	plain := make([]byte, dataKeySize)
+	if _, err := rand.Read(plain); err != nil {
+		t.Fatal(err)
+	}
+	wrapped, err := c.provider.WrapKey(context.Background(), keyID, plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := c.unwrap(context.Background(), db.EncryptionDataKey{ID: pg.NewUUID(), KeyID: keyID, WrappedKey: wrapped})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.keys[key.id] = key
+	if keyID == c.provider.CurrentKeyID() {
+		c.current = key
+	}
+	return key
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCipher(t, "2024-06", "2024-06")
+	field := db.ChatMessageContentField(pg.NewUUID())
+
+	for _, plaintext := range []string{"", "I have had a headache for three days", "Schmerzen im Rücken 🤕", strings.Repeat("x", 10000)} {
+		encrypted, err := c.Encrypt(ctx, field, plaintext)
+		if err != nil {
+			t.Fatalf("Encrypt(%q) error = %v", plaintext, err)
+		}
+		if !strings.HasPrefix(encrypted, valuePrefix) || !IsEncrypted(encrypted) {
+			t.Errorf("Encrypt(%q) = %q, want a %s value", plaintext, encrypted, valuePrefix)
+		}
+		if plaintext != "" && strings.Contains(encrypted, plaintext) {
+			t.Errorf("Encrypt(%q) contains the plaintext", plaintext)
+		}
+		decrypted, err := c.Decrypt(ctx, field, encrypted)
+		if err != nil || decrypted != plaintext {
+			t.Errorf("Decrypt(Encrypt(%q)) = %q, %v", plaintext, decrypted, err)
+		}
+	}
+}
+
+func TestEncryptRequiresRow(t *testing.T) {
+	c := newTestCipher(t, "2024-06", "2024-06")
+	if _, err := c.Encrypt(context.Background(), db.MedicalHistoryNotesField(pgtype.UUID{}), "notes"); err == nil {
+		t.Fatal("Encrypt() without a row succeeded")
+	}
+}
+
+func TestDecryptIsBoundToField(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCipher(t, "2024-06", "2024-06")
+	row := pg.NewUUID()
+	field := db.AIInteractionField("ai_response", row)
+	encrypted, err := c.Encrypt(ctx, field, "Take ibuprofen with food")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		field   db.Field
+		wantErr bool
+	}{
+		{"same field", field, false},
+		{"other row", db.AIInteractionField("ai_response", pg.NewUUID()), true},
+		{"other column", db.AIInteractionField("modified_content", row), true},
+		{"other table", db.ChatMessageContentField(row), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Decrypt(ctx, tt.field, encrypted)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decrypt() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCipher(t, "2024-06", "2024-06")
+	field := db.ChatMessageContentField(pg.NewUUID())
+	encrypted, err := c.Encrypt(ctx, field, "chest pain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix, encoded, _ := strings.Cut(encrypted[len(valuePrefix):], ":")
+	sealed, _ := base64.StdEncoding.DecodeString(encoded)
+	sealed[len(sealed)-1] ^= 1
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"flipped bit", valuePrefix + prefix + ":" + base64.StdEncoding.EncodeToString(sealed)},
+		{"not base64", valuePrefix + prefix + ":not base64!"},
+		{"no key", valuePrefix + "garbage"},
+		{"unknown key", valuePrefix + "not-a-uuid:" + encoded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.Decrypt(ctx, field, tt.value); err == nil {
+				t.Fatal("Decrypt() succeeded")
+			}
+		})
+	}
+}
+
+func TestDecryptPlaintext(t *testing.T) {
+	c := newTestCipher(t, "2024-06", "2024-06")
+	for _, value := range []string{"", "stored before encryption", "enc:v3:unknown"} {
+		got, err := c.Decrypt(context.Background(), db.ChatMessageContentField(pg.NewUUID()), value)
+		if err != nil || got != value {
+			t.Errorf("Decrypt(%q) = %q, %v, want it unchanged", value, got, err)
+		}
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCipher(t, "2024-01", "2024-01", "2024-06")
+	field := db.ChatMessageContentField(pg.NewUUID())
+	old, err := c.Encrypt(ctx, field, "follow up in two weeks")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rotate to the next KEK, as a changed keyfile would
+	c.provider.(*LocalKeyProvider).current = "2024-06"
+	newKey := addDataKey(t, c, "2024-06")
+	rotated, err := c.Encrypt(ctx, field, "follow up in two weeks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(rotated, newKey.id) {
+		t.Errorf("Encrypt() after rotation = %q, want data key %s", rotated, newKey.id)
+	}
+
+	tests := []struct {
+		name      string
+		value     string
+		wantStale bool
+	}{
+		{"retired KEK", old, true},
+		{"current KEK", rotated, false},
+		{"plaintext", "follow up in two weeks", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stale, err := c.NeedsReencryption(ctx, tt.value)
+			if err != nil || stale != tt.wantStale {
+				t.Fatalf("NeedsReencryption() = %v, %v, want %v", stale, err, tt.wantStale)
+			}
+			// Values under a retired KEK still decrypt until re-encrypted
+			got, err := c.Decrypt(ctx, field, tt.value)
+			if err != nil || got != "follow up in two weeks" {
+				t.Fatalf("Decrypt() = %q, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestLoadKeyFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"valid", `{"current": "a", "keys": {"a": "` + base64.StdEncoding.EncodeToString(make([]byte, dataKeySize)) + `"}}`, ""},
+		{"current missing", `{"current": "b", "keys": {"a": "` + base64.StdEncoding.EncodeToString(make([]byte, dataKeySize)) + `"}}`, "not among its keys"},
+		{"short key", `{"current": "a", "keys": {"a": "` + base64.StdEncoding.EncodeToString(make([]byte, 16)) + `"}}`, "must be 32 bytes"},
+		{"not base64", `{"current": "a", "keys": {"a": "!!"}}`, "not base64"},
+		{"not json", `current: a`, "failed to parse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadKeyFile(path)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("LoadKeyFile() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("LoadKeyFile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// KeyProvider holds the key encryption keys (KEKs) that wrap data keys.
+// Wrapped data keys name the KEK that wrapped them, so retired KEKs must stay
+// available until everything under them has been re-encrypted.
+type KeyProvider interface {
+	// CurrentKeyID names the KEK new data keys are wrapped with
+	CurrentKeyID() string
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// keyFile is the format of a local keyfile:
+//
+//	{"current": "2024-06", "keys": {"2024-01": "<base64>", "2024-06": "<base64>"}}
+//
+// Every key is 32 random bytes.
+type keyFile struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// LocalKeyProvider wraps data keys with AES-256-GCM under KEKs read from a
+// keyfile. It is meant for development, the keyfile holds the KEKs in the
+// clear.
+type LocalKeyProvider struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// LoadKeyFile reads the KEKs of a local keyfile
+func LoadKeyFile(path string) (*LocalKeyProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %v", err)
+	}
+	var file keyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse key file %s: %v", path, err)
+	}
+	if _, ok := file.Keys[file.Current]; !ok {
+		return nil, fmt.Errorf("key file %s: current key %q is not among its keys", path, file.Current)
+	}
+
+	p := &LocalKeyProvider{current: file.Current, keys: make(map[string]cipher.AEAD)}
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key file %s: key %q is not base64: %v", path, id, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("key file %s: key %q must be %d bytes, got %d", path, id, dataKeySize, len(key))
+		}
+		if p.keys[id], err = newAEAD(key); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *LocalKeyProvider) CurrentKeyID() string {
+	return p.current
+}
+
+func (p *LocalKeyProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	return seal(aead, dataKey, []byte(keyID))
+}
+
+func (p *LocalKeyProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+// KMSClient is the part of a key management service API the KMS provider
+// needs. Implementations call the service; keys never leave it.
+type KMSClient interface {
+	Encrypt(ctx context.Context, keyID string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte) ([]byte, error)
+}
+
+// KMSProvider wraps data keys with a key management service. Rotating the
+// KEK means passing the new key ID, the old one stays usable in the service.
+type KMSProvider struct {
+	client KMSClient
+	keyID  string
+}
+
+func NewKMSProvider(client KMSClient, keyID string) *KMSProvider {
+	return &KMSProvider{client: client, keyID: keyID}
+}
+
+func (p *KMSProvider) CurrentKeyID() string {
+	return p.keyID
+}
+
+func (p *KMSProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	return p.client.Encrypt(ctx, keyID, dataKey)
+}
+
+func (p *KMSProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	return p.client.Decrypt(ctx, keyID, wrapped)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext under a random nonce, which it prefixes to the
+// result
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %v", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open reverses seal
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %v", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,194 @@
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"llm-qa-system/backend-service/src/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ReencryptResult counts the values of a column checked and rewritten
+type ReencryptResult struct {
+	Column      string
+	Checked     int
+	Reencrypted int
+}
+
+// stored is a value of an encrypted column and where it is stored
+type stored struct {
+	id    pgtype.UUID
+	field db.Field
+	value string
+}
+
+// column reads and rewrites one encrypted column in ID order
+type column struct {
+	name string
+	// list returns the batch of values after id
+	list func(ctx context.Context, q *db.Queries, after pgtype.UUID, limit int32) ([]stored, error)
+	// update replaces old with value, reporting whether it was still stored
+	update func(ctx context.Context, q *db.Queries, id pgtype.UUID, old, value string) (bool, error)
+}
+
+var columns = []column{
+	{
+		name: "chat_messages.content",
+		list: func(ctx context.Context, q *db.Queries, after pgtype.UUID, limit int32) ([]stored, error) {
+			rows, err := q.ListChatMessageContent(ctx, db.ListChatMessageContentParams{ID: after, Limit: limit})
+			values := make([]stored, len(rows))
+			for i, row := range rows {
+				values[i] = stored{row.ID, db.ChatMessageContentField(row.ID), row.Content}
+			}
+			return values, err
+		},
+		update: func(ctx context.Context, q *db.Queries, id pgtype.UUID, old, value string) (bool, error) {
+			n, err := q.UpdateChatMessageContent(ctx, db.UpdateChatMessageContentParams{ID: id, Content: value, OldContent: old})
+			return n > 0, err
+		},
+	},
+	{
+		name: "ai_interactions.ai_response",
+		list: func(ctx context.Context, q *db.Queries, after pgtype.UUID, limit int32) ([]stored, error) {
+			rows, err := q.ListAIInteractionResponses(ctx, db.ListAIInteractionResponsesParams{ID: after, Limit: limit})
+			values := make([]stored, len(rows))
+			for i, row := range rows {
+				values[i] = stored{row.ID, db.AIInteractionField("ai_response", row.ChatMessageID), row.AiResponse}
+			}
+			return values, err
+		},
+		update: func(ctx context.Context, q *db.Queries, id pgtype.UUID, old, value string) (bool, error) {
+			n, err := q.UpdateAIInteractionResponse(ctx, db.UpdateAIInteractionResponseParams{ID: id, AiResponse: value, OldAiResponse: old})
+			return n > 0, err
+		},
+	},
+	{
+		name: "ai_interactions.modified_content",
+		list: func(ctx context.Context, q *db.Queries, after pgtype.UUID, limit int32) ([]stored, error) {
+			rows, err := q.ListAIInteractionModifiedContent(ctx, db.ListAIInteractionModifiedContentParams{ID: after, Limit: limit})
+			values := make([]stored, len(rows))
+			for i, row := range rows {
+				values[i] = stored{row.ID, db.AIInteractionField("modified_content", row.ChatMessageID), row.ModifiedContent.String}
+			}
+			return values, err
+		},
+		update: func(ctx context.Context, q *db.Queries, id pgtype.UUID, old, value string) (bool, error) {
+			n, err := q.UpdateAIInteractionModifiedContent(ctx, db.UpdateAIInteractionModifiedContentParams{
+				ID:                 id,
+				ModifiedContent:    pgtype.Text{String: value, Valid: true},
+				OldModifiedContent: pgtype.Text{String: old, Valid: true},
+			})
+			return n > 0, err
+		},
+	},
+	{
+		// Encrypted components are stored as a JSON string, plaintext ones as
+		// the JSON object itself
+		name: "ai_interactions.prompt_components",
+		list: func(ctx context.Context, q *db.Queries, after pgtype.UUID, limit int32) ([]stored, error) {
+			rows, err := q.ListAIInteractionPromptComponents(ctx, db.ListAIInteractionPromptComponentsParams{ID: after, Limit: limit})
+			values := make([]stored, len(rows))
+			for i, row := range rows {
+				value := string(row.PromptComponents)
+				var encrypted string
+				if json.Unmarshal(row.PromptComponents, &encrypted) == nil && IsEncrypted(encrypted) {
+					value = encrypted
+				}
+				values[i] = stored{row.ID, db.AIInteractionField("prompt_components", row.ChatMessageID), value}
+			}
+			return values, err
+		},
+		update: func(ctx context.Context, q *db.Queries, id pgtype.UUID, old, value string) (bool, error) {
+			encoded := func(value string) []byte {
+				if !IsEncrypted(value) {
+					return []byte(value)
+				}
+				data, _ := json.Marshal(value)
+				return data
+			}
+			n, err := q.UpdateAIInteractionPromptComponents(ctx, db.UpdateAIInteractionPromptComponentsParams{
+				ID:                  id,
+				PromptComponents:    encoded(value),
+				OldPromptComponents: encoded(old),
+			})
+			return n > 0, err
+		},
+	},
+	{
+		name: "medical_history.notes",
+		list: func(ctx context.Context, q *db.Queries, after pgtype.UUID, limit int32) ([]stored, error) {
+			rows, err := q.ListMedicalHistoryNotes(ctx, db.ListMedicalHistoryNotesParams{ID: after, Limit: limit})
+			values := make([]stored, len(rows))
+			for i, row := range rows {
+				values[i] = stored{row.ID, db.MedicalHistoryNotesField(row.ID), row.Notes.String}
+			}
+			return values, err
+		},
+		update: func(ctx context.Context, q *db.Queries, id pgtype.UUID, old, value string) (bool, error) {
+			n, err := q.UpdateMedicalHistoryNotes(ctx, db.UpdateMedicalHistoryNotesParams{
+				ID:       id,
+				Notes:    pgtype.Text{String: value, Valid: true},
+				OldNotes: pgtype.Text{String: old, Valid: true},
+			})
+			return n > 0, err
+		},
+	},
+}
+
+// Reencrypt rewrites every value of the encrypted columns that is plaintext
+// or under a data key of a retired KEK with the current data key. Values
+// changed while it runs are left to the writer, which encrypts them itself.
+// It is safe to run again after an interruption.
+func (c *Cipher) Reencrypt(ctx context.Context, batchSize int32) ([]ReencryptResult, error) {
+	var results []ReencryptResult
+	for _, col := range columns {
+		result, err := c.reencryptColumn(ctx, col, batchSize)
+		results = append(results, result)
+		if err != nil {
+			return results, fmt.Errorf("%s: %v", col.name, err)
+		}
+	}
+	return results, nil
+}
+
+func (c *Cipher) reencryptColumn(ctx context.Context, col column, batchSize int32) (ReencryptResult, error) {
+	result := ReencryptResult{Column: col.name}
+	after := pgtype.UUID{Valid: true} // Sorts before every other ID
+	for {
+		values, err := col.list(ctx, c.q, after, batchSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to list values: %v", err)
+		}
+		for _, v := range values {
+			result.Checked++
+			stale, err := c.NeedsReencryption(ctx, v.value)
+			if err != nil {
+				return result, err
+			}
+			if !stale {
+				continue
+			}
+			plaintext, err := c.Decrypt(ctx, v.field, v.value)
+			if err != nil {
+				return result, err
+			}
+			value, err := c.Encrypt(ctx, v.field, plaintext)
+			if err != nil {
+				return result, err
+			}
+			updated, err := col.update(ctx, c.q, v.id, v.value, value)
+			if err != nil {
+				return result, fmt.Errorf("failed to update value: %v", err)
+			}
+			if updated {
+				result.Reencrypted++
+			}
+		}
+		if len(values) < int(batchSize) {
+			return result, nil
+		}
+		after = values[len(values)-1].id
+	}
+}
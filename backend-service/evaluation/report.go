@@ -0,0 +1,223 @@
+package evaluation
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// significantDelta is the smallest score change listed per case
+const significantDelta = 0.05
+
+// Summary aggregates the results of a run. Means are over the drafts the
+// score applies to, failed requests are only counted in Errors.
+type Summary struct {
+	Cases               int
+	Errors              int
+	MeanKeywordCoverage float64 // Over cases with keywords
+	MeanSimilarity      float64 // Over cases with a reference answer
+	MeanLengthRatio     float64 // Over cases with a reference answer
+	MeanWords           float64
+	RefusalRate         float64
+	RefusalAccuracy     float64 // Share of drafts refusing exactly when expected
+	MeanConfidence      float64
+	P50LatencyMs        int64
+	P95LatencyMs        int64
+}
+
+// CaseChange is how the draft of a case changed between two runs
+type CaseChange struct {
+	CaseID               string
+	SimilarityDelta      float64
+	KeywordCoverageDelta float64
+	RefusalBefore        bool
+	RefusalAfter         bool
+	ErrorBefore          string
+	ErrorAfter           string
+}
+
+// Comparison of a candidate run against a baseline run
+type Comparison struct {
+	Baseline, Candidate Summary
+	Changes             []CaseChange // Significant changes, largest first
+	OnlyInBaseline      []string     // Case IDs the candidate run lacks
+	OnlyInCandidate     []string
+}
+
+// Summarize aggregates results
+func Summarize(results []Result) Summary {
+	s := Summary{Cases: len(results)}
+	var ok, withKeywords, withReference, refused, refusalCorrect int
+	var latencies []int64
+	for _, r := range results {
+		latencies = append(latencies, r.LatencyMs)
+		if r.Error != "" {
+			s.Errors++
+			continue
+		}
+		ok++
+		s.MeanWords += float64(r.Scores.Words)
+		s.MeanConfidence += float64(r.Confidence)
+		if r.Scores.KeywordsTotal > 0 {
+			withKeywords++
+			s.MeanKeywordCoverage += r.Scores.KeywordCoverage
+		}
+		if r.Scores.HasReference {
+			withReference++
+			s.MeanSimilarity += r.Scores.Similarity
+			s.MeanLengthRatio += r.Scores.LengthRatio
+		}
+		if r.Scores.Refused {
+			refused++
+		}
+		if r.Scores.RefusalCorrect {
+			refusalCorrect++
+		}
+	}
+	if ok > 0 {
+		s.MeanWords /= float64(ok)
+		s.MeanConfidence /= float64(ok)
+		s.RefusalRate = float64(refused) / float64(ok)
+		s.RefusalAccuracy = float64(refusalCorrect) / float64(ok)
+	}
+	if withKeywords > 0 {
+		s.MeanKeywordCoverage /= float64(withKeywords)
+	}
+	if withReference > 0 {
+		s.MeanSimilarity /= float64(withReference)
+		s.MeanLengthRatio /= float64(withReference)
+	}
+	if n := len(latencies); n > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		s.P50LatencyMs = latencies[(n-1)/2]
+		s.P95LatencyMs = latencies[int(math.Ceil(0.95*float64(n)))-1]
+	}
+	return s
+}
+
+// Compare matches the results of two runs by case ID
+func Compare(baseline, candidate []Result) Comparison {
+	c := Comparison{Baseline: Summarize(baseline), Candidate: Summarize(candidate)}
+	before := make(map[string]Result, len(baseline))
+	for _, r := range baseline {
+		before[r.CaseID] = r
+	}
+	after := make(map[string]bool, len(candidate))
+	for _, r := range candidate {
+		after[r.CaseID] = true
+		b, ok := before[r.CaseID]
+		if !ok {
+			c.OnlyInCandidate = append(c.OnlyInCandidate, r.CaseID)
+			continue
+		}
+		change := CaseChange{
+			CaseID:               r.CaseID,
+			SimilarityDelta:      r.Scores.Similarity - b.Scores.Similarity,
+			KeywordCoverageDelta: r.Scores.KeywordCoverage - b.Scores.KeywordCoverage,
+			RefusalBefore:        b.Scores.Refused,
+			RefusalAfter:         r.Scores.Refused,
+			ErrorBefore:          b.Error,
+			ErrorAfter:           r.Error,
+		}
+		if change.significant() {
+			c.Changes = append(c.Changes, change)
+		}
+	}
+	for _, r := range baseline {
+		if !after[r.CaseID] {
+			c.OnlyInBaseline = append(c.OnlyInBaseline, r.CaseID)
+		}
+	}
+	sort.SliceStable(c.Changes, func(i, j int) bool {
+		return c.Changes[i].magnitude() > c.Changes[j].magnitude()
+	})
+	return c
+}
+
+// significant reports whether the change is worth listing
+func (c CaseChange) significant() bool {
+	return c.RefusalBefore != c.RefusalAfter ||
+		(c.ErrorBefore == "") != (c.ErrorAfter == "") ||
+		math.Abs(c.SimilarityDelta) >= significantDelta ||
+		math.Abs(c.KeywordCoverageDelta) >= significantDelta
+}
+
+// magnitude orders changes, refusal and error flips first
+func (c CaseChange) magnitude() float64 {
+	m := math.Abs(c.SimilarityDelta) + math.Abs(c.KeywordCoverageDelta)
+	if c.RefusalBefore != c.RefusalAfter || (c.ErrorBefore == "") != (c.ErrorAfter == "") {
+		m += 10
+	}
+	return m
+}
+
+// WriteReport writes the comparison as Markdown, listing at most maxChanges
+// cases
+func WriteReport(w io.Writer, baselineName, candidateName string, c Comparison, maxChanges int) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Evaluation: %s vs %s\n\n", candidateName, baselineName)
+	fmt.Fprintf(&b, "| Metric | %s | %s | Change |\n|---|---:|---:|---:|\n", baselineName, candidateName)
+	rows := []struct {
+		name          string
+		before, after float64
+		format        string
+	}{
+		{"Cases", float64(c.Baseline.Cases), float64(c.Candidate.Cases), "%.0f"},
+		{"Errors", float64(c.Baseline.Errors), float64(c.Candidate.Errors), "%.0f"},
+		{"Keyword coverage", c.Baseline.MeanKeywordCoverage, c.Candidate.MeanKeywordCoverage, "%.3f"},
+		{"Similarity to reference", c.Baseline.MeanSimilarity, c.Candidate.MeanSimilarity, "%.3f"},
+		{"Length ratio to reference", c.Baseline.MeanLengthRatio, c.Candidate.MeanLengthRatio, "%.2f"},
+		{"Words", c.Baseline.MeanWords, c.Candidate.MeanWords, "%.1f"},
+		{"Refusal rate", c.Baseline.RefusalRate, c.Candidate.RefusalRate, "%.3f"},
+		{"Refusal accuracy", c.Baseline.RefusalAccuracy, c.Candidate.RefusalAccuracy, "%.3f"},
+		{"Confidence", c.Baseline.MeanConfidence, c.Candidate.MeanConfidence, "%.3f"},
+		{"Latency p50 (ms)", float64(c.Baseline.P50LatencyMs), float64(c.Candidate.P50LatencyMs), "%.0f"},
+		{"Latency p95 (ms)", float64(c.Baseline.P95LatencyMs), float64(c.Candidate.P95LatencyMs), "%.0f"},
+	}
+	for _, row := range rows {
+		fmt.Fprintf(&b, "| %s | "+row.format+" | "+row.format+" | %+"+row.format[1:]+" |\n",
+			row.name, row.before, row.after, row.after-row.before)
+	}
+
+	fmt.Fprintf(&b, "\n## Changed cases\n\n")
+	if len(c.Changes) == 0 {
+		fmt.Fprintf(&b, "No case changed by %.2f or more.\n", significantDelta)
+	} else {
+		fmt.Fprintf(&b, "| Case | Similarity | Keyword coverage | Refused | Error |\n|---|---:|---:|---|---|\n")
+		for i, change := range c.Changes {
+			if i == maxChanges {
+				fmt.Fprintf(&b, "\n%d more cases changed.\n", len(c.Changes)-maxChanges)
+				break
+			}
+			fmt.Fprintf(&b, "| %s | %+.3f | %+.3f | %s | %s |\n", change.CaseID,
+				change.SimilarityDelta, change.KeywordCoverageDelta,
+				transition(change.RefusalBefore, change.RefusalAfter),
+				transition(change.ErrorBefore != "", change.ErrorAfter != ""))
+		}
+	}
+	if len(c.OnlyInBaseline) > 0 {
+		fmt.Fprintf(&b, "\nOnly in %s: %s\n", baselineName, strings.Join(c.OnlyInBaseline, ", "))
+	}
+	if len(c.OnlyInCandidate) > 0 {
+		fmt.Fprintf(&b, "\nOnly in %s: %s\n", candidateName, strings.Join(c.OnlyInCandidate, ", "))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// transition describes a flag before and after
+func transition(before, after bool) string {
+	switch {
+	case before == after && after:
+		return "yes"
+	case before == after:
+		return "no"
+	case after:
+		return "no → yes"
+	default:
+		return "yes → no"
+	}
+}
@@ -0,0 +1,177 @@
+// Package evaluation replays a set of questions against the LLM service,
+// scores the drafts and compares runs
+package evaluation
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxLineBytes caps a line of a cases or results file
+const maxLineBytes = 1 << 20
+
+// Case is a question of an evaluation set
+type Case struct {
+	ID       string `json:"id"`
+	Question string `json:"question"`
+	// UserContext is the JSON form of a pb.UserContext, e.g.
+	// {"user_info": {"age": "54", "gender": "GENDER_FEMALE"}}
+	UserContext     json.RawMessage `json:"user_context,omitempty"`
+	ReferenceAnswer string          `json:"reference_answer,omitempty"` // Doctor-approved answer
+	Keywords        []string        `json:"keywords,omitempty"`         // Terms a good answer mentions
+	ExpectRefusal   bool            `json:"expect_refusal,omitempty"`   // The service should decline to answer
+}
+
+// Result is the draft of a Case and its scores
+type Result struct {
+	CaseID     string  `json:"case_id"`
+	Answer     string  `json:"answer"`
+	Confidence float32 `json:"confidence"`
+	LatencyMs  int64   `json:"latency_ms"`
+	Error      string  `json:"error,omitempty"`
+	Scores     Scores  `json:"scores"`
+}
+
+// LoadCases reads an evaluation set of one JSON Case per line. Blank lines
+// are skipped.
+func LoadCases(r io.Reader) ([]Case, error) {
+	var cases []Case
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var c Case
+		if err := json.Unmarshal(scanner.Bytes(), &c); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		if c.ID == "" || strings.TrimSpace(c.Question) == "" {
+			return nil, fmt.Errorf("line %d: id and question are required", line)
+		}
+		if seen[c.ID] {
+			return nil, fmt.Errorf("line %d: duplicate id %q", line, c.ID)
+		}
+		if len(c.UserContext) > 0 {
+			if err := protojson.Unmarshal(c.UserContext, &pb.UserContext{}); err != nil {
+				return nil, fmt.Errorf("line %d: invalid user_context: %v", line, err)
+			}
+		}
+		seen[c.ID] = true
+		cases = append(cases, c)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cases: %v", err)
+	}
+	return cases, nil
+}
+
+// Runner asks the LLM service for the drafts of an evaluation set
+type Runner struct {
+	Client         pb.MedicalQAServiceClient
+	Concurrency    int           // Requests in flight, at least 1
+	RequestTimeout time.Duration // Per draft, none when 0
+	PromptTemplate string        // Instructions to test, the service default when empty
+}
+
+// Run returns the scored result of each case, in the order of cases. A
+// failed request is recorded in its Result and does not stop the run.
+func (r *Runner) Run(ctx context.Context, cases []Case) []Result {
+	results := make([]Result, len(cases))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(r.Concurrency, 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = r.runCase(ctx, cases[i])
+			}
+		}()
+	}
+	for i := range cases {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// runCase asks for and scores the draft of c
+func (r *Runner) runCase(ctx context.Context, c Case) Result {
+	result := Result{CaseID: c.ID}
+	userContext := &pb.UserContext{}
+	if len(c.UserContext) > 0 {
+		// Checked by LoadCases
+		protojson.Unmarshal(c.UserContext, userContext)
+	}
+	questionID := uuid.New()
+	req := &pb.QuestionRequest{
+		QuestionId:     &pb.UUID{Value: questionID[:]},
+		QuestionText:   c.Question,
+		UserContext:    userContext,
+		PromptTemplate: r.PromptTemplate,
+	}
+
+	if r.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.RequestTimeout)
+		defer cancel()
+	}
+	start := time.Now()
+	resp, err := r.Client.GenerateDraftAnswer(ctx, req)
+	result.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Answer = resp.DraftAnswer
+	result.Confidence = resp.ConfidenceScore
+	result.Scores = Score(c, resp.DraftAnswer)
+	return result
+}
+
+// WriteResults writes one JSON Result per line
+func WriteResults(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for _, result := range results {
+		if err := enc.Encode(result); err != nil {
+			return fmt.Errorf("failed to write result: %v", err)
+		}
+	}
+	return nil
+}
+
+// LoadResults reads a file written by WriteResults
+func LoadResults(r io.Reader) ([]Result, error) {
+	var results []Result
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var result Result
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		results = append(results, result)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read results: %v", err)
+	}
+	return results, nil
+}
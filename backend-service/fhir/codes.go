@@ -0,0 +1,149 @@
+package fhir
+
+import "strings"
+
+// Code systems
+const (
+	LOINCSystem               = "http://loinc.org"
+	UCUMSystem                = "http://unitsofmeasure.org"
+	ConditionClinicalSystem   = "http://terminology.hl7.org/CodeSystem/condition-clinical"
+	ObservationCategorySystem = "http://terminology.hl7.org/CodeSystem/observation-category"
+)
+
+// AgeExtensionURL carries the patient's age, the patients table stores no
+// birth date
+const AgeExtensionURL = "https://llm-qa-system/fhir/StructureDefinition/patient-age"
+
+// ImportSource is stored as the source of imported readings that name no
+// device
+const ImportSource = "fhir"
+
+const bloodPressureType = "BLOOD_PRESSURE"
+
+// Blood pressure is exported as a panel with systolic and diastolic
+// components
+const (
+	bloodPressurePanelCode = "85354-9"
+	systolicCode           = "8480-6"
+	diastolicCode          = "8462-4"
+)
+
+// loincCodes maps ref_biometric_types IDs to the LOINC code they export as
+var loincCodes = map[string]Coding{
+	"BLOOD_PRESSURE":    {System: LOINCSystem, Code: bloodPressurePanelCode, Display: "Blood pressure panel with all children optional"},
+	"HEART_RATE":        {System: LOINCSystem, Code: "8867-4", Display: "Heart rate"},
+	"TEMPERATURE":       {System: LOINCSystem, Code: "8310-5", Display: "Body temperature"},
+	"BLOOD_GLUCOSE":     {System: LOINCSystem, Code: "2339-0", Display: "Glucose [Mass/volume] in Blood"},
+	"WEIGHT":            {System: LOINCSystem, Code: "29463-7", Display: "Body weight"},
+	"HEIGHT":            {System: LOINCSystem, Code: "8302-2", Display: "Body height"},
+	"BMI":               {System: LOINCSystem, Code: "39156-5", Display: "Body mass index (BMI) [Ratio]"},
+	"OXYGEN_SATURATION": {System: LOINCSystem, Code: "59408-5", Display: "Oxygen saturation in Arterial blood by Pulse oximetry"},
+	"RESPIRATORY_RATE":  {System: LOINCSystem, Code: "9279-1", Display: "Respiratory rate"},
+	"STEPS":             {System: LOINCSystem, Code: "55423-8", Display: "Number of steps in unspecified time Pedometer"},
+}
+
+// biometricTypes maps LOINC codes to ref_biometric_types IDs. Besides the
+// exported codes it accepts common alternatives partner systems send.
+var biometricTypes = map[string]string{
+	"55284-4": "BLOOD_PRESSURE",    // Blood pressure systolic and diastolic
+	"2708-6":  "OXYGEN_SATURATION", // Oxygen saturation in Arterial blood
+	"41653-7": "BLOOD_GLUCOSE",     // Glucose in Capillary blood by Glucometer
+	"8331-1":  "TEMPERATURE",       // Oral temperature
+	"3141-9":  "WEIGHT",            // Body weight Measured
+}
+
+func init() {
+	for typeID, coding := range loincCodes {
+		biometricTypes[coding.Code] = typeID
+	}
+}
+
+// ucumUnits maps ref_biometric_types units to UCUM codes
+var ucumUnits = map[string]string{
+	"mmHg":  "mm[Hg]",
+	"bpm":   "/min",
+	"°C":    "Cel",
+	"mg/dL": "mg/dL",
+	"kg":    "kg",
+	"cm":    "cm",
+	"kg/m²": "kg/m2",
+	"%":     "%",
+	"steps": "{steps}",
+}
+
+// unitAliases maps other spellings partner systems use to the unit they are
+// stored as
+var unitAliases = map[string]string{
+	"{beats}/min":   "bpm",
+	"{breaths}/min": "bpm",
+	"beats/min":     "bpm",
+	"breaths/min":   "bpm",
+	"mm Hg":         "mmHg",
+	"degC":          "°C",
+	"{step}":        "steps",
+	"{count}":       "steps",
+}
+
+// storedUnit returns the ref_biometric_types unit of a FHIR quantity. Units
+// are not converted, a quantity in another unit of the same dimension does
+// not match.
+func storedUnit(q *Quantity) string {
+	for _, unit := range []string{q.Code, q.Unit} {
+		unit = strings.TrimSpace(unit)
+		if unit == "" {
+			continue
+		}
+		if stored, ok := unitAliases[unit]; ok {
+			return stored
+		}
+		for stored, ucum := range ucumUnits {
+			if unit == ucum || strings.EqualFold(unit, stored) {
+				return stored
+			}
+		}
+	}
+	return strings.TrimSpace(q.Unit)
+}
+
+// Genders, see ref_gender
+var genders = map[string]string{
+	"male":    "GENDER_MALE",
+	"female":  "GENDER_FEMALE",
+	"other":   "GENDER_OTHER",
+	"unknown": "GENDER_PREFER_NOT_TO_SAY",
+}
+
+// administrativeGender returns the FHIR gender of a ref_gender ID
+func administrativeGender(gender string) string {
+	for fhirGender, id := range genders {
+		if id == gender {
+			return fhirGender
+		}
+	}
+	return "unknown"
+}
+
+// Condition statuses, see ref_medical_condition_status
+const (
+	conditionActive   = "ACTIVE"
+	conditionResolved = "RESOLVED"
+)
+
+// conditionStatuses maps FHIR clinical statuses to condition statuses
+var conditionStatuses = map[string]string{
+	"active":     conditionActive,
+	"recurrence": conditionActive,
+	"relapse":    conditionActive,
+	"inactive":   conditionResolved,
+	"remission":  conditionResolved,
+	"resolved":   conditionResolved,
+}
+
+// clinicalStatus returns the FHIR clinical status of a condition status.
+// FHIR has no chronic status, chronic conditions export as active.
+func clinicalStatus(status string) string {
+	if status == conditionResolved {
+		return "resolved"
+	}
+	return "active"
+}
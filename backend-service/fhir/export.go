@@ -0,0 +1,153 @@
+package fhir
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Export returns the patient with their medical history and biometric
+// readings as a collection Bundle that Import accepts
+func Export(ctx context.Context, q *db.EncryptedQueries, patientID pgtype.UUID) (*Bundle, error) {
+	patient, err := q.GetPatientByUserID(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load patient: %v", err)
+	}
+	history, err := q.GetPatientMedicalHistory(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load medical history: %v", err)
+	}
+	readings, err := q.ListPatientBiometrics(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load biometrics: %v", err)
+	}
+
+	bundle := &Bundle{
+		ResourceType: ResourceBundle,
+		Type:         "collection",
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+	}
+	id := pg.ToUUID(patient.ID).String()
+	subject := &Reference{Reference: ResourcePatient + "/" + id}
+
+	age := int(patient.Age)
+	if err := bundle.add(id, &Patient{
+		ResourceType: ResourcePatient,
+		ID:           id,
+		Extension:    []Extension{{URL: AgeExtensionURL, ValueInteger: &age}},
+		Name:         []HumanName{{Text: patient.Name}},
+		Telecom:      []ContactPoint{{System: "email", Value: patient.Email}},
+		Gender:       administrativeGender(patient.Gender),
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, h := range history {
+		condition := &Condition{
+			ResourceType: ResourceCondition,
+			ID:           pg.ToUUID(h.ID).String(),
+			ClinicalStatus: &CodeableConcept{
+				Coding: []Coding{{System: ConditionClinicalSystem, Code: clinicalStatus(h.StatusID)}},
+			},
+			Code:    &CodeableConcept{Text: h.Condition},
+			Subject: subject,
+		}
+		if h.DiagnosedDate.Valid {
+			condition.OnsetDateTime = h.DiagnosedDate.Time.UTC().Format(time.RFC3339)
+		}
+		if h.Notes.Valid {
+			condition.Note = []Annotation{{Text: h.Notes.String}}
+		}
+		if err := bundle.add(condition.ID, condition); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range readings {
+		observation, err := readingObservation(r, subject)
+		if err != nil {
+			return nil, err
+		}
+		if err := bundle.add(observation.ID, observation); err != nil {
+			return nil, err
+		}
+	}
+	return bundle, nil
+}
+
+// readingObservation maps a biometric reading to a vital signs Observation
+func readingObservation(r db.ListPatientBiometricsRow, subject *Reference) (*Observation, error) {
+	value, err := r.Value.Float64Value()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value: %v", r.TypeID, err)
+	}
+
+	category := "vital-signs"
+	if r.TypeID == "STEPS" {
+		category = "activity"
+	}
+	o := &Observation{
+		ResourceType: ResourceObservation,
+		ID:           pg.ToUUID(r.ID).String(),
+		Status:       "final",
+		Category: []CodeableConcept{{
+			Coding: []Coding{{System: ObservationCategorySystem, Code: category}},
+		}},
+		Code:              CodeableConcept{Text: r.TypeID},
+		Subject:           subject,
+		EffectiveDateTime: r.MeasuredAt.Time.UTC().Format(time.RFC3339Nano),
+	}
+	if coding, ok := loincCodes[r.TypeID]; ok {
+		o.Code.Coding = []Coding{coding}
+	}
+	if r.Source.Valid && r.Source.String != ImportSource {
+		o.Device = &Reference{Display: r.Source.String}
+	}
+
+	if r.TypeID != bloodPressureType {
+		o.ValueQuantity = quantity(value.Float64, r.UnitType)
+		return o, nil
+	}
+
+	secondary, err := r.SecondaryValue.Float64Value()
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s secondary value: %v", r.TypeID, err)
+	}
+	o.Component = []ObservationComponent{
+		{
+			Code:          CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: systolicCode, Display: "Systolic blood pressure"}}},
+			ValueQuantity: quantity(value.Float64, r.UnitType),
+		},
+		{
+			Code:          CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: diastolicCode, Display: "Diastolic blood pressure"}}},
+			ValueQuantity: quantity(secondary.Float64, r.UnitType),
+		},
+	}
+	return o, nil
+}
+
+// quantity returns value in unit, with its UCUM code when there is one
+func quantity(value float64, unit string) *Quantity {
+	q := &Quantity{Value: &value, Unit: unit}
+	if code, ok := ucumUnits[unit]; ok {
+		q.System = UCUMSystem
+		q.Code = code
+	}
+	return q
+}
+
+// add appends resource to the bundle under a urn:uuid full URL
+func (b *Bundle) add(id string, resource any) error {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return fmt.Errorf("failed to encode resource: %v", err)
+	}
+	b.Entry = append(b.Entry, BundleEntry{FullURL: "urn:uuid:" + id, Resource: raw})
+	return nil
+}
@@ -0,0 +1,105 @@
+package fhir
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var measured = time.Date(2024, 6, 1, 8, 30, 0, 0, time.UTC)
+
+func biometricRow(typeID, unit string, value, secondary float64, source string) db.ListPatientBiometricsRow {
+	row := db.ListPatientBiometricsRow{
+		ID:         pg.NewUUID(),
+		TypeID:     typeID,
+		UnitType:   unit,
+		Value:      pg.Float64ToNumeric(value),
+		MeasuredAt: pgtype.Timestamptz{Time: measured, Valid: true},
+		Source:     pgtype.Text{String: source, Valid: source != ""},
+	}
+	if typeID == bloodPressureType {
+		row.SecondaryValue = pg.Float64ToNumeric(secondary)
+	}
+	return row
+}
+
+func value(v float64) *float64 {
+	return &v
+}
+
+func TestReadingObservation(t *testing.T) {
+	subject := &Reference{Reference: "urn:uuid:patient"}
+	tests := []struct {
+		name          string
+		row           db.ListPatientBiometricsRow
+		wantCode      CodeableConcept
+		wantCategory  string
+		wantValue     *Quantity
+		wantComponent []ObservationComponent
+		wantDevice    *Reference
+	}{
+		{
+			name:         "heart rate",
+			row:          biometricRow("HEART_RATE", "bpm", 72, 0, "watch"),
+			wantCode:     CodeableConcept{Text: "HEART_RATE", Coding: []Coding{loincCodes["HEART_RATE"]}},
+			wantCategory: "vital-signs",
+			wantValue:    &Quantity{Value: value(72), Unit: "bpm", System: UCUMSystem, Code: "/min"},
+			wantDevice:   &Reference{Display: "watch"},
+		},
+		{
+			name:         "blood pressure panel",
+			row:          biometricRow(bloodPressureType, "mmHg", 120, 80, ""),
+			wantCode:     CodeableConcept{Text: bloodPressureType, Coding: []Coding{loincCodes[bloodPressureType]}},
+			wantCategory: "vital-signs",
+			wantComponent: []ObservationComponent{
+				{Code: CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: systolicCode, Display: "Systolic blood pressure"}}}, ValueQuantity: &Quantity{Value: value(120), Unit: "mmHg", System: UCUMSystem, Code: "mm[Hg]"}},
+				{Code: CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: diastolicCode, Display: "Diastolic blood pressure"}}}, ValueQuantity: &Quantity{Value: value(80), Unit: "mmHg", System: UCUMSystem, Code: "mm[Hg]"}},
+			},
+		},
+		{
+			name:         "steps are activity",
+			row:          biometricRow("STEPS", "steps", 4000, 0, ""),
+			wantCode:     CodeableConcept{Text: "STEPS", Coding: []Coding{loincCodes["STEPS"]}},
+			wantCategory: "activity",
+			wantValue:    &Quantity{Value: value(4000), Unit: "steps", System: UCUMSystem, Code: "{steps}"},
+		},
+		{
+			name:         "type without a LOINC code",
+			row:          biometricRow("MOOD", "score", 7, 0, ImportSource),
+			wantCode:     CodeableConcept{Text: "MOOD"},
+			wantCategory: "vital-signs",
+			wantValue:    &Quantity{Value: value(7), Unit: "score"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := readingObservation(tt.row, subject)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o.ResourceType != ResourceObservation || o.Status != "final" || o.Subject != subject || o.ID != pg.ToUUID(tt.row.ID).String() {
+				t.Fatalf("readingObservation() header = %+v", o)
+			}
+			if o.EffectiveDateTime != "2024-06-01T08:30:00Z" {
+				t.Fatalf("effectiveDateTime = %q", o.EffectiveDateTime)
+			}
+			if !reflect.DeepEqual(o.Code, tt.wantCode) {
+				t.Fatalf("code = %+v, want %+v", o.Code, tt.wantCode)
+			}
+			if len(o.Category) != 1 || o.Category[0].code(ObservationCategorySystem) != tt.wantCategory {
+				t.Fatalf("category = %+v, want %s", o.Category, tt.wantCategory)
+			}
+			if !reflect.DeepEqual(o.ValueQuantity, tt.wantValue) || !reflect.DeepEqual(o.Component, tt.wantComponent) {
+				t.Fatalf("value = %+v, components = %+v", o.ValueQuantity, o.Component)
+			}
+			if !reflect.DeepEqual(o.Device, tt.wantDevice) {
+				t.Fatalf("device = %+v, want %+v", o.Device, tt.wantDevice)
+			}
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package fhir
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Result summarises an imported bundle
+type Result struct {
+	PatientID             pgtype.UUID
+	PatientCreated        bool
+	Conditions            int
+	DuplicateConditions   int
+	Observations          int
+	DuplicateObservations int
+	Skipped               []string // Why entries were not imported
+}
+
+func (r *Result) skip(index int, format string, args ...any) {
+	r.Skipped = append(r.Skipped, fmt.Sprintf("entry %d: ", index)+fmt.Sprintf(format, args...))
+}
+
+// entry is a bundle entry awaiting import
+type entry struct {
+	index int
+	BundleEntry
+}
+
+// Import stores the bundle's Patient, Conditions and Observations in one
+// transaction. The bundle must hold exactly one Patient; a patient whose
+// email is already registered is reused, not updated. Entries that cannot be
+// mapped are skipped and reported in the result, and conditions and readings
+// already stored are counted as duplicates. cipher encrypts the notes of
+// conditions, nil stores them in plaintext.
+func Import(ctx context.Context, pool *pgxpool.Pool, cipher db.FieldCipher, bundle *Bundle) (*Result, error) {
+	if bundle.ResourceType != ResourceBundle {
+		return nil, fmt.Errorf("expected a %s, got %q", ResourceBundle, bundle.ResourceType)
+	}
+
+	result := &Result{}
+	var patients, conditions, observations []entry
+	for i, e := range bundle.Entry {
+		var header resourceHeader
+		if err := json.Unmarshal(e.Resource, &header); err != nil {
+			return nil, fmt.Errorf("entry %d: invalid resource: %v", i, err)
+		}
+		switch header.ResourceType {
+		case ResourcePatient:
+			patients = append(patients, entry{i, e})
+		case ResourceCondition:
+			conditions = append(conditions, entry{i, e})
+		case ResourceObservation:
+			observations = append(observations, entry{i, e})
+		default:
+			result.skip(i, "unsupported resource type %q", header.ResourceType)
+		}
+	}
+	if len(patients) != 1 {
+		return nil, fmt.Errorf("bundle must hold exactly one %s, found %d", ResourcePatient, len(patients))
+	}
+
+	var patient Patient
+	if err := json.Unmarshal(patients[0].Resource, &patient); err != nil {
+		return nil, fmt.Errorf("entry %d: invalid %s: %v", patients[0].index, ResourcePatient, err)
+	}
+	// References the other resources may use to name the patient
+	subjects := make(map[string]bool)
+	if patient.ID != "" {
+		subjects[ResourcePatient+"/"+patient.ID] = true
+	}
+	if patients[0].FullURL != "" {
+		subjects[patients[0].FullURL] = true
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	q := db.NewEncrypted(db.New(tx), cipher)
+	if result.PatientID, result.PatientCreated, err = importPatient(ctx, q, &patient); err != nil {
+		return nil, err
+	}
+	if err := importConditions(ctx, q, result, conditions, subjects); err != nil {
+		return nil, err
+	}
+	if err := importObservations(ctx, q, result, observations, subjects); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit import: %v", err)
+	}
+	return result, nil
+}
+
+// importPatient returns the ID of the patient registered under p's email,
+// creating the user and patient as needed
+func importPatient(ctx context.Context, q *db.EncryptedQueries, p *Patient) (pgtype.UUID, bool, error) {
+	var email string
+	for _, t := range p.Telecom {
+		if t.System == "email" && t.Value != "" {
+			email = strings.TrimSpace(t.Value)
+			break
+		}
+	}
+	if email == "" {
+		return pgtype.UUID{}, false, fmt.Errorf("patient has no email address")
+	}
+
+	name := patientName(p)
+	if name == "" {
+		return pgtype.UUID{}, false, fmt.Errorf("patient has no name")
+	}
+
+	age, err := patientAge(p, time.Now())
+	if err != nil {
+		return pgtype.UUID{}, false, err
+	}
+
+	gender := genders["unknown"]
+	if p.Gender != "" {
+		var ok bool
+		if gender, ok = genders[p.Gender]; !ok {
+			return pgtype.UUID{}, false, fmt.Errorf("unsupported gender %q", p.Gender)
+		}
+	}
+
+	user, err := q.GetUserByEmail(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		user, err = q.CreateUser(ctx, db.CreateUserParams{Email: email, Name: name})
+		if err != nil {
+			return pgtype.UUID{}, false, fmt.Errorf("failed to create user: %v", err)
+		}
+	} else if err != nil {
+		return pgtype.UUID{}, false, fmt.Errorf("failed to look up user: %v", err)
+	}
+
+	_, err = q.GetPatientByUserID(ctx, user.ID)
+	if err == nil {
+		return user.ID, false, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return pgtype.UUID{}, false, fmt.Errorf("failed to look up patient: %v", err)
+	}
+
+	_, err = q.CreatePatient(ctx, db.CreatePatientParams{
+		UserID: user.ID,
+		Age:    int32(age),
+		Gender: gender,
+	})
+	if err != nil {
+		return pgtype.UUID{}, false, fmt.Errorf("failed to create patient: %v", err)
+	}
+	return user.ID, true, nil
+}
+
+// patientName returns the first of the patient's names as a single string
+func patientName(p *Patient) string {
+	for _, n := range p.Name {
+		if text := strings.TrimSpace(n.Text); text != "" {
+			return text
+		}
+		if name := strings.TrimSpace(strings.Join(append(n.Given, n.Family), " ")); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// patientAge returns the patient's age from the birth date, or from the age
+// extension of bundles this package exported
+func patientAge(p *Patient, now time.Time) (int, error) {
+	age := -1
+	if p.BirthDate != "" {
+		born, err := parseDateTime(p.BirthDate)
+		if err != nil {
+			return 0, fmt.Errorf("invalid birthDate: %v", err)
+		}
+		age = now.Year() - born.Year()
+		if now.Month() < born.Month() || now.Month() == born.Month() && now.Day() < born.Day() {
+			age--
+		}
+	} else {
+		for _, ext := range p.Extension {
+			if ext.URL == AgeExtensionURL && ext.ValueInteger != nil {
+				age = *ext.ValueInteger
+			}
+		}
+	}
+
+	if age == -1 {
+		return 0, fmt.Errorf("patient has neither a birthDate nor an age")
+	}
+	if age < 0 || age > 150 {
+		return 0, fmt.Errorf("patient age %d is out of range", age)
+	}
+	return age, nil
+}
+
+// importConditions adds the conditions to the patient's medical history,
+// skipping those already recorded with the same diagnosis date
+func importConditions(ctx context.Context, q *db.EncryptedQueries, result *Result, entries []entry, subjects map[string]bool) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	history, err := q.GetPatientMedicalHistory(ctx, result.PatientID)
+	if err != nil {
+		return fmt.Errorf("failed to load medical history: %v", err)
+	}
+	recorded := make(map[string]bool, len(history))
+	for _, h := range history {
+		recorded[conditionKey(h.Condition, h.DiagnosedDate)] = true
+	}
+
+	for _, e := range entries {
+		var c Condition
+		if err := json.Unmarshal(e.Resource, &c); err != nil {
+			result.skip(e.index, "invalid %s: %v", ResourceCondition, err)
+			continue
+		}
+		if !isSubject(c.Subject, subjects) {
+			result.skip(e.index, "subject %q is not the bundle's patient", c.Subject.Reference)
+			continue
+		}
+
+		params, reason := conditionParams(&c)
+		if reason != "" {
+			result.skip(e.index, "%s", reason)
+			continue
+		}
+		params.PatientID = result.PatientID
+
+		key := conditionKey(params.Condition, params.DiagnosedDate)
+		if recorded[key] {
+			result.DuplicateConditions++
+			continue
+		}
+		if _, err := q.AddMedicalHistory(ctx, params); err != nil {
+			return fmt.Errorf("failed to add condition: %v", err)
+		}
+		recorded[key] = true
+		result.Conditions++
+	}
+	return nil
+}
+
+// conditionParams maps a Condition to a medical_history row, or returns why
+// it cannot be
+func conditionParams(c *Condition) (db.AddMedicalHistoryParams, string) {
+	var params db.AddMedicalHistoryParams
+	if c.Code != nil {
+		params.Condition = strings.TrimSpace(c.Code.Text)
+		for i := 0; params.Condition == "" && i < len(c.Code.Coding); i++ {
+			params.Condition = strings.TrimSpace(c.Code.Coding[i].Display)
+		}
+	}
+	if params.Condition == "" {
+		return params, "condition has no text or display"
+	}
+
+	params.StatusID = conditionActive
+	if code := c.ClinicalStatus.code(ConditionClinicalSystem); code != "" {
+		status, ok := conditionStatuses[code]
+		if !ok {
+			return params, fmt.Sprintf("unsupported clinical status %q", code)
+		}
+		params.StatusID = status
+	}
+
+	if c.OnsetDateTime != "" {
+		onset, err := parseDateTime(c.OnsetDateTime)
+		if err != nil {
+			return params, fmt.Sprintf("invalid onsetDateTime: %v", err)
+		}
+		params.DiagnosedDate = pgtype.Timestamptz{Time: onset, Valid: true}
+	}
+
+	var notes []string
+	for _, n := range c.Note {
+		if text := strings.TrimSpace(n.Text); text != "" {
+			notes = append(notes, text)
+		}
+	}
+	if len(notes) > 0 {
+		params.Notes = pgtype.Text{String: strings.Join(notes, "\n"), Valid: true}
+	}
+	return params, ""
+}
+
+func conditionKey(condition string, diagnosed pgtype.Timestamptz) string {
+	key := strings.ToLower(condition)
+	if diagnosed.Valid {
+		key += "/" + diagnosed.Time.UTC().Format(time.RFC3339)
+	}
+	return key
+}
+
+// importObservations stores the observations as biometric readings. Readings
+// already stored for the same type and time are counted as duplicates.
+func importObservations(ctx context.Context, q *db.EncryptedQueries, result *Result, entries []entry, subjects map[string]bool) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	types, err := q.ListActiveBiometricTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load biometric types: %v", err)
+	}
+	units := make(map[string]string, len(types))
+	for _, t := range types {
+		units[t.ID] = t.UnitType
+	}
+
+	now := time.Now()
+	for _, e := range entries {
+		var o Observation
+		if err := json.Unmarshal(e.Resource, &o); err != nil {
+			result.skip(e.index, "invalid %s: %v", ResourceObservation, err)
+			continue
+		}
+		if !isSubject(o.Subject, subjects) {
+			result.skip(e.index, "subject %q is not the bundle's patient", o.Subject.Reference)
+			continue
+		}
+		if o.Status == "entered-in-error" || o.Status == "cancelled" {
+			result.skip(e.index, "observation status is %s", o.Status)
+			continue
+		}
+
+		params, reason := readingParams(&o, units, now)
+		if reason != "" {
+			result.skip(e.index, "%s", reason)
+			continue
+		}
+		params.PatientID = result.PatientID
+
+		rows, err := q.IngestBiometricReading(ctx, params)
+		if err != nil {
+			return fmt.Errorf("failed to insert reading: %v", err)
+		}
+		if rows == 0 {
+			result.DuplicateObservations++
+		} else {
+			result.Observations++
+		}
+	}
+	return nil
+}
+
+// readingParams maps an Observation to a biometric reading, or returns why it
+// cannot be. units maps active biometric type IDs to their unit.
+func readingParams(o *Observation, units map[string]string, now time.Time) (db.IngestBiometricReadingParams, string) {
+	var params db.IngestBiometricReadingParams
+
+	typeID := biometricTypes[o.Code.code(LOINCSystem)]
+	if typeID == "" {
+		// Types with no LOINC code export their ID as the code's text
+		typeID = o.Code.Text
+	}
+	unit, ok := units[typeID]
+	if !ok {
+		return params, "observation code is not a known biometric type"
+	}
+	params.TypeID = typeID
+
+	value := o.ValueQuantity
+	var secondary *Quantity
+	if typeID == bloodPressureType {
+		value, secondary = nil, nil
+		for i := range o.Component {
+			switch o.Component[i].Code.code(LOINCSystem) {
+			case systolicCode:
+				value = o.Component[i].ValueQuantity
+			case diastolicCode:
+				secondary = o.Component[i].ValueQuantity
+			}
+		}
+		if value == nil || secondary == nil {
+			return params, "blood pressure needs systolic and diastolic components"
+		}
+	}
+
+	for _, quantity := range []*Quantity{value, secondary} {
+		if quantity == nil {
+			continue
+		}
+		if quantity.Value == nil || math.IsNaN(*quantity.Value) || math.IsInf(*quantity.Value, 0) || *quantity.Value < 0 {
+			return params, "value must be a non-negative number"
+		}
+		if got := storedUnit(quantity); !strings.EqualFold(got, unit) {
+			return params, fmt.Sprintf("unit %q does not match %s, expected %q", got, typeID, unit)
+		}
+	}
+	if value == nil {
+		return params, "observation has no valueQuantity"
+	}
+	params.Value = pg.Float64ToNumeric(*value.Value)
+	if secondary != nil {
+		if *secondary.Value >= *value.Value {
+			return params, "diastolic pressure must be below systolic"
+		}
+		params.SecondaryValue = pg.Float64ToNumeric(*secondary.Value)
+	}
+
+	effective := o.EffectiveDateTime
+	if effective == "" {
+		effective = o.Issued
+	}
+	if effective == "" {
+		return params, "observation has no effectiveDateTime"
+	}
+	measured, err := parseDateTime(effective)
+	if err != nil {
+		return params, fmt.Sprintf("invalid effectiveDateTime: %v", err)
+	}
+	if measured.After(now) {
+		return params, "effectiveDateTime is in the future"
+	}
+	params.MeasuredAt = pgtype.Timestamptz{Time: measured, Valid: true}
+
+	source := ImportSource
+	if o.Device != nil && o.Device.Display != "" {
+		source = o.Device.Display
+	}
+	params.Source = pgtype.Text{String: source, Valid: true}
+	return params, ""
+}
+
+// isSubject reports whether ref names the bundle's patient. Resources with
+// no subject belong to the bundle's only patient.
+func isSubject(ref *Reference, subjects map[string]bool) bool {
+	return ref == nil || ref.Reference == "" || subjects[ref.Reference]
+}
+
+// parseDateTime parses a FHIR date or dateTime. Partial dates are taken as
+// the start of the period, in UTC.
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02", "2006-01", "2006"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a FHIR date or dateTime", value)
+}
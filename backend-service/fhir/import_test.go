@@ -0,0 +1,226 @@
+package fhir
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var units = map[string]string{
+	"HEART_RATE":        "bpm",
+	bloodPressureType:   "mmHg",
+	"OXYGEN_SATURATION": "%",
+	"TEMPERATURE":       "°C",
+	"STEPS":             "steps",
+	"MOOD":              "score",
+}
+
+func numeric(t *testing.T, n pgtype.Numeric) float64 {
+	t.Helper()
+	if !n.Valid {
+		return -1
+	}
+	f, err := n.Float64Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.Float64
+}
+
+func TestReadingParamsRoundTrip(t *testing.T) {
+	rows := []db.ListPatientBiometricsRow{
+		biometricRow("HEART_RATE", "bpm", 72, 0, "watch"),
+		biometricRow(bloodPressureType, "mmHg", 120, 80, "cuff"),
+		biometricRow("STEPS", "steps", 4000, 0, ""),
+		biometricRow("MOOD", "score", 7, 0, ImportSource),
+	}
+	for _, row := range rows {
+		t.Run(row.TypeID, func(t *testing.T) {
+			o, err := readingObservation(row, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			params, reason := readingParams(o, units, measured.Add(time.Hour))
+			if reason != "" {
+				t.Fatalf("readingParams() refused the exported reading: %s", reason)
+			}
+			if params.TypeID != row.TypeID || numeric(t, params.Value) != numeric(t, row.Value) || numeric(t, params.SecondaryValue) != numeric(t, row.SecondaryValue) {
+				t.Fatalf("readingParams() = %s %v/%v, want %s %v/%v", params.TypeID, numeric(t, params.Value), numeric(t, params.SecondaryValue), row.TypeID, numeric(t, row.Value), numeric(t, row.SecondaryValue))
+			}
+			if !params.MeasuredAt.Time.Equal(measured) {
+				t.Fatalf("measured at %v, want %v", params.MeasuredAt.Time, measured)
+			}
+			wantSource := row.Source.String
+			if wantSource == "" {
+				wantSource = ImportSource
+			}
+			if params.Source.String != wantSource {
+				t.Fatalf("source = %q, want %q", params.Source.String, wantSource)
+			}
+		})
+	}
+}
+
+func TestReadingParams(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	loinc := func(code string) CodeableConcept {
+		return CodeableConcept{Coding: []Coding{{System: LOINCSystem, Code: code}}}
+	}
+	ucum := func(v float64, unit, code string) *Quantity {
+		return &Quantity{Value: &v, Unit: unit, System: UCUMSystem, Code: code}
+	}
+	bp := func(systolic, diastolic *Quantity) []ObservationComponent {
+		var out []ObservationComponent
+		if systolic != nil {
+			out = append(out, ObservationComponent{Code: loinc(systolicCode), ValueQuantity: systolic})
+		}
+		if diastolic != nil {
+			out = append(out, ObservationComponent{Code: loinc(diastolicCode), ValueQuantity: diastolic})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		o          Observation
+		wantType   string
+		wantValue  float64
+		wantReason string
+	}{
+		{"alternative LOINC code", Observation{Code: loinc("2708-6"), ValueQuantity: ucum(97, "%", "%"), EffectiveDateTime: "2024-06-01T10:00:00Z"}, "OXYGEN_SATURATION", 97, ""},
+		{"unit alias", Observation{Code: loinc("8867-4"), ValueQuantity: &Quantity{Value: value(60), Unit: "beats/min"}, EffectiveDateTime: "2024-06-01"}, "HEART_RATE", 60, ""},
+		{"UCUM code only", Observation{Code: loinc("8310-5"), ValueQuantity: &Quantity{Value: value(37.2), Code: "Cel"}, EffectiveDateTime: "2024-06-01T10:00:00Z"}, "TEMPERATURE", 37.2, ""},
+		{"issued instead of effective", Observation{Code: loinc("8867-4"), ValueQuantity: ucum(60, "/min", "/min"), Issued: "2024-06-01T10:00:00+02:00"}, "HEART_RATE", 60, ""},
+		{"converted unit refused", Observation{Code: loinc("8310-5"), ValueQuantity: ucum(99, "degF", "[degF]"), EffectiveDateTime: "2024-06-01"}, "", 0, `unit "degF" does not match TEMPERATURE`},
+		{"unknown code", Observation{Code: loinc("1234-5"), ValueQuantity: ucum(1, "", ""), EffectiveDateTime: "2024-06-01"}, "", 0, "not a known biometric type"},
+		{"no value", Observation{Code: loinc("8867-4"), EffectiveDateTime: "2024-06-01"}, "", 0, "no valueQuantity"},
+		{"negative value", Observation{Code: loinc("8867-4"), ValueQuantity: ucum(-3, "/min", "/min"), EffectiveDateTime: "2024-06-01"}, "", 0, "non-negative"},
+		{"blood pressure", Observation{Code: loinc("55284-4"), Component: bp(ucum(130, "mmHg", "mm[Hg]"), ucum(85, "mm Hg", "")), EffectiveDateTime: "2024-06-01"}, bloodPressureType, 130, ""},
+		{"blood pressure without diastolic", Observation{Code: loinc(bloodPressurePanelCode), Component: bp(ucum(130, "mmHg", "mm[Hg]"), nil), EffectiveDateTime: "2024-06-01"}, "", 0, "systolic and diastolic"},
+		{"blood pressure inverted", Observation{Code: loinc(bloodPressurePanelCode), Component: bp(ucum(80, "mmHg", "mm[Hg]"), ucum(120, "mmHg", "mm[Hg]")), EffectiveDateTime: "2024-06-01"}, "", 0, "below systolic"},
+		{"no time", Observation{Code: loinc("8867-4"), ValueQuantity: ucum(60, "/min", "/min")}, "", 0, "no effectiveDateTime"},
+		{"invalid time", Observation{Code: loinc("8867-4"), ValueQuantity: ucum(60, "/min", "/min"), EffectiveDateTime: "yesterday"}, "", 0, "invalid effectiveDateTime"},
+		{"future", Observation{Code: loinc("8867-4"), ValueQuantity: ucum(60, "/min", "/min"), EffectiveDateTime: "2024-06-02"}, "", 0, "in the future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, reason := readingParams(&tt.o, units, now)
+			if tt.wantReason != "" {
+				if !strings.Contains(reason, tt.wantReason) {
+					t.Fatalf("readingParams() reason = %q, want one containing %q", reason, tt.wantReason)
+				}
+				return
+			}
+			if reason != "" {
+				t.Fatalf("readingParams() refused: %s", reason)
+			}
+			if params.TypeID != tt.wantType || numeric(t, params.Value) != tt.wantValue {
+				t.Fatalf("readingParams() = %s %v, want %s %v", params.TypeID, numeric(t, params.Value), tt.wantType, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestConditionParams(t *testing.T) {
+	clinical := func(code string) *CodeableConcept {
+		return &CodeableConcept{Coding: []Coding{{System: ConditionClinicalSystem, Code: code}}}
+	}
+	tests := []struct {
+		name       string
+		c          Condition
+		want       db.AddMedicalHistoryParams
+		wantReason string
+	}{
+		{
+			name: "text, onset and notes",
+			c: Condition{
+				Code:           &CodeableConcept{Text: " Asthma "},
+				ClinicalStatus: clinical("remission"),
+				OnsetDateTime:  "2019-03",
+				Note:           []Annotation{{Text: "Uses an inhaler"}, {Text: " "}, {Text: "Worse in spring"}},
+			},
+			want: db.AddMedicalHistoryParams{
+				Condition:     "Asthma",
+				StatusID:      conditionResolved,
+				DiagnosedDate: pgtype.Timestamptz{Time: time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+				Notes:         pgtype.Text{String: "Uses an inhaler\nWorse in spring", Valid: true},
+			},
+		},
+		{
+			name: "display and no status",
+			c:    Condition{Code: &CodeableConcept{Coding: []Coding{{Code: "38341003"}, {Display: "Hypertension"}}}},
+			want: db.AddMedicalHistoryParams{Condition: "Hypertension", StatusID: conditionActive},
+		},
+		{
+			name: "relapse is active",
+			c:    Condition{Code: &CodeableConcept{Text: "Gout"}, ClinicalStatus: clinical("relapse")},
+			want: db.AddMedicalHistoryParams{Condition: "Gout", StatusID: conditionActive},
+		},
+		{"no name", Condition{Code: &CodeableConcept{Coding: []Coding{{Code: "38341003"}}}}, db.AddMedicalHistoryParams{}, "no text or display"},
+		{"no code", Condition{}, db.AddMedicalHistoryParams{}, "no text or display"},
+		{"unsupported status", Condition{Code: &CodeableConcept{Text: "Gout"}, ClinicalStatus: clinical("unknown")}, db.AddMedicalHistoryParams{}, "unsupported clinical status"},
+		{"invalid onset", Condition{Code: &CodeableConcept{Text: "Gout"}, OnsetDateTime: "spring"}, db.AddMedicalHistoryParams{}, "invalid onsetDateTime"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := conditionParams(&tt.c)
+			if tt.wantReason != "" {
+				if !strings.Contains(reason, tt.wantReason) {
+					t.Fatalf("conditionParams() reason = %q, want one containing %q", reason, tt.wantReason)
+				}
+				return
+			}
+			if reason != "" || got != tt.want {
+				t.Fatalf("conditionParams() = %+v, %q, want %+v", got, reason, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatientAge(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	age := func(n int) []Extension {
+		return []Extension{{URL: AgeExtensionURL, ValueInteger: &n}}
+	}
+	tests := []struct {
+		name    string
+		p       Patient
+		want    int
+		wantErr bool
+	}{
+		{"birthday passed", Patient{BirthDate: "1980-06-15"}, 44, false},
+		{"birthday to come", Patient{BirthDate: "1980-06-16"}, 43, false},
+		{"birth year only", Patient{BirthDate: "1980"}, 44, false},
+		{"age extension", Patient{Extension: age(61)}, 61, false},
+		{"birth date wins", Patient{BirthDate: "2000-01-01", Extension: age(61)}, 24, false},
+		{"neither", Patient{}, 0, true},
+		{"invalid birth date", Patient{BirthDate: "15/06/1980"}, 0, true},
+		{"born in the future", Patient{BirthDate: "2030-01-01"}, 0, true},
+		{"too old", Patient{Extension: age(151)}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := patientAge(&tt.p, now)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Fatalf("patientAge() = %d, %v, want %d, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClinicalStatusAndGender(t *testing.T) {
+	for status, want := range map[string]string{conditionActive: "active", conditionResolved: "resolved", "CHRONIC": "active"} {
+		if got := clinicalStatus(status); got != want {
+			t.Errorf("clinicalStatus(%s) = %s, want %s", status, got, want)
+		}
+	}
+	for gender, want := range map[string]string{"GENDER_FEMALE": "female", "GENDER_PREFER_NOT_TO_SAY": "unknown", "": "unknown"} {
+		if got := administrativeGender(gender); got != want {
+			t.Errorf("administrativeGender(%q) = %s, want %s", gender, got, want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Field keys shared by every component so log lines can be correlated
+const (
+	KeySessionID = "session_id"
+	KeyMessageID = "message_id"
+	KeyRole      = "role"
+	KeyDoctorID  = "doctor_id"
+	KeyError     = "error"
+)
+
+// Supported output formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Config holds configuration for the logger
+type Config struct {
+	Level  string // debug, info, warn, error
+	Format string // text or json
+}
+
+// New creates a structured logger writing to stderr. Every record passes
+// through the redaction layer before it reaches the output handler.
+func New(cfg Config) (*slog.Logger, error) {
+	return NewWithWriter(cfg, os.Stderr)
+}
+
+// NewWithWriter creates a structured logger writing to w
+func NewWithWriter(cfg Config, w io.Writer) (*slog.Logger, error) {
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case "", FormatText:
+		handler = slog.NewTextHandler(w, opts)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format: %s", cfg.Format)
+	}
+
+	return slog.New(NewRedactingHandler(handler)), nil
+}
+
+// ParseLevel converts a level name into a slog.Level. An empty name means info.
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level: %s", name)
+	}
+}
+
+// SessionID returns the attribute identifying a chat session
+func SessionID(id string) slog.Attr {
+	return slog.String(KeySessionID, id)
+}
+
+// MessageID returns the attribute identifying a chat message or draft
+func MessageID(id string) slog.Attr {
+	return slog.String(KeyMessageID, id)
+}
+
+// Role returns the attribute identifying the role of a connection
+func Role(role string) slog.Attr {
+	return slog.String(KeyRole, role)
+}
+
+// DoctorID returns the attribute identifying a doctor
+func DoctorID(id string) slog.Attr {
+	return slog.String(KeyDoctorID, id)
+}
+
+// Err returns the attribute carrying an error. The redaction layer scrubs
+// its message, see ScrubError.
+func Err(err error) slog.Attr {
+	return slog.Any(KeyError, err)
+}
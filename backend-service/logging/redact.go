@@ -0,0 +1,141 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the value of any attribute that may carry PHI
+const RedactedValue = "[REDACTED]"
+
+// sensitiveKeys lists attribute keys whose values must never be written out.
+// Message content, drafts and anything that identifies a patient belong here.
+var sensitiveKeys = map[string]struct{}{
+	"content":          {},
+	"message_content":  {},
+	"original_message": {},
+	"draft":            {},
+	"question":         {},
+	"question_text":    {},
+	"answer":           {},
+	"review_content":   {},
+	"notes":            {},
+	"patient_id":       {},
+	"patient_name":     {},
+	"user_id":          {},
+	"name":             {},
+	"email":            {},
+	"age":              {},
+	"gender":           {},
+	"birth_date":       {},
+	"phone":            {},
+	"address":          {},
+	"medical_history":  {},
+	"biometrics":       {},
+	"token":            {},
+}
+
+// errorPatterns match the parts of an error message that may carry PHI.
+// Errors quote the values they refuse, and identifiers, contact details and
+// dates can appear in them unquoted.
+var errorPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`"(?:[^"\\]|\\.)*"`),
+	regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`),
+	regexp.MustCompile(`[\w.+-]+@[\w-]+(?:\.[\w-]+)+`),
+	regexp.MustCompile(`\d{4}-\d{2}-\d{2}`),
+	regexp.MustCompile(`\+?\(?\d[\d ()-]{5,}\d`),
+}
+
+// ScrubError returns an error message with quoted values, identifiers,
+// contact details and dates replaced by RedactedValue
+func ScrubError(message string) string {
+	for _, pattern := range errorPatterns {
+		message = pattern.ReplaceAllString(message, RedactedValue)
+	}
+	return message
+}
+
+// IsSensitiveKey reports whether values logged under key are redacted
+func IsSensitiveKey(key string) bool {
+	_, ok := sensitiveKeys[strings.ToLower(key)]
+	return ok
+}
+
+// RedactingHandler wraps another handler and scrubs sensitive attributes,
+// including those nested inside groups, before the record is emitted.
+type RedactingHandler struct {
+	next slog.Handler
+}
+
+// NewRedactingHandler wraps next with the redaction layer
+func NewRedactingHandler(next slog.Handler) *RedactingHandler {
+	return &RedactingHandler{next: next}
+}
+
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *RedactingHandler) Handle(ctx context.Context, r slog.Record) error {
+	clean := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		clean.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, clean)
+}
+
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clean := make([]slog.Attr, 0, len(attrs))
+	for _, a := range attrs {
+		clean = append(clean, redactAttr(a))
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(clean)}
+}
+
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name)}
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, RedactedValue)
+	}
+
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindGroup:
+	case slog.KindString:
+		if a.Key == KeyError {
+			return slog.String(a.Key, ScrubError(v.String()))
+		}
+		return slog.Attr{Key: a.Key, Value: v}
+	case slog.KindAny:
+		return slog.Attr{Key: a.Key, Value: redactAny(v.Any())}
+	default:
+		return slog.Attr{Key: a.Key, Value: v}
+	}
+
+	group := v.Group()
+	clean := make([]slog.Attr, 0, len(group))
+	for _, ga := range group {
+		clean = append(clean, redactAttr(ga))
+	}
+	return slog.Attr{Key: a.Key, Value: slog.GroupValue(clean...)}
+}
+
+// redactAny restricts the values logged with slog.Any. Errors are scrubbed
+// and string lists, such as rule names, pass; anything else, like a struct
+// whose fields cannot be told apart, is redacted whole.
+func redactAny(value any) slog.Value {
+	switch v := value.(type) {
+	case error:
+		return slog.StringValue(ScrubError(v.Error()))
+	case []string:
+		return slog.AnyValue(v)
+	default:
+		return slog.StringValue(RedactedValue)
+	}
+}
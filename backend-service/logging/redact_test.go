@@ -0,0 +1,125 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// logLine logs one record through the redaction layer and returns its JSON
+// fields
+func logLine(t *testing.T, log func(*slog.Logger)) map[string]any {
+	t.Helper()
+	var buf bytes.Buffer
+	logger, err := NewWithWriter(Config{Format: FormatJSON}, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log(logger)
+	var fields map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		t.Fatalf("log line %q is not JSON: %v", buf.String(), err)
+	}
+	return fields
+}
+
+// field returns the value at a dotted path of nested groups
+func field(fields map[string]any, path string) any {
+	var v any = fields
+	for _, key := range strings.Split(path, ".") {
+		group, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = group[key]
+	}
+	return v
+}
+
+type patientRecord struct {
+	Name    string
+	Content string
+}
+
+type logValuer struct{}
+
+func (logValuer) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("content", "I feel dizzy"), slog.String("department", "cardiology"))
+}
+
+func TestRedaction(t *testing.T) {
+	tests := []struct {
+		name string
+		log  func(*slog.Logger)
+		path string
+		want any
+	}{
+		{"sensitive key", func(l *slog.Logger) { l.Info("m", "content", "I feel dizzy") }, "content", RedactedValue},
+		{"sensitive key in any case", func(l *slog.Logger) { l.Info("m", "Patient_ID", "p-1") }, "Patient_ID", RedactedValue},
+		{"safe key", func(l *slog.Logger) { l.Info("m", SessionID("s-1")) }, KeySessionID, "s-1"},
+		{"nested group", func(l *slog.Logger) {
+			l.Info("m", slog.Group("request", slog.Group("patient", slog.String("name", "Jane Doe"), slog.Int("visits", 3))))
+		}, "request.patient.name", RedactedValue},
+		{"nested group keeps safe keys", func(l *slog.Logger) {
+			l.Info("m", slog.Group("request", slog.Group("patient", slog.String("name", "Jane Doe"), slog.Int("visits", 3))))
+		}, "request.patient.visits", float64(3)},
+		{"group with a sensitive key", func(l *slog.Logger) {
+			l.Info("m", slog.Group("medical_history", slog.String("condition", "asthma")))
+		}, "medical_history", RedactedValue},
+		{"logger attrs", func(l *slog.Logger) { l.With("email", "jane@example.com").Info("m") }, "email", RedactedValue},
+		{"logger group", func(l *slog.Logger) { l.WithGroup("req").Info("m", "question", "Is this normal?") }, "req.question", RedactedValue},
+		{"log valuer", func(l *slog.Logger) { l.Info("m", "draft_info", logValuer{}) }, "draft_info.content", RedactedValue},
+		{"log valuer keeps safe keys", func(l *slog.Logger) { l.Info("m", "draft_info", logValuer{}) }, "draft_info.department", "cardiology"},
+		{"any struct", func(l *slog.Logger) {
+			l.Info("m", slog.Any("record", patientRecord{Name: "Jane Doe", Content: "I feel dizzy"}))
+		}, "record", RedactedValue},
+		{"any struct pointer", func(l *slog.Logger) { l.Info("m", "record", &patientRecord{Name: "Jane Doe"}) }, "record", RedactedValue},
+		{"any map", func(l *slog.Logger) { l.Info("m", "fields", map[string]string{"content": "I feel dizzy"}) }, "fields", RedactedValue},
+		{"string list", func(l *slog.Logger) { l.Info("m", "reasons", []string{"chest_pain"}) }, "reasons", []any{"chest_pain"}},
+		{"error", func(l *slog.Logger) {
+			l.Error("m", Err(fmt.Errorf("failed to parse message: invalid value %q", "I feel dizzy")))
+		}, KeyError, "failed to parse message: invalid value " + RedactedValue},
+		{"wrapped error", func(l *slog.Logger) {
+			l.Error("m", Err(fmt.Errorf("failed to save: %w", errors.New("no patient 6ba7b810-9dad-11d1-80b4-00c04fd430c8"))))
+		}, KeyError, "failed to save: no patient " + RedactedValue},
+		{"error under another key", func(l *slog.Logger) { l.Error("m", "cause", errors.New("unknown address jane@example.com")) }, "cause", "unknown address " + RedactedValue},
+		{"error string", func(l *slog.Logger) { l.Error("m", KeyError, `invalid birth date "1980-02-30"`) }, KeyError, "invalid birth date " + RedactedValue},
+		{"error in a group", func(l *slog.Logger) {
+			l.Error("m", slog.Group("retry", Err(errors.New("call +1 555 123 4567 failed"))))
+		}, "retry.error", "call " + RedactedValue + " failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := field(logLine(t, tt.log), tt.path)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("%s = %#v, want %#v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScrubError(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"connection refused", "connection refused"},
+		{"ERROR: relation does not exist (SQLSTATE 42P01)", "ERROR: relation does not exist (SQLSTATE 42P01)"},
+		{"dial tcp 10.0.0.5:5432: i/o timeout", "dial tcp 10.0.0.5:5432: i/o timeout"},
+		{`strconv.ParseUint: parsing "abc": invalid syntax`, "strconv.ParseUint: parsing " + RedactedValue + ": invalid syntax"},
+		{`invalid value "say \"hi\" to Jane"`, "invalid value " + RedactedValue},
+		{"patient 6BA7B810-9DAD-11D1-80B4-00C04FD430C8 not found", "patient " + RedactedValue + " not found"},
+		{"no user jane.doe+test@mail.example.org", "no user " + RedactedValue},
+		{"born 1980-02-29", "born " + RedactedValue},
+		{"phone (030) 1234-5678 rejected", "phone " + RedactedValue + " rejected"},
+	}
+	for _, tt := range tests {
+		if got := ScrubError(tt.message); got != tt.want {
+			t.Errorf("ScrubError(%q) = %q, want %q", tt.message, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// erasureBatchSize is the number of AI interactions de-identified per query
+// during an erasure
+const erasureBatchSize = 500
+
+// PatientExport is everything stored about a patient
+type PatientExport struct {
+	ExportedAt     time.Time           `json:"exported_at"`
+	Patient        ExportedPatient     `json:"patient"`
+	MedicalHistory []ExportedCondition `json:"medical_history"`
+	Biometrics     []ExportedReading   `json:"biometrics"`
+	Sessions       []ExportedSession   `json:"sessions"`
+}
+
+type ExportedPatient struct {
+	ID        string     `json:"id"`
+	Email     string     `json:"email"`
+	Name      string     `json:"name"`
+	Age       int32      `json:"age"`
+	Gender    string     `json:"gender"`
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+type ExportedCondition struct {
+	ID            string     `json:"id"`
+	Condition     string     `json:"condition"`
+	DiagnosedDate *time.Time `json:"diagnosed_date,omitempty"`
+	Status        string     `json:"status"`
+	Notes         string     `json:"notes,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+}
+
+type ExportedReading struct {
+	Type           string    `json:"type"`
+	Value          float64   `json:"value"`
+	SecondaryValue *float64  `json:"secondary_value,omitempty"`
+	Unit           string    `json:"unit"`
+	Source         string    `json:"source,omitempty"`
+	MeasuredAt     time.Time `json:"measured_at"`
+}
+
+type ExportedSession struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status"`
+	CreatedAt time.Time         `json:"created_at"`
+	ClosedAt  *time.Time        `json:"closed_at,omitempty"`
+	Messages  []ExportedMessage `json:"messages"`
+}
+
+type ExportedMessage struct {
+	ID              string    `json:"id"`
+	SenderID        string    `json:"sender_id"`
+	MessageType     string    `json:"message_type"`
+	Content         string    `json:"content"`
+	ParentMessageID string    `json:"parent_message_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// ErasureResult counts what an erasure removed
+type ErasureResult struct {
+	Conditions     int64
+	Biometrics     int64
+	Messages       int64
+	AIInteractions int64 // De-identified
+}
+
+// ExportAndErase writes everything stored about the patient to w as JSON,
+// then erases it in the same transaction. Medical history, readings and the
+// messages of their sessions are deleted and the AI interactions of those
+// sessions de-identified. The users row is anonymized and the patient row
+// kept, with age and gender, so sessions and review outcomes still count in
+// aggregate statistics. Nothing is erased if the export cannot be written;
+// when w is a file it is synced first.
+func ExportAndErase(ctx context.Context, pool *pgxpool.Pool, cipher db.FieldCipher, patientID pgtype.UUID, w io.Writer) (ErasureResult, error) {
+	var result ErasureResult
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	q := db.NewEncrypted(db.New(tx), cipher)
+
+	export, sessionIDs, err := exportPatient(ctx, q, patientID)
+	if err != nil {
+		return result, err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(export); err != nil {
+		return result, fmt.Errorf("failed to write export: %v", err)
+	}
+	if f, ok := w.(interface{ Sync() error }); ok {
+		if err := f.Sync(); err != nil {
+			return result, fmt.Errorf("failed to write export: %v", err)
+		}
+	}
+
+	if len(sessionIDs) > 0 {
+		if result.AIInteractions, err = deidentifySessions(ctx, q, sessionIDs, erasureBatchSize); err != nil {
+			return result, err
+		}
+		if result.Messages, err = q.DeleteSessionMessages(ctx, sessionIDs); err != nil {
+			return result, fmt.Errorf("failed to delete messages: %v", err)
+		}
+	}
+	if result.Conditions, err = q.DeletePatientMedicalHistory(ctx, patientID); err != nil {
+		return result, fmt.Errorf("failed to delete medical history: %v", err)
+	}
+	if result.Biometrics, err = q.DeletePatientBiometrics(ctx, patientID); err != nil {
+		return result, fmt.Errorf("failed to delete biometrics: %v", err)
+	}
+	if err := q.AnonymizeUser(ctx, patientID); err != nil {
+		return result, fmt.Errorf("failed to anonymize user: %v", err)
+	}
+	if err := q.ErasePatient(ctx, patientID); err != nil {
+		return result, fmt.Errorf("failed to erase patient: %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return result, fmt.Errorf("failed to commit erasure: %v", err)
+	}
+	return result, nil
+}
+
+// exportPatient loads everything stored about the patient, locking them, and
+// returns it with the IDs of their sessions
+func exportPatient(ctx context.Context, q *db.EncryptedQueries, patientID pgtype.UUID) (*PatientExport, []pgtype.UUID, error) {
+	patient, err := q.GetPatientForErasure(ctx, patientID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, errors.New("patient not found")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load patient: %v", err)
+	}
+	if patient.ErasedAt.Valid {
+		return nil, nil, fmt.Errorf("patient was already erased at %s", patient.ErasedAt.Time.Format(time.RFC3339))
+	}
+	export := &PatientExport{
+		ExportedAt: time.Now().UTC(),
+		Patient: ExportedPatient{
+			ID:        pg.ToUUID(patient.ID).String(),
+			Email:     patient.Email,
+			Name:      patient.Name,
+			Age:       patient.Age,
+			Gender:    patient.Gender,
+			CreatedAt: patient.CreatedAt.Time,
+			DeletedAt: optionalTime(patient.DeletedAt),
+		},
+		MedicalHistory: []ExportedCondition{},
+		Biometrics:     []ExportedReading{},
+		Sessions:       []ExportedSession{},
+	}
+
+	history, err := q.ListMedicalHistoryForErasure(ctx, patientID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load medical history: %v", err)
+	}
+	for _, row := range history {
+		export.MedicalHistory = append(export.MedicalHistory, ExportedCondition{
+			ID:            pg.ToUUID(row.ID).String(),
+			Condition:     row.Condition,
+			DiagnosedDate: optionalTime(row.DiagnosedDate),
+			Status:        row.StatusID,
+			Notes:         row.Notes.String,
+			CreatedAt:     row.CreatedAt.Time,
+			DeletedAt:     optionalTime(row.DeletedAt),
+		})
+	}
+
+	readings, err := q.ListPatientBiometrics(ctx, patientID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load biometrics: %v", err)
+	}
+	for _, row := range readings {
+		value, err := row.Value.Float64Value()
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid biometric value: %v", err)
+		}
+		reading := ExportedReading{
+			Type:       row.TypeID,
+			Value:      value.Float64,
+			Unit:       row.UnitType,
+			Source:     row.Source.String,
+			MeasuredAt: row.MeasuredAt.Time,
+		}
+		if row.SecondaryValue.Valid {
+			secondary, err := row.SecondaryValue.Float64Value()
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid biometric value: %v", err)
+			}
+			reading.SecondaryValue = &secondary.Float64
+		}
+		export.Biometrics = append(export.Biometrics, reading)
+	}
+
+	sessions, err := q.ListPatientChatSessions(ctx, patientID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load sessions: %v", err)
+	}
+	var sessionIDs []pgtype.UUID
+	index := make(map[string]int, len(sessions))
+	for _, row := range sessions {
+		id := pg.ToUUID(row.ID).String()
+		index[id] = len(export.Sessions)
+		sessionIDs = append(sessionIDs, row.ID)
+		export.Sessions = append(export.Sessions, ExportedSession{
+			ID:        id,
+			Status:    row.Status,
+			CreatedAt: row.CreatedAt.Time,
+			ClosedAt:  optionalTime(row.ClosedAt),
+			Messages:  []ExportedMessage{},
+		})
+	}
+
+	messages, err := q.ListPatientChatMessages(ctx, patientID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load messages: %v", err)
+	}
+	for _, row := range messages {
+		msg := ExportedMessage{
+			ID:          pg.ToUUID(row.ID).String(),
+			SenderID:    pg.ToUUID(row.SenderID).String(),
+			MessageType: row.MessageType,
+			Content:     row.Content,
+			CreatedAt:   row.CreatedAt.Time,
+		}
+		if row.ParentMessageID.Valid {
+			msg.ParentMessageID = pg.ToUUID(row.ParentMessageID).String()
+		}
+		session := &export.Sessions[index[pg.ToUUID(row.ChatSessionID).String()]]
+		session.Messages = append(session.Messages, msg)
+	}
+	return export, sessionIDs, nil
+}
+
+func optionalTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,240 @@
+// Package retention removes data past its retention period and erases
+// patients on request, keeping de-identified review outcomes for analytics
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PurgeResult counts what a purge removed
+type PurgeResult struct {
+	Sessions       int64 // Sessions whose messages were removed
+	Messages       int64
+	AIInteractions int64 // De-identified
+	Biometrics     int64
+}
+
+// Purger removes conversations, draft text and biometric readings past
+// their retention period
+type Purger struct {
+	db   *pgxpool.Pool
+	q    *db.EncryptedQueries
+	cfg  config.RetentionConfig
+	done chan struct{}
+}
+
+// NewPurger returns nil when cfg keeps everything forever
+func NewPurger(pool *pgxpool.Pool, cipher db.FieldCipher, cfg config.RetentionConfig) *Purger {
+	if cfg.Conversations == 0 && cfg.AIInteractions == 0 && cfg.Biometrics == 0 {
+		return nil
+	}
+	return &Purger{
+		db:   pool,
+		q:    db.NewEncrypted(db.New(pool), cipher),
+		cfg:  cfg,
+		done: make(chan struct{}),
+	}
+}
+
+// Run purges now and every purge interval until ctx is cancelled
+func (p *Purger) Run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.cfg.PurgeInterval)
+	defer ticker.Stop()
+
+	now := time.Now()
+	for {
+		result, err := p.Purge(ctx, now)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("retention purge failed", logging.Err(err))
+		}
+		if result != (PurgeResult{}) {
+			slog.Info("retention purge removed expired data", "sessions", result.Sessions, "messages", result.Messages,
+				"ai_interactions", result.AIInteractions, "biometrics", result.Biometrics)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case now = <-ticker.C:
+		}
+	}
+}
+
+// Done is closed when Run returns
+func (p *Purger) Done() <-chan struct{} {
+	return p.done
+}
+
+// Purge removes what expired at now, in batches of one transaction each.
+// The result counts what was removed even when it fails part way.
+func (p *Purger) Purge(ctx context.Context, now time.Time) (PurgeResult, error) {
+	var result PurgeResult
+	if p.cfg.Conversations > 0 {
+		if err := p.purgeConversations(ctx, now.Add(-p.cfg.Conversations), &result); err != nil {
+			return result, fmt.Errorf("failed to purge conversations: %v", err)
+		}
+	}
+	if p.cfg.AIInteractions > 0 {
+		if err := p.purgeAIInteractions(ctx, now.Add(-p.cfg.AIInteractions), &result); err != nil {
+			return result, fmt.Errorf("failed to purge AI interactions: %v", err)
+		}
+	}
+	if p.cfg.Biometrics > 0 {
+		if err := p.purgeBiometrics(ctx, now.Add(-p.cfg.Biometrics), &result); err != nil {
+			return result, fmt.Errorf("failed to purge biometrics: %v", err)
+		}
+	}
+	return result, nil
+}
+
+// purgeConversations removes the messages of sessions without a message
+// since cutoff, de-identifying their AI interactions. The sessions are kept.
+func (p *Purger) purgeConversations(ctx context.Context, cutoff time.Time, result *PurgeResult) error {
+	for {
+		var batch PurgeResult
+		err := p.inTx(ctx, func(q *db.EncryptedQueries) error {
+			ids, err := q.ListInactiveChatSessions(ctx, db.ListInactiveChatSessionsParams{
+				Cutoff: pgtype.Timestamptz{Time: cutoff, Valid: true},
+				Limit:  p.cfg.BatchSize,
+			})
+			if err != nil || len(ids) == 0 {
+				return err
+			}
+			if batch.AIInteractions, err = deidentifySessions(ctx, q, ids, p.cfg.BatchSize); err != nil {
+				return err
+			}
+			if batch.Messages, err = q.DeleteSessionMessages(ctx, ids); err != nil {
+				return fmt.Errorf("failed to delete messages: %v", err)
+			}
+			batch.Sessions = int64(len(ids))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		result.Sessions += batch.Sessions
+		result.Messages += batch.Messages
+		result.AIInteractions += batch.AIInteractions
+		if batch.Sessions < int64(p.cfg.BatchSize) {
+			return nil
+		}
+	}
+}
+
+// purgeAIInteractions removes the draft text of interactions created before
+// cutoff, from the interaction and its draft message
+func (p *Purger) purgeAIInteractions(ctx context.Context, cutoff time.Time, result *PurgeResult) error {
+	params := db.ListAIInteractionsToDeidentifyParams{
+		CreatedBefore: pgtype.Timestamptz{Time: cutoff, Valid: true},
+		Limit:         p.cfg.BatchSize,
+	}
+	for {
+		var n int
+		err := p.inTx(ctx, func(q *db.EncryptedQueries) error {
+			var err error
+			n, err = deidentifyBatch(ctx, q, params)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		result.AIInteractions += int64(n)
+		if n < int(p.cfg.BatchSize) {
+			return nil
+		}
+	}
+}
+
+// purgeBiometrics removes readings measured before cutoff
+func (p *Purger) purgeBiometrics(ctx context.Context, cutoff time.Time, result *PurgeResult) error {
+	for {
+		n, err := p.q.DeleteBiometricsBefore(ctx, db.DeleteBiometricsBeforeParams{
+			Cutoff: pgtype.Timestamptz{Time: cutoff, Valid: true},
+			Limit:  p.cfg.BatchSize,
+		})
+		result.Biometrics += n
+		if err != nil || n < int64(p.cfg.BatchSize) {
+			return err
+		}
+	}
+}
+
+// inTx runs fn in a transaction, committing if it returns nil
+func (p *Purger) inTx(ctx context.Context, fn func(q *db.EncryptedQueries) error) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(p.q.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// deidentifySessions de-identifies every AI interaction of the sessions
+func deidentifySessions(ctx context.Context, q *db.EncryptedQueries, sessionIDs []pgtype.UUID, batchSize int32) (int64, error) {
+	params := db.ListAIInteractionsToDeidentifyParams{SessionIds: sessionIDs, Limit: batchSize}
+	var total int64
+	for {
+		n, err := deidentifyBatch(ctx, q, params)
+		total += int64(n)
+		if err != nil || n < int(batchSize) {
+			return total, err
+		}
+	}
+}
+
+// deidentifyBatch removes the draft text, review comment and prompt of up to
+// params.Limit interactions, clears their draft message and detaches them
+// from it. Their review outcome is kept, with the edit distance between the
+// draft and the answer sent. It returns how many it changed.
+func deidentifyBatch(ctx context.Context, q *db.EncryptedQueries, params db.ListAIInteractionsToDeidentifyParams) (int, error) {
+	rows, err := q.ListAIInteractionsToDeidentify(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list AI interactions: %v", err)
+	}
+	for _, row := range rows {
+		arg := db.DeidentifyAIInteractionParams{ID: row.ID}
+		if sent, ok := sentAnswer(row); ok {
+			distance, longest := pg.EditDistance(row.AiResponse, sent)
+			arg.EditDistance = pgtype.Int4{Int32: int32(distance), Valid: true}
+			arg.EditLength = pgtype.Int4{Int32: int32(longest), Valid: true}
+		}
+		if err := q.DeidentifyAIInteraction(ctx, arg); err != nil {
+			return 0, fmt.Errorf("failed to de-identify AI interaction: %v", err)
+		}
+		if row.ChatMessageID.Valid {
+			if err := q.ClearChatMessageContent(ctx, row.ChatMessageID); err != nil {
+				return 0, fmt.Errorf("failed to clear draft message: %v", err)
+			}
+		}
+	}
+	return len(rows), nil
+}
+
+// sentAnswer returns the answer sent for an approved or modified draft
+func sentAnswer(row db.ListAIInteractionsToDeidentifyRow) (string, bool) {
+	switch row.ReviewStatus.String {
+	case db.ReviewApproved:
+		return row.AiResponse, true
+	case db.ReviewModified:
+		return row.ModifiedContent.String, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,269 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// fakeDB answers the purge's queries from memory, by the sqlc name of each
+type fakeDB struct {
+	interactions []db.ListAIInteractionsToDeidentifyRow // Still holding text, oldest first
+	deidentified []db.DeidentifyAIInteractionParams
+	cleared      []pgtype.UUID // Draft messages emptied
+	readings     int64         // Biometric readings left
+	cutoffs      []time.Time   // Of each DeleteBiometricsBefore
+	calls        map[string]int
+	fail         string // Query that returns an error
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{calls: make(map[string]int)}
+}
+
+func queryName(sql string) string {
+	name, _, _ := strings.Cut(strings.TrimPrefix(sql, "-- name: "), " ")
+	return name
+}
+
+func (f *fakeDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	name := queryName(sql)
+	f.calls[name]++
+	if name == f.fail {
+		return pgconn.CommandTag{}, errors.New("connection reset")
+	}
+	switch name {
+	case "DeidentifyAIInteraction":
+		arg := db.DeidentifyAIInteractionParams{EditDistance: args[0].(pgtype.Int4), EditLength: args[1].(pgtype.Int4), ID: args[2].(pgtype.UUID)}
+		f.deidentified = append(f.deidentified, arg)
+		for i, row := range f.interactions {
+			if row.ID == arg.ID {
+				f.interactions = append(f.interactions[:i], f.interactions[i+1:]...)
+				break
+			}
+		}
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	case "ClearChatMessageContent":
+		f.cleared = append(f.cleared, args[0].(pgtype.UUID))
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	case "DeleteBiometricsBefore":
+		f.cutoffs = append(f.cutoffs, args[0].(pgtype.Timestamptz).Time)
+		n := min(f.readings, int64(args[1].(int32)))
+		f.readings -= n
+		return pgconn.NewCommandTag(fmt.Sprintf("DELETE %d", n)), nil
+	}
+	return pgconn.CommandTag{}, fmt.Errorf("unexpected query %s", name)
+}
+
+func (f *fakeDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	name := queryName(sql)
+	f.calls[name]++
+	if name == f.fail {
+		return nil, errors.New("connection reset")
+	}
+	if name != "ListAIInteractionsToDeidentify" {
+		return nil, fmt.Errorf("unexpected query %s", name)
+	}
+	limit := int(args[2].(int32))
+	rows := &fakeRows{}
+	for _, row := range f.interactions[:min(limit, len(f.interactions))] {
+		rows.rows = append(rows.rows, []any{row.ID, row.ChatMessageID, row.AiResponse, row.ReviewStatus, row.ModifiedContent})
+	}
+	return rows, nil
+}
+
+func (f *fakeDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	panic("unexpected QueryRow " + queryName(sql))
+}
+
+// fakeRows hands out rows of values in column order
+type fakeRows struct {
+	rows [][]any
+	next int
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.NewCommandTag("SELECT") }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]any, error)                       { return r.rows[r.next-1], nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error {
+	for i, value := range r.rows[r.next-1] {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+	}
+	return nil
+}
+
+func text(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func interaction(status, response, modified string) db.ListAIInteractionsToDeidentifyRow {
+	return db.ListAIInteractionsToDeidentifyRow{
+		ID:              pg.NewUUID(),
+		ChatMessageID:   pg.NewUUID(),
+		AiResponse:      response,
+		ReviewStatus:    text(status),
+		ModifiedContent: text(modified),
+	}
+}
+
+func TestDeidentifyBatch(t *testing.T) {
+	detached := interaction(db.ReviewApproved, "Rest and drink water.", "")
+	detached.ChatMessageID = pgtype.UUID{}
+
+	tests := []struct {
+		name         string
+		row          db.ListAIInteractionsToDeidentifyRow
+		wantDistance pgtype.Int4
+		wantLength   pgtype.Int4
+		wantCleared  bool
+	}{
+		{"approved", interaction(db.ReviewApproved, "Rest and drink water.", ""), pgtype.Int4{Int32: 0, Valid: true}, pgtype.Int4{Int32: 21, Valid: true}, true},
+		{"modified", interaction(db.ReviewModified, "Take aspirin.", "Take ibuprofen."), pgtype.Int4{Int32: 7, Valid: true}, pgtype.Int4{Int32: 15, Valid: true}, true},
+		{"rejected", interaction("rejected", "Ignore it.", ""), pgtype.Int4{}, pgtype.Int4{}, true},
+		{"pending", interaction("", "Ignore it.", ""), pgtype.Int4{}, pgtype.Int4{}, true},
+		{"without draft message", detached, pgtype.Int4{Int32: 0, Valid: true}, pgtype.Int4{Int32: 21, Valid: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeDB()
+			fake.interactions = []db.ListAIInteractionsToDeidentifyRow{tt.row}
+			n, err := deidentifyBatch(context.Background(), db.NewEncrypted(db.New(fake), nil), db.ListAIInteractionsToDeidentifyParams{Limit: 10})
+			if err != nil || n != 1 {
+				t.Fatalf("deidentifyBatch() = %d, %v, want 1", n, err)
+			}
+			got := fake.deidentified[0]
+			if got.ID != tt.row.ID || got.EditDistance != tt.wantDistance || got.EditLength != tt.wantLength {
+				t.Fatalf("de-identified with distance %v of %v, want %v of %v", got.EditDistance, got.EditLength, tt.wantDistance, tt.wantLength)
+			}
+			if cleared := len(fake.cleared) == 1 && fake.cleared[0] == tt.row.ChatMessageID; cleared != tt.wantCleared {
+				t.Fatalf("draft message cleared = %v, want %v", cleared, tt.wantCleared)
+			}
+		})
+	}
+}
+
+func TestDeidentifySessionsInBatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		rows      int
+		batchSize int32
+		wantLists int
+	}{
+		{"none", 0, 2, 1},
+		{"partial batch", 3, 5, 1},
+		{"full batches", 4, 2, 3},
+		{"full and partial batches", 5, 2, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeDB()
+			for i := 0; i < tt.rows; i++ {
+				fake.interactions = append(fake.interactions, interaction(db.ReviewApproved, "Rest.", ""))
+			}
+			n, err := deidentifySessions(context.Background(), db.NewEncrypted(db.New(fake), nil), []pgtype.UUID{pg.NewUUID()}, tt.batchSize)
+			if err != nil || n != int64(tt.rows) {
+				t.Fatalf("deidentifySessions() = %d, %v, want %d", n, err, tt.rows)
+			}
+			if got := fake.calls["ListAIInteractionsToDeidentify"]; got != tt.wantLists {
+				t.Fatalf("listed %d batches, want %d", got, tt.wantLists)
+			}
+		})
+	}
+}
+
+func TestDeidentifyBatchStopsOnError(t *testing.T) {
+	fake := newFakeDB()
+	fake.interactions = []db.ListAIInteractionsToDeidentifyRow{interaction(db.ReviewApproved, "Rest.", "")}
+	fake.fail = "ClearChatMessageContent"
+	if _, err := deidentifyBatch(context.Background(), db.NewEncrypted(db.New(fake), nil), db.ListAIInteractionsToDeidentifyParams{Limit: 10}); err == nil {
+		t.Fatal("deidentifyBatch() succeeded when clearing the draft message failed")
+	}
+}
+
+func TestPurgeBiometrics(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		readings    int64
+		batchSize   int32
+		fail        string
+		want        int64
+		wantDeletes int
+		wantErr     bool
+	}{
+		{"nothing expired", 0, 100, "", 0, 1, false},
+		{"one batch", 30, 100, "", 30, 1, false},
+		{"exact batches", 200, 100, "", 200, 3, false},
+		{"several batches", 250, 100, "", 250, 3, false},
+		{"failure", 250, 100, "DeleteBiometricsBefore", 0, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeDB()
+			fake.readings = tt.readings
+			fake.fail = tt.fail
+			p := &Purger{
+				q:   db.NewEncrypted(db.New(fake), nil),
+				cfg: config.RetentionConfig{Biometrics: 30 * 24 * time.Hour, BatchSize: tt.batchSize},
+			}
+
+			result, err := p.Purge(context.Background(), now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Purge() error = %v, want error %v", err, tt.wantErr)
+			}
+			if result.Biometrics != tt.want || result.Sessions != 0 || result.AIInteractions != 0 {
+				t.Fatalf("Purge() = %+v, want %d readings only", result, tt.want)
+			}
+			if got := fake.calls["DeleteBiometricsBefore"]; got != tt.wantDeletes {
+				t.Fatalf("deleted in %d batches, want %d", got, tt.wantDeletes)
+			}
+			for _, cutoff := range fake.cutoffs {
+				if want := now.Add(-30 * 24 * time.Hour); !cutoff.Equal(want) {
+					t.Fatalf("cutoff = %v, want %v", cutoff, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewPurger(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.RetentionConfig
+		want bool
+	}{
+		{"keeps everything", config.RetentionConfig{PurgeInterval: time.Hour, BatchSize: 100}, false},
+		{"conversations", config.RetentionConfig{Conversations: time.Hour}, true},
+		{"ai interactions", config.RetentionConfig{AIInteractions: time.Hour}, true},
+		{"biometrics", config.RetentionConfig{Biometrics: time.Hour}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPurger(nil, nil, tt.cfg) != nil; got != tt.want {
+				t.Fatalf("NewPurger() returned a purger = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// draftQualityColumns is the header of CSV exports
+var draftQualityColumns = []string{
+	"bucket_start", "group", "group_name", "total", "pending", "accepted", "modified", "rejected",
+	"accept_rate", "modify_rate", "reject_rate", "mean_confidence",
+	"mean_review_latency_seconds", "median_review_latency_seconds",
+	"mean_edit_distance", "mean_normalized_edit_distance",
+}
+
+// AnalyticsServer reports on the quality of AI drafts, over gRPC and as JSON
+// or CSV over HTTP
+type AnalyticsServer struct {
+	pb.UnimplementedAnalyticsServiceServer
+	*recordsServer
+}
+
+func NewAnalyticsServer(records *recordsServer) *AnalyticsServer {
+	return &AnalyticsServer{recordsServer: records}
+}
+
+// Bounds on the work of one draft quality report
+const (
+	maxDraftQualityRange = 366 * 24 * time.Hour
+	editDistanceSamples  = 200  // Drafts of each group whose edit distance is computed
+	maxEditRunes         = 1000 // Of each text compared, longer ones are cut
+)
+
+// Units of GetDraftQualityStats
+var (
+	bucketUnits = map[pb.TimeBucket]string{
+		pb.TimeBucket_TIME_BUCKET_NONE:  "",
+		pb.TimeBucket_TIME_BUCKET_HOUR:  "hour",
+		pb.TimeBucket_TIME_BUCKET_DAY:   "day",
+		pb.TimeBucket_TIME_BUCKET_WEEK:  "week",
+		pb.TimeBucket_TIME_BUCKET_MONTH: "month",
+	}
+	groupColumns = map[pb.AnalyticsGroup]string{
+		pb.AnalyticsGroup_ANALYTICS_GROUP_NONE:           "",
+		pb.AnalyticsGroup_ANALYTICS_GROUP_DOCTOR:         "doctor",
+		pb.AnalyticsGroup_ANALYTICS_GROUP_DEPARTMENT:     "department",
+		pb.AnalyticsGroup_ANALYTICS_GROUP_PROMPT_VERSION: "prompt_version",
+	}
+)
+
+// draftGroup is the bucket and group a draft is counted in
+type draftGroup struct {
+	bucket time.Time
+	group  string
+}
+
+// editSample sums the edit distances computed for a sample of a group's
+// drafts
+type editSample struct {
+	sum, normalizedSum float64
+	count              int64
+}
+
+func (s *AnalyticsServer) GetDraftQuality(ctx context.Context, req *pb.DraftQualityRequest) (*pb.DraftQualityResponse, error) {
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time is required")
+	}
+	start, end := req.StartTime.AsTime(), time.Now()
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	if start.After(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_time is after end_time")
+	}
+	if end.Sub(start) > maxDraftQualityRange {
+		return nil, status.Errorf(codes.InvalidArgument, "the range may span at most %d days", int(maxDraftQualityRange.Hours()/24))
+	}
+	group, ok := groupColumns[req.GroupBy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown group_by %d", req.GroupBy)
+	}
+	bucket, ok := bucketUnits[req.Bucket]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown bucket %d", req.Bucket)
+	}
+
+	params := db.GetDraftQualityStatsParams{
+		Bucket:    bucket,
+		GroupBy:   group,
+		StartTime: pgtype.Timestamptz{Time: start, Valid: true},
+		EndTime:   pgtype.Timestamptz{Time: end, Valid: true},
+	}
+	rows, err := s.dbq.GetDraftQualityStats(ctx, params)
+	if err != nil {
+		return nil, internalError("failed to aggregate drafts", err)
+	}
+	samples, err := s.sampleEditDistances(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DraftQualityResponse{}
+	for _, row := range rows {
+		stats := draftQualityStats(row, samples[draftGroup{bucket: row.BucketStart.Time.UTC(), group: row.GroupID}])
+		if row.BucketStart.Valid {
+			stats.BucketStart = timestamppb.New(row.BucketStart.Time)
+		}
+		resp.Stats = append(resp.Stats, stats)
+	}
+	return resp, nil
+}
+
+// sampleEditDistances computes the edit distance of a random sample of each
+// group's accepted and modified drafts that still have their texts
+func (s *AnalyticsServer) sampleEditDistances(ctx context.Context, params db.GetDraftQualityStatsParams) (map[draftGroup]*editSample, error) {
+	rows, err := s.dbq.ListAIInteractionsForAnalytics(ctx, db.ListAIInteractionsForAnalyticsParams{
+		Bucket:     params.Bucket,
+		GroupBy:    params.GroupBy,
+		StartTime:  params.StartTime,
+		EndTime:    params.EndTime,
+		SampleSize: editDistanceSamples,
+	})
+	if err != nil {
+		return nil, internalError("failed to load drafts", err)
+	}
+
+	samples := make(map[draftGroup]*editSample)
+	for _, row := range rows {
+		final := row.AiResponse
+		if row.ReviewStatus.String == db.ReviewModified {
+			final = row.ModifiedContent.String
+		}
+		distance, longest := pg.EditDistance(truncateRunes(row.AiResponse, maxEditRunes), truncateRunes(final, maxEditRunes))
+
+		key := draftGroup{bucket: row.BucketStart.Time.UTC(), group: row.GroupID}
+		sample, ok := samples[key]
+		if !ok {
+			sample = &editSample{}
+			samples[key] = sample
+		}
+		sample.sum += float64(distance)
+		if longest > 0 {
+			sample.normalizedSum += float64(distance) / float64(longest)
+		}
+		sample.count++
+	}
+	return samples, nil
+}
+
+// ServeHTTP handles GET /api/v1/analytics/draft-quality. The query holds the
+// DraftQualityRequest fields, format=csv returns CSV instead of JSON.
+func (s *AnalyticsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	query.Del("format")
+	if format != "" && format != "json" && format != "csv" {
+		http.Error(w, "format must be json or csv", http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.DraftQualityRequest{}
+	for name, values := range query {
+		if err := setField(req, name, values[len(values)-1]); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	resp, err := invoke(ctx, s.intercept, pb.AnalyticsService_GetDraftQuality_FullMethodName, req, s.GetDraftQuality)
+	if err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), httpStatus(st.Code()))
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="draft-quality.csv"`)
+		writeDraftQualityCSV(w, resp.Stats)
+		return
+	}
+	out, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
+}
+
+// writeDraftQualityCSV writes one row per group under draftQualityColumns
+func writeDraftQualityCSV(w http.ResponseWriter, stats []*pb.DraftQualityStats) {
+	cw := csv.NewWriter(w)
+	cw.Write(draftQualityColumns)
+	for _, st := range stats {
+		bucket := ""
+		if st.BucketStart != nil {
+			bucket = st.BucketStart.AsTime().UTC().Format(time.RFC3339)
+		}
+		cw.Write([]string{
+			bucket, st.Group, st.GroupName,
+			strconv.FormatInt(st.Total, 10), strconv.FormatInt(st.Pending, 10),
+			strconv.FormatInt(st.Accepted, 10), strconv.FormatInt(st.Modified, 10), strconv.FormatInt(st.Rejected, 10),
+			formatFloat(st.AcceptRate), formatFloat(st.ModifyRate), formatFloat(st.RejectRate),
+			formatFloat(st.MeanConfidence),
+			formatFloat(st.MeanReviewLatencySeconds), formatFloat(st.MedianReviewLatencySeconds),
+			formatFloat(st.MeanEditDistance), formatFloat(st.MeanNormalizedEditDistance),
+		})
+	}
+	cw.Flush()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// draftQualityStats returns a group's rates and means, zero where there is
+// nothing to average. The edit distance of drafts that still have their
+// texts is estimated from sample, those de-identified kept theirs.
+func draftQualityStats(row db.GetDraftQualityStatsRow, sample *editSample) *pb.DraftQualityStats {
+	st := &pb.DraftQualityStats{
+		Group:                      row.GroupID,
+		GroupName:                  row.GroupName,
+		Total:                      row.Total,
+		Pending:                    row.Pending,
+		Accepted:                   row.Accepted,
+		Modified:                   row.Modified,
+		Rejected:                   row.Rejected,
+		MeanConfidence:             row.MeanConfidence,
+		MeanReviewLatencySeconds:   row.MeanReviewLatencySeconds,
+		MedianReviewLatencySeconds: row.MedianReviewLatencySeconds,
+	}
+	if reviewed := row.Accepted + row.Modified + row.Rejected; reviewed > 0 {
+		st.AcceptRate = float64(row.Accepted) / float64(reviewed)
+		st.ModifyRate = float64(row.Modified) / float64(reviewed)
+		st.RejectRate = float64(row.Rejected) / float64(reviewed)
+	}
+
+	editSum, normalizedSum, editCount := row.EditDistanceSum, row.NormalizedEditDistanceSum, float64(row.EditDistanceCount)
+	if sample != nil && sample.count > 0 && row.UnscoredCount > 0 {
+		// The drafts that still have their texts count at the mean of those sampled
+		scale := float64(row.UnscoredCount) / float64(sample.count)
+		editSum += sample.sum * scale
+		normalizedSum += sample.normalizedSum * scale
+		editCount += float64(row.UnscoredCount)
+	}
+	if editCount > 0 {
+		st.MeanEditDistance = editSum / editCount
+		st.MeanNormalizedEditDistance = normalizedSum / editCount
+	}
+	return st
+}
+
+// truncateRunes returns the first n runes of text
+func truncateRunes(text string, n int) string {
+	for i := range text {
+		if n == 0 {
+			return text[:i]
+		}
+		n--
+	}
+	return text
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// auditQueueSize bounds the audit events waiting to be appended
+const auditQueueSize = 1024
+
+// recordAudit queues e for the audit log. A failure is logged and does not
+// fail the action being audited.
+func (s *BaseServer) recordAudit(e audit.Event) {
+	s.auditQueue.Record(e)
+}
+
+// auditConn records an action of a WebSocket participant, on the patient of
+// their session. The actor is who authenticated: staff by their token, with
+// the user ID it carries as a detail.
+func (s *WebSocketServer) auditConn(conn *Connection, action, resource string, details map[string]string) {
+	if details == nil {
+		details = map[string]string{}
+	}
+	if conn.doctorID != "" {
+		details["doctor_id"] = conn.doctorID
+	}
+	e := audit.Event{
+		Action:    action,
+		ActorRole: string(conn.role),
+		ActorID:   conn.principal,
+		SessionID: conn.sessionID,
+		Resource:  resource,
+		Details:   details,
+	}
+	s.mu.RLock()
+	if session, exists := s.sessions[conn.sessionID]; exists {
+		e.PatientID = session.patientID
+	}
+	s.mu.RUnlock()
+	s.recordAudit(e)
+}
+
+// auditDraftView records that the session's doctor and observers were shown
+// a draft
+func (s *WebSocketServer) auditDraftView(sessionID, messageID string) {
+	s.mu.RLock()
+	var viewers []*Connection
+	if session, exists := s.sessions[sessionID]; exists {
+		if session.doctorConn != nil {
+			viewers = append(viewers, session.doctorConn)
+		}
+		viewers = append(viewers, session.observerConns()...)
+	}
+	s.mu.RUnlock()
+
+	for _, conn := range viewers {
+		s.auditConn(conn, audit.ActionDraftView, messageID, nil)
+	}
+}
+
+// auditAdmin records a management RPC. Callers are identified by their role
+// and a fingerprint of their token, never the token itself.
+func (s *recordsServer) auditAdmin(ctx context.Context, method string, patientID pgtype.UUID, resource string, details map[string]string) {
+	if details == nil {
+		details = map[string]string{}
+	}
+	details["method"] = method
+	caller, _ := authz.FromContext(ctx)
+	s.recordAudit(audit.Event{
+		Action:    audit.ActionAdmin,
+		ActorRole: string(caller.Role),
+		ActorID:   caller.ID,
+		PatientID: patientID,
+		Resource:  resource,
+		Details:   details,
+	})
+}
+
+// AuditServer answers access accounting queries over the audit log
+type AuditServer struct {
+	pb.UnimplementedAuditServiceServer
+	*recordsServer
+}
+
+func NewAuditServer(records *recordsServer) *AuditServer {
+	return &AuditServer{recordsServer: records}
+}
+
+func (s *AuditServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if req.PatientId == "" && req.ActorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "patient_id or actor_id is required")
+	}
+	limit, offset, err := pageBounds(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.ListAuditEventsParams{
+		StartTime: pgtype.Timestamptz{Time: time.Unix(0, 0), Valid: true},
+		EndTime:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		Limit:     limit + 1,
+		Offset:    offset,
+	}
+	if req.PatientId != "" {
+		if params.PatientID, err = parseID("patient_id", req.PatientId); err != nil {
+			return nil, err
+		}
+	}
+	if req.ActorId != "" {
+		params.ActorID = pgtype.Text{String: req.ActorId, Valid: true}
+	}
+	if req.StartTime != nil {
+		params.StartTime.Time = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		params.EndTime.Time = req.EndTime.AsTime()
+	}
+
+	rows, err := s.dbq.ListAuditEvents(ctx, params)
+	if err != nil {
+		return nil, internalError("failed to list audit events", err)
+	}
+	s.auditAdmin(ctx, "ListAuditEvents", params.PatientID, req.ActorId, nil)
+
+	resp := &pb.ListAuditEventsResponse{NextPageToken: nextPageToken(offset, limit, len(rows))}
+	for i, row := range rows {
+		if i == int(limit) {
+			break
+		}
+		e, err := audit.FromRow(row)
+		if err != nil {
+			return nil, internalError("failed to load audit event", err)
+		}
+		resp.Events = append(resp.Events, auditEventMessage(e))
+	}
+	return resp, nil
+}
+
+func (s *AuditServer) VerifyAuditLog(ctx context.Context, req *pb.VerifyAuditLogRequest) (*pb.VerifyAuditLogResponse, error) {
+	result, err := s.auditLog.Verify(ctx)
+	if err != nil {
+		return nil, internalError("failed to verify audit log", err)
+	}
+	if !result.Valid {
+		slog.Error("audit log chain is broken", "seq", result.FirstInvalidSeq, "reason", result.Reason)
+	}
+	s.auditAdmin(ctx, "VerifyAuditLog", pgtype.UUID{}, "", nil)
+
+	return &pb.VerifyAuditLogResponse{
+		Valid:           result.Valid,
+		EventsChecked:   result.Checked,
+		FirstInvalidSeq: result.FirstInvalidSeq,
+		Reason:          result.Reason,
+	}, nil
+}
+
+func auditEventMessage(e audit.Event) *pb.AuditEvent {
+	msg := &pb.AuditEvent{
+		Seq:        e.Seq,
+		OccurredAt: timestamppb.New(e.OccurredAt),
+		Action:     e.Action,
+		ActorRole:  e.ActorRole,
+		ActorId:    e.ActorID,
+		SessionId:  e.SessionID,
+		Resource:   e.Resource,
+		Details:    e.Details,
+		PrevHash:   hex.EncodeToString(e.PrevHash),
+		Hash:       hex.EncodeToString(e.Hash),
+	}
+	if e.PatientID.Valid {
+		msg.PatientId = pg.ToUUID(e.PatientID).String()
+	}
+	return msg
+}
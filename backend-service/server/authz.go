@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+
+	"llm-qa-system/backend-service/authz"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// methodPermissions is the permission each RPC requires. The interceptor
+// refuses RPCs missing here, except those of the health and reflection
+// services.
+var methodPermissions = authz.MethodPermissions{
+	Methods: map[string]authz.Permission{
+		pb.BiometricService_IngestBiometrics_FullMethodName: authz.IngestBiometrics,
+
+		pb.PatientService_CreatePatient_FullMethodName: authz.ManagePatients,
+		pb.PatientService_GetPatient_FullMethodName:    authz.ManagePatients,
+		pb.PatientService_ListPatients_FullMethodName:  authz.ManagePatients,
+		pb.PatientService_UpdatePatient_FullMethodName: authz.ManagePatients,
+		pb.PatientService_DeletePatient_FullMethodName: authz.ManagePatients,
+
+		pb.DoctorService_CreateDoctor_FullMethodName: authz.ManageDoctors,
+		pb.DoctorService_GetDoctor_FullMethodName:    authz.ManageDoctors,
+		pb.DoctorService_ListDoctors_FullMethodName:  authz.ManageDoctors,
+		pb.DoctorService_UpdateDoctor_FullMethodName: authz.ManageDoctors,
+		pb.DoctorService_DeleteDoctor_FullMethodName: authz.ManageDoctors,
+
+		pb.MedicalHistoryService_AddCondition_FullMethodName:    authz.ManageMedicalHistory,
+		pb.MedicalHistoryService_ListConditions_FullMethodName:  authz.ManageMedicalHistory,
+		pb.MedicalHistoryService_UpdateCondition_FullMethodName: authz.ManageMedicalHistory,
+		pb.MedicalHistoryService_DeleteCondition_FullMethodName: authz.ManageMedicalHistory,
+
+		pb.PromptTemplateService_CreatePromptTemplate_FullMethodName:   authz.ManageTemplates,
+		pb.PromptTemplateService_GetPromptTemplate_FullMethodName:      authz.ManageTemplates,
+		pb.PromptTemplateService_ListPromptTemplates_FullMethodName:    authz.ManageTemplates,
+		pb.PromptTemplateService_ActivatePromptTemplate_FullMethodName: authz.ManageTemplates,
+		pb.PromptTemplateService_SetPromptExperiment_FullMethodName:    authz.ManageTemplates,
+		pb.PromptTemplateService_GetPromptExperiment_FullMethodName:    authz.ManageTemplates,
+		pb.PromptTemplateService_GetPromptTemplateStats_FullMethodName: authz.ReadAnalytics,
+
+		pb.AnalyticsService_GetDraftQuality_FullMethodName: authz.ReadAnalytics,
+
+		pb.AuditService_ListAuditEvents_FullMethodName: authz.ReadAuditLog,
+		pb.AuditService_VerifyAuditLog_FullMethodName:  authz.ReadAuditLog,
+	},
+	Public: []string{
+		healthpb.Health_ServiceDesc.ServiceName,
+		"grpc.reflection.v1.ServerReflection",
+		"grpc.reflection.v1alpha.ServerReflection",
+	},
+}
+
+// invoke calls an RPC received over HTTP through intercept, so it is
+// authorized like the same RPC over gRPC
+func invoke[Req, Resp any](ctx context.Context, intercept grpc.UnaryServerInterceptor, method string, req Req, call func(context.Context, Req) (Resp, error)) (Resp, error) {
+	resp, err := intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+		return call(ctx, req.(Req))
+	})
+	if err != nil {
+		var zero Resp
+		return zero, err
+	}
+	return resp.(Resp), nil
+}
+
+// messagePermission returns the permission conn needs to send msg, false
+// for messages the server ignores, MESSAGE_ACKs, which any participant sends
+// for what it receives, and the TYPING and PRESENCE any participant shares
+func messagePermission(conn *Connection, msg *pb.WebSocketMessage) (authz.Permission, bool) {
+	switch msg.Type {
+	case pb.MessageType_PATIENT_MESSAGE:
+		return authz.SendPatientMessage, true
+	case pb.MessageType_DOCTOR_MESSAGE:
+		return authz.SendFreeText, true
+	case pb.MessageType_SESSION_HANDOFF:
+		if conn.observer {
+			return authz.ReassignSession, true
+		}
+		return authz.HandOffSession, true
+	case pb.MessageType_DOCTOR_STATUS:
+		return authz.TakeSessions, true
+	case pb.MessageType_DRAFT_REVIEW:
+		// A modified draft is the reviewer's own text
+		if msg.GetReview().GetAction() == pb.ReviewAction_MODIFY {
+			return authz.SendFreeText, true
+		}
+		return authz.ReviewDraft, true
+	default:
+		return "", false
+	}
+}
+
+// authenticateStaff verifies a staff connection's token and identifies it by
+// the user the token was issued to, never by a client-chosen ID
+func (s *WebSocketServer) authenticateStaff(conn *Connection, req sessionRequest) error {
+	doctorID, err := s.authn.StaffID(conn.role, req.token, req.doctorID)
+	if err != nil {
+		return refuse(CloseUnauthorized, "invalid %s token: %v", conn.role, err)
+	}
+	conn.doctorID = doctorID
+	conn.principal = authz.PrincipalID(conn.role, req.token)
+	return nil
+}
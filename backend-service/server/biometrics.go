@@ -0,0 +1,343 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BloodPressureType is the only biometric type with a secondary value
+const BloodPressureType = "BLOOD_PRESSURE"
+
+// maxIngestBodyBytes caps HTTP request bodies, well above a full batch
+const maxIngestBodyBytes = 4 << 20
+
+// biometricTypes maps ref_biometric_types IDs to the types sent to the LLM service
+var biometricTypes = map[string]pb.BiometricType{
+	"HEART_RATE":        pb.BiometricType_BIOMETRIC_HEART_RATE,
+	"OXYGEN_SATURATION": pb.BiometricType_BIOMETRIC_BLOOD_OXYGEN,
+	"BLOOD_PRESSURE":    pb.BiometricType_BIOMETRIC_BLOOD_PRESSURE,
+	"TEMPERATURE":       pb.BiometricType_BIOMETRIC_TEMPERATURE,
+	"BLOOD_GLUCOSE":     pb.BiometricType_BIOMETRIC_BLOOD_GLUCOSE,
+	"RESPIRATORY_RATE":  pb.BiometricType_BIOMETRIC_RESPIRATORY_RATE,
+	"WEIGHT":            pb.BiometricType_BIOMETRIC_WEIGHT,
+	"HEIGHT":            pb.BiometricType_BIOMETRIC_HEIGHT,
+	"BMI":               pb.BiometricType_BIOMETRIC_BMI,
+	"STEPS":             pb.BiometricType_BIOMETRIC_STEPS,
+}
+
+// errUnknownPatient is returned when readings name a patient that does not exist
+var errUnknownPatient = errors.New("unknown patient")
+
+// BiometricServer stores batches of readings sent by wearables, over gRPC
+// and as JSON over HTTP
+type BiometricServer struct {
+	pb.UnimplementedBiometricServiceServer
+	*BaseServer
+	cfg    config.BiometricsConfig
+	authn  *authz.Authenticator // Authorizes HTTP requests, gRPC ones go through the interceptor
+	vitals *VitalsMonitor
+	ws     *WebSocketServer // Delivers vital alerts to doctors
+}
+
+func NewBiometricServer(base *BaseServer, cfg *config.Config, authn *authz.Authenticator, ws *WebSocketServer) *BiometricServer {
+	return &BiometricServer{
+		BaseServer: base,
+		cfg:        cfg.Biometrics,
+		authn:      authn,
+		vitals:     NewVitalsMonitor(cfg.Vitals),
+		ws:         ws,
+	}
+}
+
+// IngestBiometrics stores a batch of readings of the patient the caller's
+// device token is bound to
+func (s *BiometricServer) IngestBiometrics(ctx context.Context, req *pb.IngestBiometricsRequest) (*pb.IngestBiometricsResponse, error) {
+	if err := s.authn.DevicePatient(authz.MetadataToken(ctx), req.PatientId); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	resp, err := s.ingest(ctx, req)
+	switch {
+	case err == nil:
+		return resp, nil
+	case errors.Is(err, errUnknownPatient):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case resp == nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, "failed to store readings")
+	}
+}
+
+// ServeHTTP handles POST /api/v1/biometrics. The body is the JSON form of
+// IngestBiometricsRequest and the device token is sent as "Authorization:
+// Bearer".
+func (s *BiometricServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	token := authz.BearerToken(r.Header.Get("Authorization"))
+	if _, err := s.authn.Authorize(token, authz.IngestBiometrics); err != nil {
+		st := status.Convert(err)
+		http.Error(w, st.Message(), httpStatus(st.Code()))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestBodyBytes))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var req pb.IngestBiometricsRequest
+	if err := protojson.Unmarshal(body, &req); err != nil {
+		slog.Warn("invalid biometrics request", "remote_addr", r.RemoteAddr, logging.Err(err))
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if err := s.authn.DevicePatient(token, req.PatientId); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	resp, err := s.ingest(r.Context(), &req)
+	switch {
+	case err == nil:
+	case errors.Is(err, errUnknownPatient):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case resp == nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	default:
+		http.Error(w, "failed to store readings", http.StatusInternalServerError)
+		return
+	}
+
+	out, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
+}
+
+// ingest validates and stores req. Invalid readings are reported in the
+// response, not as an error. A nil response with an error means the request
+// itself is invalid; a response with an error means storing failed.
+func (s *BiometricServer) ingest(ctx context.Context, req *pb.IngestBiometricsRequest) (*pb.IngestBiometricsResponse, error) {
+	patientID, err := pg.ParseUUID(req.PatientId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patient_id: %v", err)
+	}
+	if len(req.Readings) == 0 {
+		return nil, fmt.Errorf("no readings")
+	}
+	if len(req.Readings) > s.cfg.MaxBatchSize {
+		return nil, fmt.Errorf("batch of %d readings exceeds the limit of %d", len(req.Readings), s.cfg.MaxBatchSize)
+	}
+
+	types, err := s.dbq.ListActiveBiometricTypes(ctx)
+	if err != nil {
+		slog.Error("failed to load biometric types", logging.Err(err))
+		return &pb.IngestBiometricsResponse{}, fmt.Errorf("failed to load biometric types: %v", err)
+	}
+	units := make(map[string]string, len(types))
+	for _, t := range types {
+		units[t.ID] = t.UnitType
+	}
+
+	resp := &pb.IngestBiometricsResponse{}
+	now := time.Now()
+	seen := make(map[string]struct{}, len(req.Readings))
+	var valid []*pb.BiometricReading
+	for i, reading := range req.Readings {
+		if reason := validateReading(reading, units, now.Add(s.cfg.MaxClockSkew)); reason != "" {
+			resp.Rejected = append(resp.Rejected, &pb.RejectedReading{Index: int32(i), Reason: reason})
+			continue
+		}
+
+		key := reading.TypeId + "/" + strconv.FormatInt(reading.MeasuredAt.AsTime().UnixNano(), 10)
+		if _, dup := seen[key]; dup {
+			resp.Duplicates++
+			continue
+		}
+		seen[key] = struct{}{}
+		valid = append(valid, reading)
+	}
+
+	stored, err := s.store(ctx, patientID, req.Source, valid, resp)
+	if err != nil {
+		if !errors.Is(err, errUnknownPatient) {
+			slog.Error("failed to store biometric readings", "source", req.Source, logging.Err(err))
+		}
+		return resp, err
+	}
+	if len(stored) > 0 {
+		s.checkVitals(ctx, patientID, stored, units)
+	}
+
+	slog.Info("biometric readings ingested", "source", req.Source,
+		"accepted", resp.Accepted, "duplicates", resp.Duplicates, "rejected", len(resp.Rejected))
+	return resp, nil
+}
+
+// store inserts the readings in one transaction, counting readings already
+// stored as duplicates, and returns the readings it inserted
+func (s *BiometricServer) store(ctx context.Context, patientID pgtype.UUID, source string, readings []*pb.BiometricReading, resp *pb.IngestBiometricsResponse) ([]*pb.BiometricReading, error) {
+	if len(readings) == 0 {
+		return nil, nil
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.dbq.WithTx(tx)
+	var stored []*pb.BiometricReading
+	var duplicates int32
+	for _, reading := range readings {
+		params := db.IngestBiometricReadingParams{
+			PatientID:  patientID,
+			TypeID:     reading.TypeId,
+			Value:      pg.Float64ToNumeric(reading.Value),
+			MeasuredAt: pgtype.Timestamptz{Time: reading.MeasuredAt.AsTime(), Valid: true},
+			Source:     pgtype.Text{String: source, Valid: source != ""},
+		}
+		if reading.TypeId == BloodPressureType {
+			params.SecondaryValue = pg.Float64ToNumeric(reading.SecondaryValue)
+		}
+
+		rows, err := q.IngestBiometricReading(ctx, params)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+				return nil, errUnknownPatient
+			}
+			return nil, fmt.Errorf("failed to insert reading: %v", err)
+		}
+		if rows == 0 {
+			duplicates++
+		} else {
+			stored = append(stored, reading)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit readings: %v", err)
+	}
+	resp.Accepted += int32(len(stored))
+	resp.Duplicates += duplicates
+	return stored, nil
+}
+
+// checkVitals evaluates the vitals rules against stored readings and raises
+// an alert for each rule that fires. Failures are logged, the readings are
+// already stored.
+func (s *BiometricServer) checkVitals(ctx context.Context, patientID pgtype.UUID, stored []*pb.BiometricReading, units map[string]string) {
+	alerts, err := s.vitals.Evaluate(ctx, s.dbq, patientID, stored, units)
+	if err != nil {
+		slog.Error("failed to evaluate vitals rules", logging.Err(err))
+	}
+	for _, alert := range alerts {
+		if err := s.ws.raiseVitalAlert(ctx, patientID, alert); err != nil {
+			slog.Error("failed to raise vital alert", "rule", alert.Rule, logging.Err(err))
+		}
+	}
+}
+
+// validateReading returns why a reading is rejected, or "" if it is valid.
+// units maps active biometric type IDs to their unit.
+func validateReading(r *pb.BiometricReading, units map[string]string, latest time.Time) string {
+	unit, ok := units[r.TypeId]
+	if !ok {
+		return fmt.Sprintf("unknown biometric type %q", r.TypeId)
+	}
+	if !strings.EqualFold(strings.TrimSpace(r.Unit), unit) {
+		return fmt.Sprintf("unit %q does not match %s, expected %q", r.Unit, r.TypeId, unit)
+	}
+	if math.IsNaN(r.Value) || math.IsInf(r.Value, 0) || r.Value < 0 {
+		return "value must be a non-negative number"
+	}
+
+	if r.TypeId == BloodPressureType {
+		if math.IsNaN(r.SecondaryValue) || math.IsInf(r.SecondaryValue, 0) || r.SecondaryValue <= 0 {
+			return "secondary_value (diastolic) is required for BLOOD_PRESSURE"
+		}
+		if r.SecondaryValue >= r.Value {
+			return "diastolic pressure must be below systolic"
+		}
+	} else if r.SecondaryValue != 0 {
+		return fmt.Sprintf("secondary_value is only used for %s", BloodPressureType)
+	}
+
+	if r.MeasuredAt == nil {
+		return "measured_at is required"
+	}
+	if err := r.MeasuredAt.CheckValid(); err != nil {
+		return fmt.Sprintf("invalid measured_at: %v", err)
+	}
+	if r.MeasuredAt.AsTime().After(latest) {
+		return "measured_at is in the future"
+	}
+	return ""
+}
+
+// latestBiometrics returns the patient's most recent reading of each type in
+// the form sent to the LLM service
+func latestBiometrics(ctx context.Context, q *db.EncryptedQueries, patientID pgtype.UUID) ([]*pb.BiometricData, error) {
+	rows, err := q.GetLatestBiometrics(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load biometrics: %v", err)
+	}
+
+	out := make([]*pb.BiometricData, 0, len(rows))
+	for _, row := range rows {
+		value, err := row.Value.Float64Value()
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", row.TypeID, err)
+		}
+
+		text := strconv.FormatFloat(value.Float64, 'f', -1, 64)
+		if row.SecondaryValue.Valid {
+			secondary, err := row.SecondaryValue.Float64Value()
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s secondary value: %v", row.TypeID, err)
+			}
+			text += "/" + strconv.FormatFloat(secondary.Float64, 'f', -1, 64)
+		}
+
+		out = append(out, &pb.BiometricData{
+			Type:      biometricTypes[row.TypeID],
+			Value:     text + " " + row.UnitType,
+			Timestamp: timestamppb.New(row.MeasuredAt.Time),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValidateReading(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	units := map[string]string{"HEART_RATE": "bpm", BloodPressureType: "mmHg"}
+	valid := func(edit func(r *pb.BiometricReading)) *pb.BiometricReading {
+		r := &pb.BiometricReading{TypeId: "HEART_RATE", Value: 72, Unit: "bpm", MeasuredAt: timestamppb.New(now.Add(-time.Minute))}
+		edit(r)
+		return r
+	}
+	bloodPressure := func(systolic, diastolic float64) func(*pb.BiometricReading) {
+		return func(r *pb.BiometricReading) {
+			r.TypeId, r.Unit, r.Value, r.SecondaryValue = BloodPressureType, "mmHg", systolic, diastolic
+		}
+	}
+
+	tests := []struct {
+		name    string
+		reading *pb.BiometricReading
+		want    string // Part of the rejection reason, "" if valid
+	}{
+		{"valid", valid(func(*pb.BiometricReading) {}), ""},
+		{"unit in another case with spaces", valid(func(r *pb.BiometricReading) { r.Unit = " BPM " }), ""},
+		{"zero", valid(func(r *pb.BiometricReading) { r.Value = 0 }), ""},
+		{"measured now", valid(func(r *pb.BiometricReading) { r.MeasuredAt = timestamppb.New(now) }), ""},
+		{"blood pressure", valid(bloodPressure(120, 80)), ""},
+		{"unknown type", valid(func(r *pb.BiometricReading) { r.TypeId = "MOOD" }), "unknown biometric type"},
+		{"wrong unit", valid(func(r *pb.BiometricReading) { r.Unit = "mmHg" }), "does not match"},
+		{"negative", valid(func(r *pb.BiometricReading) { r.Value = -1 }), "non-negative"},
+		{"not a number", valid(func(r *pb.BiometricReading) { r.Value = math.NaN() }), "non-negative"},
+		{"infinite", valid(func(r *pb.BiometricReading) { r.Value = math.Inf(1) }), "non-negative"},
+		{"secondary value on another type", valid(func(r *pb.BiometricReading) { r.SecondaryValue = 80 }), "only used for BLOOD_PRESSURE"},
+		{"no diastolic", valid(bloodPressure(120, 0)), "diastolic) is required"},
+		{"diastolic not a number", valid(bloodPressure(120, math.NaN())), "diastolic) is required"},
+		{"diastolic above systolic", valid(bloodPressure(80, 120)), "below systolic"},
+		{"diastolic equal to systolic", valid(bloodPressure(90, 90)), "below systolic"},
+		{"no time", valid(func(r *pb.BiometricReading) { r.MeasuredAt = nil }), "measured_at is required"},
+		{"invalid time", valid(func(r *pb.BiometricReading) { r.MeasuredAt = &timestamppb.Timestamp{Nanos: -1} }), "invalid measured_at"},
+		{"future", valid(func(r *pb.BiometricReading) { r.MeasuredAt = timestamppb.New(now.Add(time.Second)) }), "in the future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateReading(tt.reading, units, now)
+			if tt.want == "" {
+				if got != "" {
+					t.Fatalf("validateReading() = %q, want valid", got)
+				}
+				return
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Fatalf("validateReading() = %q, want a reason containing %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeHTTPRefusesBeforeIngesting(t *testing.T) {
+	const patientID = "5b0e6f3a-9a44-4c8e-8f7e-2f0f8d9b1c11"
+	secret := strings.Repeat("d", 32)
+	authn, err := authz.NewAuthenticator(config.AuthConfig{
+		NurseTokens:       []string{"nurse-token"},
+		DoctorTokens:      []string{"doctor-token"},
+		SupervisorTokens:  []string{"supervisor-token"},
+		AdminTokens:       []string{"admin-token"},
+		DeviceTokenSecret: secret,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &BiometricServer{authn: authn}
+	token := authz.IssueDeviceToken([]byte(secret), patientID, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name     string
+		token    string
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{"malformed body", token, `{"patient_id": 7, "readings": [`, http.StatusBadRequest, "invalid request body\n"},
+		{"other patient", token, `{"patient_id": "0d7e6c1a-1111-4c8e-8f7e-2f0f8d9b1c11"}`, http.StatusForbidden, "patient_id is not the token's patient\n"},
+		{"admin token", "admin-token", `{"patient_id": "` + patientID + `"}`, http.StatusForbidden, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/api/v1/biometrics", strings.NewReader(tt.body))
+			r.Header.Set("Authorization", "Bearer "+tt.token)
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, r)
+			if w.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantCode)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/logging"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Bounds of a session's outbox. Delivered messages are kept only for their
+// read receipt, so they are dropped after deliveredRetention and are the
+// first to go when the outbox holds more than maxOutbox messages.
+const (
+	maxOutbox          = 256
+	deliveredRetention = time.Hour
+)
+
+// trackedMessage is a message between patient and doctor the server keeps
+// until its recipient reads it
+type trackedMessage struct {
+	id        string
+	clientID  string // Sender's reference, echoed in receipts
+	recipient string // "patient" or "doctor", as in broadcastToRole
+	seq       uint64 // Position in the session, for resuming
+	frame     *pb.WebSocketMessage
+	status    pb.DeliveryStatus
+	delivered time.Time // When the recipient acknowledged it as delivered
+}
+
+// receipt builds the DELIVERY_RECEIPT frame of m's current status
+func (m *trackedMessage) receipt() *pb.WebSocketMessage {
+	return &pb.WebSocketMessage{
+		Type: pb.MessageType_DELIVERY_RECEIPT,
+		Payload: &pb.WebSocketMessage_Receipt{
+			Receipt: &pb.DeliveryReceipt{
+				MessageId:       m.id,
+				ClientMessageId: m.clientID,
+				Status:          m.status,
+				Timestamp:       timestamppb.Now(),
+			},
+		},
+	}
+}
+
+// recipientSide returns the side of the session conn receives messages for
+func recipientSide(conn *Connection) string {
+	if conn.role == authz.RolePatient {
+		return "patient"
+	}
+	return "doctor"
+}
+
+// senderSide returns the side that sent messages to recipient
+func senderSide(recipient string) string {
+	if recipient == "patient" {
+		return "doctor"
+	}
+	return "patient"
+}
+
+// relay sends a message from sender to the other side of the session and
+// tracks it until it is read. It is recorded before it is sent, so the
+// recipient's acknowledgement finds it, and the sender gets a QUEUED receipt.
+// clientID is the sender's reference for the receipts.
+func (s *WebSocketServer) relay(sender *Connection, msgType pb.MessageType, messageType, content, clientID string) {
+	recipient := senderSide(recipientSide(sender))
+	m := &trackedMessage{
+		id:        uuid.NewString(),
+		clientID:  clientID,
+		recipient: recipient,
+		status:    pb.DeliveryStatus_DELIVERY_QUEUED,
+		frame: &pb.WebSocketMessage{
+			Type: msgType,
+			Payload: &pb.WebSocketMessage_Message{
+				Message: &pb.Message{Content: content, Timestamp: timestamppb.Now()},
+			},
+		},
+	}
+	m.frame.GetMessage().MessageId = m.id
+
+	s.recordTrackedMessage(sender.sessionID, m, s.senderID(sender), messageType, content)
+
+	s.mu.Lock()
+	if session, exists := s.sessions[sender.sessionID]; exists {
+		session.seq++
+		m.seq = session.seq
+		m.frame.GetMessage().Seq = m.seq
+		session.outbox = append(session.outbox, m)
+		pruneOutbox(session, time.Now())
+	}
+	receipt := m.receipt()
+	s.mu.Unlock()
+
+	s.broadcastToRole(sender.sessionID, recipient, m.frame)
+	if recipient == "patient" {
+		// Observers see the doctor's side of the conversation too
+		s.broadcastToObservers(sender.sessionID, m.frame)
+	}
+	if err := sender.send(receipt); err != nil {
+		slog.Warn("failed to send delivery receipt", logging.SessionID(sender.sessionID), logging.MessageID(m.id), logging.Err(err))
+	}
+}
+
+// acknowledge records that conn delivered or read a message it received and
+// sends the new status to the sender's side. Repeated and out of order
+// acknowledgements are ignored, the status only moves forward.
+func (s *WebSocketServer) acknowledge(conn *Connection, ack *pb.MessageAck) error {
+	if ack.Status != pb.DeliveryStatus_DELIVERY_DELIVERED && ack.Status != pb.DeliveryStatus_DELIVERY_READ {
+		return fmt.Errorf("status must be DELIVERY_DELIVERED or DELIVERY_READ")
+	}
+	recipient := recipientSide(conn)
+
+	s.mu.Lock()
+	session, exists := s.sessions[conn.sessionID]
+	if !exists || (recipient == "doctor" && session.doctorConn != conn) {
+		s.mu.Unlock()
+		return fmt.Errorf("only the session's patient and doctor acknowledge messages")
+	}
+	var m *trackedMessage
+	for i, tracked := range session.outbox {
+		if tracked.id == ack.MessageId && tracked.recipient == recipient {
+			m = tracked
+			if ack.Status == pb.DeliveryStatus_DELIVERY_READ {
+				session.outbox = append(session.outbox[:i], session.outbox[i+1:]...)
+			}
+			break
+		}
+	}
+	if m == nil {
+		s.mu.Unlock()
+		return fmt.Errorf("unknown message %s", ack.MessageId)
+	}
+	if ack.Status <= m.status {
+		s.mu.Unlock()
+		return nil
+	}
+	m.status = ack.Status
+	if m.status == pb.DeliveryStatus_DELIVERY_DELIVERED {
+		m.delivered = time.Now()
+	}
+	receipt := m.receipt()
+	s.mu.Unlock()
+
+	s.recordDelivery(conn.sessionID, m.id, ack.Status)
+	s.broadcastToRole(conn.sessionID, senderSide(recipient), receipt)
+	return nil
+}
+
+// pruneOutbox drops the messages of session delivered more than
+// deliveredRetention ago, then the oldest ones beyond maxOutbox, delivered
+// before queued. Their read acknowledgements are ignored as unknown. The
+// caller must hold s.mu.
+func pruneOutbox(session *ChatSession, now time.Time) {
+	session.outbox = slices.DeleteFunc(session.outbox, func(m *trackedMessage) bool {
+		return m.status == pb.DeliveryStatus_DELIVERY_DELIVERED && now.Sub(m.delivered) > deliveredRetention
+	})
+	for len(session.outbox) > maxOutbox {
+		i := slices.IndexFunc(session.outbox, func(m *trackedMessage) bool {
+			return m.status == pb.DeliveryStatus_DELIVERY_DELIVERED
+		})
+		if i < 0 {
+			i = 0
+			slog.Warn("outbox full, dropping unacknowledged message", logging.SessionID(session.sessionID), logging.MessageID(session.outbox[i].id))
+		}
+		session.outbox = slices.Delete(session.outbox, i, i+1)
+	}
+}
+
+// retransmit resends conn the messages for its side of the session after
+// seq after that were never acknowledged, oldest first. A resuming patient
+// passes the last one it acknowledged, in case the acknowledgement was lost.
+func (s *WebSocketServer) retransmit(conn *Connection, after uint64) {
+	recipient := recipientSide(conn)
+
+	s.mu.RLock()
+	var frames []*pb.WebSocketMessage
+	if session, exists := s.sessions[conn.sessionID]; exists {
+		for _, m := range session.outbox {
+			if m.recipient == recipient && m.seq > after && m.status == pb.DeliveryStatus_DELIVERY_QUEUED {
+				frames = append(frames, m.frame)
+			}
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, frame := range frames {
+		if err := conn.send(frame); err != nil {
+			slog.Warn("failed to retransmit message", logging.SessionID(conn.sessionID), logging.MessageID(frame.GetMessage().GetMessageId()), logging.Err(err))
+			return
+		}
+	}
+	if len(frames) > 0 {
+		slog.Info("retransmitted unacknowledged messages", logging.SessionID(conn.sessionID), logging.Role(recipient), "count", len(frames))
+	}
+}
+
+// recordDelivery persists a message's new delivery status. Messages of
+// sessions that are not persisted have none.
+func (s *WebSocketServer) recordDelivery(sessionID, messageID string, status pb.DeliveryStatus) {
+	s.mu.RLock()
+	var persisted bool
+	if session, exists := s.sessions[sessionID]; exists {
+		persisted = session.chatID.Valid
+	}
+	s.mu.RUnlock()
+	if !persisted {
+		return
+	}
+
+	id, err := pg.ParseUUID(messageID)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transcriptTimeout)
+	defer cancel()
+
+	if status == pb.DeliveryStatus_DELIVERY_READ {
+		err = s.dbq.MarkMessageRead(ctx, id)
+	} else {
+		err = s.dbq.MarkMessageDelivered(ctx, id)
+	}
+	if err != nil {
+		slog.Error("failed to record message delivery", logging.SessionID(sessionID), logging.MessageID(messageID), logging.Err(err))
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "llm-qa-system/backend-service/src/proto"
+)
+
+func outboxIDs(session *ChatSession) []string {
+	var ids []string
+	for _, m := range session.outbox {
+		ids = append(ids, m.id)
+	}
+	return ids
+}
+
+func TestPruneOutboxExpiresDelivered(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	session := &ChatSession{outbox: []*trackedMessage{
+		{id: "old-delivered", status: pb.DeliveryStatus_DELIVERY_DELIVERED, delivered: now.Add(-2 * deliveredRetention)},
+		{id: "queued", status: pb.DeliveryStatus_DELIVERY_QUEUED},
+		{id: "new-delivered", status: pb.DeliveryStatus_DELIVERY_DELIVERED, delivered: now.Add(-time.Minute)},
+	}}
+
+	pruneOutbox(session, now)
+
+	if got := fmt.Sprint(outboxIDs(session)); got != "[queued new-delivered]" {
+		t.Errorf("outbox = %s, want [queued new-delivered]", got)
+	}
+}
+
+func TestPruneOutboxCap(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	session := &ChatSession{}
+	for i := 0; i < maxOutbox; i++ {
+		session.outbox = append(session.outbox, &trackedMessage{id: fmt.Sprint("q", i), status: pb.DeliveryStatus_DELIVERY_QUEUED})
+	}
+	session.outbox[5].status = pb.DeliveryStatus_DELIVERY_DELIVERED
+	session.outbox[5].delivered = now
+
+	session.outbox = append(session.outbox, &trackedMessage{id: "over-1", status: pb.DeliveryStatus_DELIVERY_QUEUED})
+	pruneOutbox(session, now)
+	if len(session.outbox) != maxOutbox || session.outbox[5].id != "q6" {
+		t.Fatalf("first over the cap: len %d, [5] = %s, want the delivered q5 dropped", len(session.outbox), session.outbox[5].id)
+	}
+
+	session.outbox = append(session.outbox, &trackedMessage{id: "over-2", status: pb.DeliveryStatus_DELIVERY_QUEUED})
+	pruneOutbox(session, now)
+	if len(session.outbox) != maxOutbox || session.outbox[0].id != "q1" {
+		t.Fatalf("second over the cap: len %d, [0] = %s, want the oldest q0 dropped", len(session.outbox), session.outbox[0].id)
+	}
+	if last := session.outbox[len(session.outbox)-1].id; last != "over-2" {
+		t.Errorf("newest message = %s, want over-2", last)
+	}
+}
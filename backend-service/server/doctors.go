@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DoctorServer registers and manages doctors
+type DoctorServer struct {
+	pb.UnimplementedDoctorServiceServer
+	*recordsServer
+}
+
+func NewDoctorServer(records *recordsServer) *DoctorServer {
+	return &DoctorServer{recordsServer: records}
+}
+
+func (s *DoctorServer) CreateDoctor(ctx context.Context, req *pb.CreateDoctorRequest) (*pb.Doctor, error) {
+	email, err := validEmail(req.Email)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.DepartmentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "department_id is required")
+	}
+	if err := s.checkDepartment(ctx, req.DepartmentId); err != nil {
+		return nil, err
+	}
+	if err := checkYearsOfExperience(req.YearsOfExperience); err != nil {
+		return nil, err
+	}
+
+	var userID pgtype.UUID
+	err = s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		user, err := userForEmail(ctx, q, email, name)
+		if err != nil {
+			return err
+		}
+		userID = user.ID
+
+		_, err = q.CreateDoctor(ctx, db.CreateDoctorParams{
+			UserID:            user.ID,
+			DepartmentID:      pgtype.Text{String: req.DepartmentId, Valid: true},
+			Specialization:    specializations(req.Specialization),
+			YearsOfExperience: pgtype.Int4{Int32: req.YearsOfExperience, Valid: true},
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.AlreadyExists, "a doctor with this email already exists")
+		}
+		if err != nil {
+			return internalError("failed to create doctor", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("doctor created", logging.DoctorID(pg.ToUUID(userID).String()))
+	s.auditAdmin(ctx, "CreateDoctor", pgtype.UUID{}, pg.ToUUID(userID).String(), nil)
+	return s.getDoctor(ctx, userID)
+}
+
+func (s *DoctorServer) GetDoctor(ctx context.Context, req *pb.GetDoctorRequest) (*pb.Doctor, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return s.getDoctor(ctx, id)
+}
+
+func (s *DoctorServer) ListDoctors(ctx context.Context, req *pb.ListDoctorsRequest) (*pb.ListDoctorsResponse, error) {
+	limit, offset, err := pageBounds(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.ListDoctorsParams{Limit: limit + 1, Offset: offset}
+	if req.DepartmentId != "" {
+		params.DepartmentID = pgtype.Text{String: req.DepartmentId, Valid: true}
+	}
+	rows, err := s.dbq.ListDoctors(ctx, params)
+	if err != nil {
+		return nil, internalError("failed to list doctors", err)
+	}
+
+	resp := &pb.ListDoctorsResponse{NextPageToken: nextPageToken(offset, limit, len(rows))}
+	for i, row := range rows {
+		if i == int(limit) {
+			break
+		}
+		resp.Doctors = append(resp.Doctors, doctorMessage(db.GetDoctorByUserIDRow(row)))
+	}
+	return resp, nil
+}
+
+func (s *DoctorServer) UpdateDoctor(ctx context.Context, req *pb.UpdateDoctorRequest) (*pb.Doctor, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateDoctorParams{UserID: id}
+	if req.DepartmentId != nil {
+		if err := s.checkDepartment(ctx, *req.DepartmentId); err != nil {
+			return nil, err
+		}
+		params.DepartmentID = pgtype.Text{String: *req.DepartmentId, Valid: true}
+	}
+	if req.Specialization != nil {
+		params.SetSpecialization = true
+		params.Specialization = specializations(req.Specialization.Values)
+	}
+	if req.YearsOfExperience != nil {
+		if err := checkYearsOfExperience(*req.YearsOfExperience); err != nil {
+			return nil, err
+		}
+		params.YearsOfExperience = pgtype.Int4{Int32: *req.YearsOfExperience, Valid: true}
+	}
+
+	err = s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		if _, err := q.UpdateDoctor(ctx, params); errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "doctor not found")
+		} else if err != nil {
+			return internalError("failed to update doctor", err)
+		}
+		return updateUser(ctx, q, id, req.Email, req.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.auditAdmin(ctx, "UpdateDoctor", pgtype.UUID{}, req.Id, nil)
+	return s.getDoctor(ctx, id)
+}
+
+// DeleteDoctor soft deletes the doctor. The sessions they handled and their
+// reviews are kept.
+func (s *DoctorServer) DeleteDoctor(ctx context.Context, req *pb.DeleteDoctorRequest) (*pb.DeleteResponse, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.dbq.SoftDeleteDoctor(ctx, id)
+	if err != nil {
+		return nil, internalError("failed to delete doctor", err)
+	}
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "doctor not found")
+	}
+
+	slog.Info("doctor deleted", logging.DoctorID(req.Id))
+	s.auditAdmin(ctx, "DeleteDoctor", pgtype.UUID{}, req.Id, nil)
+	return &pb.DeleteResponse{}, nil
+}
+
+func (s *DoctorServer) getDoctor(ctx context.Context, id pgtype.UUID) (*pb.Doctor, error) {
+	row, err := s.dbq.GetDoctorByUserID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "doctor not found")
+	}
+	if err != nil {
+		return nil, internalError("failed to load doctor", err)
+	}
+	return doctorMessage(row), nil
+}
+
+func doctorMessage(row db.GetDoctorByUserIDRow) *pb.Doctor {
+	return &pb.Doctor{
+		Id:                pg.ToUUID(row.ID).String(),
+		Email:             row.Email,
+		Name:              row.Name,
+		DepartmentId:      row.DepartmentID.String,
+		DepartmentName:    row.DepartmentName,
+		Specialization:    row.Specialization,
+		YearsOfExperience: row.YearsOfExperience.Int32,
+		CreatedAt:         timestamppb.New(row.CreatedAt.Time),
+	}
+}
+
+// specializations returns the trimmed, non-empty values
+func specializations(values []string) []string {
+	out := []string{}
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// checkYearsOfExperience returns an InvalidArgument error for negative years
+func checkYearsOfExperience(years int32) error {
+	if years < 0 {
+		return status.Error(codes.InvalidArgument, "years_of_experience cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxGatewayBodyBytes caps management API request bodies
+const maxGatewayBodyBytes = 1 << 20
+
+// registerGateway serves the management services as JSON over HTTP. Bodies
+// and responses are the JSON form of the gRPC messages. Requests are
+// authorized by intercept like the same RPCs over gRPC.
+func registerGateway(mux *http.ServeMux, intercept grpc.UnaryServerInterceptor, patients *PatientServer, doctors *DoctorServer, history *MedicalHistoryServer, prompts *PromptTemplateServer, audits *AuditServer) {
+	mux.Handle("POST /api/v1/patients", rpcHandler(intercept, pb.PatientService_CreatePatient_FullMethodName, "", patients.CreatePatient))
+	mux.Handle("GET /api/v1/patients", rpcHandler(intercept, pb.PatientService_ListPatients_FullMethodName, "", patients.ListPatients))
+	mux.Handle("GET /api/v1/patients/{id}", rpcHandler(intercept, pb.PatientService_GetPatient_FullMethodName, "id", patients.GetPatient))
+	mux.Handle("PATCH /api/v1/patients/{id}", rpcHandler(intercept, pb.PatientService_UpdatePatient_FullMethodName, "id", patients.UpdatePatient))
+	mux.Handle("DELETE /api/v1/patients/{id}", rpcHandler(intercept, pb.PatientService_DeletePatient_FullMethodName, "id", patients.DeletePatient))
+
+	mux.Handle("POST /api/v1/patients/{id}/conditions", rpcHandler(intercept, pb.MedicalHistoryService_AddCondition_FullMethodName, "patient_id", history.AddCondition))
+	mux.Handle("GET /api/v1/patients/{id}/conditions", rpcHandler(intercept, pb.MedicalHistoryService_ListConditions_FullMethodName, "patient_id", history.ListConditions))
+	mux.Handle("PATCH /api/v1/conditions/{id}", rpcHandler(intercept, pb.MedicalHistoryService_UpdateCondition_FullMethodName, "id", history.UpdateCondition))
+	mux.Handle("DELETE /api/v1/conditions/{id}", rpcHandler(intercept, pb.MedicalHistoryService_DeleteCondition_FullMethodName, "id", history.DeleteCondition))
+
+	mux.Handle("POST /api/v1/doctors", rpcHandler(intercept, pb.DoctorService_CreateDoctor_FullMethodName, "", doctors.CreateDoctor))
+	mux.Handle("GET /api/v1/doctors", rpcHandler(intercept, pb.DoctorService_ListDoctors_FullMethodName, "", doctors.ListDoctors))
+	mux.Handle("GET /api/v1/doctors/{id}", rpcHandler(intercept, pb.DoctorService_GetDoctor_FullMethodName, "id", doctors.GetDoctor))
+	mux.Handle("PATCH /api/v1/doctors/{id}", rpcHandler(intercept, pb.DoctorService_UpdateDoctor_FullMethodName, "id", doctors.UpdateDoctor))
+	mux.Handle("DELETE /api/v1/doctors/{id}", rpcHandler(intercept, pb.DoctorService_DeleteDoctor_FullMethodName, "id", doctors.DeleteDoctor))
+
+	mux.Handle("POST /api/v1/prompt-templates", rpcHandler(intercept, pb.PromptTemplateService_CreatePromptTemplate_FullMethodName, "", prompts.CreatePromptTemplate))
+	mux.Handle("GET /api/v1/prompt-templates", rpcHandler(intercept, pb.PromptTemplateService_ListPromptTemplates_FullMethodName, "", prompts.ListPromptTemplates))
+	mux.Handle("GET /api/v1/prompt-templates/{id}", rpcHandler(intercept, pb.PromptTemplateService_GetPromptTemplate_FullMethodName, "version", prompts.GetPromptTemplate))
+	mux.Handle("PUT /api/v1/prompt-templates/active", rpcHandler(intercept, pb.PromptTemplateService_ActivatePromptTemplate_FullMethodName, "", prompts.ActivatePromptTemplate))
+	mux.Handle("GET /api/v1/prompt-experiment", rpcHandler(intercept, pb.PromptTemplateService_GetPromptExperiment_FullMethodName, "", prompts.GetPromptExperiment))
+	mux.Handle("PUT /api/v1/prompt-experiment", rpcHandler(intercept, pb.PromptTemplateService_SetPromptExperiment_FullMethodName, "", prompts.SetPromptExperiment))
+	mux.Handle("GET /api/v1/prompt-stats", rpcHandler(intercept, pb.PromptTemplateService_GetPromptTemplateStats_FullMethodName, "", prompts.GetPromptTemplateStats))
+
+	mux.Handle("GET /api/v1/audit-events", rpcHandler(intercept, pb.AuditService_ListAuditEvents_FullMethodName, "", audits.ListAuditEvents))
+	mux.Handle("GET /api/v1/audit-events/verify", rpcHandler(intercept, pb.AuditService_VerifyAuditLog_FullMethodName, "", audits.VerifyAuditLog))
+}
+
+// rpcHandler calls the RPC method for HTTP requests. The request message is
+// read from the body of POST, PUT and PATCH requests and from the query of
+// others, the {id} path segment is stored in pathField. The Authorization
+// header is passed on as metadata.
+func rpcHandler[T any, Req interface {
+	*T
+	proto.Message
+}, Resp proto.Message](intercept grpc.UnaryServerInterceptor, method, pathField string, call func(context.Context, Req) (Resp, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := Req(new(T))
+
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGatewayBodyBytes))
+			if err != nil {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			if len(body) > 0 {
+				if err := protojson.Unmarshal(body, req); err != nil {
+					http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+					return
+				}
+			}
+		default:
+			for name, values := range r.URL.Query() {
+				if err := setField(req, name, values[len(values)-1]); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+		}
+		if pathField != "" {
+			if err := setField(req, pathField, r.PathValue("id")); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+		resp, err := invoke(ctx, intercept, method, req, call)
+		if err != nil {
+			st := status.Convert(err)
+			http.Error(w, st.Message(), httpStatus(st.Code()))
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		out, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+		if err != nil {
+			http.Error(w, "failed to encode response", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		w.Write(out)
+	})
+}
+
+// setField sets the string, int32, enum or timestamp field of msg named name
+// to value. Enums are given by value name and timestamps in RFC 3339.
+func setField(msg proto.Message, name, value string) error {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.IsList() {
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		m.Set(fd, protoreflect.ValueOfString(value))
+	case protoreflect.Int32Kind:
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", name, err)
+		}
+		m.Set(fd, protoreflect.ValueOfInt32(int32(n)))
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByName(protoreflect.Name(value))
+		if ev == nil {
+			return fmt.Errorf("invalid %s %q", name, value)
+		}
+		m.Set(fd, protoreflect.ValueOfEnum(ev.Number()))
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() != "google.protobuf.Timestamp" {
+			return fmt.Errorf("unknown parameter %q", name)
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %v", name, err)
+		}
+		m.Set(fd, protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()))
+	default:
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+	return nil
+}
+
+// httpStatus maps gRPC status codes to HTTP status codes
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -3,94 +3,259 @@ package server
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/encryption"
+	"llm-qa-system/backend-service/retention"
+	pb "llm-qa-system/backend-service/src/proto"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 type ServerGroup struct {
-	wsServer     *WebSocketServer
-	healthServer *HealthServer
-	db           *pgxpool.Pool
-	httpServer   *http.Server
-	llmClient    *LLMClient // Add this field
-
+	wsServer        *WebSocketServer
+	healthServer    *HealthServer
+	biometricServer *BiometricServer
+	patientServer   *PatientServer
+	doctorServer    *DoctorServer
+	historyServer   *MedicalHistoryServer
+	promptServer    *PromptTemplateServer
+	analyticsServer *AnalyticsServer
+	auditServer     *AuditServer
+	auditQueue      *audit.Queue
+	db              *pgxpool.Pool
+	httpServer      *http.Server
+	grpcServer      *grpc.Server
+	llmClient       *LLMClient
+	purger          *retention.Purger // nil when everything is kept forever
+	stopPurger      context.CancelFunc
+	cfg             *config.Config
 }
 
-func NewServerGroup(pool *pgxpool.Pool, llmServiceAddr string, kafkaBrokers []string) (*ServerGroup, error) {
-	baseServer := NewBaseServer(pool)
+func NewServerGroup(pool *pgxpool.Pool, cfg *config.Config) (*ServerGroup, error) {
+	cipher, err := encryption.FromConfig(pool, cfg.Encryption)
+	if err != nil {
+		return nil, err
+	}
+	baseServer := NewBaseServer(pool, cipher)
+
+	tlsCfg, err := NewServerTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create LLM client
-	llmClient, err := NewLLMClient(llmServiceAddr, kafkaBrokers)
+	llmClient, err := NewLLMClient(baseServer, cfg.LLM, cfg.Kafka)
 	if err != nil {
 		return nil, err
 	}
 
+	// Map tokens to roles. The interceptors enforce the permissions of each
+	// role on gRPC and on the HTTP routes of the same RPCs.
+	authn, err := authz.NewAuthenticator(cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+	intercept := authz.UnaryServerInterceptor(authn, methodPermissions)
+
 	// Create WebSocket server
-	wsServer := NewWebSocketServer(baseServer, llmClient, kafkaBrokers)
+	wsServer := NewWebSocketServer(baseServer, llmClient, authn, cfg)
+
+	// Create biometric ingestion server, served over gRPC and HTTP, which
+	// alerts doctors through the WebSocket server
+	biometricServer := NewBiometricServer(baseServer, cfg, authn, wsServer)
+
+	// Create the management and analytics services
+	records := newRecordsServer(baseServer, intercept)
 
 	// Create HTTP server
 	mux := http.NewServeMux()
 	httpServer := &http.Server{
-		Addr:    ":8080", // You might want to make this configurable
-		Handler: mux,
+		Addr:              cfg.Server.HTTPAddr,
+		Handler:           mux,
+		TLSConfig:         tlsCfg,
+		ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
 	}
 
-	sg := &ServerGroup{
-		db:           pool,
-		wsServer:     wsServer,
-		healthServer: newHealthServer(pool),
-		httpServer:   httpServer,
-		llmClient:    llmClient, // Store the client
+	grpcOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(intercept),
+		grpc.StreamInterceptor(authz.StreamServerInterceptor(authn, methodPermissions)),
+	}
+	if tlsCfg != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
 
+	sg := &ServerGroup{
+		db:              pool,
+		wsServer:        wsServer,
+		healthServer:    newHealthServer(pool),
+		biometricServer: biometricServer,
+		patientServer:   NewPatientServer(records),
+		doctorServer:    NewDoctorServer(records),
+		historyServer:   NewMedicalHistoryServer(records),
+		promptServer:    NewPromptTemplateServer(records),
+		analyticsServer: NewAnalyticsServer(records),
+		auditServer:     NewAuditServer(records),
+		auditQueue:      baseServer.auditQueue,
+		httpServer:      httpServer,
+		grpcServer:      grpc.NewServer(grpcOpts...),
+		llmClient:       llmClient,
+		purger:          retention.NewPurger(pool, cipher, cfg.Retention),
+		cfg:             cfg,
 	}
+	sg.Register(sg.grpcServer)
 
 	// Set up WebSocket route
 	mux.HandleFunc("/ws", wsServer.HandleWebSocket)
+	mux.Handle("/api/v1/biometrics", biometricServer)
+	registerGateway(mux, intercept, sg.patientServer, sg.doctorServer, sg.historyServer, sg.promptServer, sg.auditServer)
+	mux.Handle("GET /api/v1/analytics/draft-quality", sg.analyticsServer)
 
 	return sg, nil
 }
 
 func (s *ServerGroup) Register(grpcServer *grpc.Server) {
 	healthpb.RegisterHealthServer(grpcServer, s.healthServer)
+	pb.RegisterBiometricServiceServer(grpcServer, s.biometricServer)
+	pb.RegisterPatientServiceServer(grpcServer, s.patientServer)
+	pb.RegisterDoctorServiceServer(grpcServer, s.doctorServer)
+	pb.RegisterMedicalHistoryServiceServer(grpcServer, s.historyServer)
+	pb.RegisterPromptTemplateServiceServer(grpcServer, s.promptServer)
+	pb.RegisterAnalyticsServiceServer(grpcServer, s.analyticsServer)
+	pb.RegisterAuditServiceServer(grpcServer, s.auditServer)
 	reflection.Register(grpcServer)
 }
 
+// Notices sent to every connected participant when the server stops, the
+// second one in sessions whose transcript is saved
+const (
+	ShutdownNotice          = "The server is restarting for maintenance and this session will end. The live session cannot continue; please reconnect in a moment."
+	ShutdownNoticePersisted = "The server is restarting for maintenance and this session will end. The conversation history saved so far is kept, but the live session cannot continue; please reconnect in a moment."
+)
+
+// Start runs the gRPC and HTTP servers until ctx is cancelled or one of them
+// fails. It does not stop them, call Shutdown for that.
 func (s *ServerGroup) Start(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.cfg.Server.GRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %v", s.cfg.Server.GRPCAddr, err)
+	}
+
+	errCh := make(chan error, 2)
+
+	if s.purger != nil {
+		purgeCtx, cancel := context.WithCancel(ctx)
+		s.stopPurger = cancel
+		go s.purger.Run(purgeCtx)
+	}
+
+	// Start gRPC server in a goroutine
+	go func() {
+		slog.Info("starting gRPC server", "addr", lis.Addr().String())
+		if err := s.grpcServer.Serve(lis); err != nil {
+			errCh <- fmt.Errorf("gRPC server error: %v", err)
+		}
+	}()
+
 	// Start HTTP server in a goroutine
 	go func() {
-		log.Printf("Starting HTTP server on %s", s.httpServer.Addr)
-		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Printf("HTTP server error: %v", err)
+		slog.Info("starting HTTP server", "addr", s.httpServer.Addr, "tls", s.httpServer.TLSConfig != nil)
+
+		var err error
+		if s.httpServer.TLSConfig != nil {
+			// Certificates are already loaded into TLSConfig
+			err = s.httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("HTTP server error: %v", err)
 		}
 	}()
 
 	// Wait for context cancellation
-	<-ctx.Done()
-	return s.httpServer.Shutdown(context.Background())
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
 }
 
+// Shutdown stops the server in order within ctx's deadline: stop accepting
+// connections, tell connected clients, let the draft in flight finish (or hand
+// it back to Kafka), then close connections, Kafka, gRPC and the database.
 func (sg *ServerGroup) Shutdown(ctx context.Context) error {
 	var errs []error
 
-	// Close WebSocket server first (this will close Kafka consumer)
+	// 1. Stop accepting new connections and RPCs
+	sg.wsServer.BeginDrain()
+	grpcStopped := make(chan struct{})
+	go func() {
+		sg.grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	if err := sg.httpServer.Shutdown(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("http server shutdown error: %v", err))
+	}
+
+	// 2. Tell connected patients and doctors
+	sg.wsServer.NotifyShutdown(ShutdownNotice, ShutdownNoticePersisted)
+
+	// 3. Finish or hand back the draft in flight, then stop delivering drafts
+	if err := sg.llmClient.Drain(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("llm client drain error: %v", err))
+	}
+	if err := sg.wsServer.StopConsumer(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("websocket server drain error: %v", err))
+	}
+
+	// 4. Close WebSocket connections
+	if err := sg.wsServer.CloseConnections(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("websocket close error: %v", err))
+	}
+
+	// 5. Close Kafka readers and writers, committing offsets
 	if err := sg.wsServer.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("websocket server close error: %v", err))
 	}
-
-	// Close LLM client (this will close Kafka producer)
 	if err := sg.llmClient.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("llm client close error: %v", err))
 	}
 
-	// Close HTTP server
-	if err := sg.httpServer.Shutdown(ctx); err != nil {
-		errs = append(errs, fmt.Errorf("http server shutdown error: %v", err))
+	// 6. Wait for RPCs in flight, cutting them off at the deadline
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		sg.grpcServer.Stop()
+		errs = append(errs, fmt.Errorf("grpc server forced to stop: %v", ctx.Err()))
+	}
+
+	// Let the retention purge roll back its batch before the pool closes
+	if sg.stopPurger != nil {
+		sg.stopPurger()
+		select {
+		case <-sg.purger.Done():
+		case <-ctx.Done():
+		}
+	}
+
+	// Append the audit events still queued before the pool closes
+	if err := sg.auditQueue.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("audit queue close error: %v", err))
 	}
 
 	// Close DB connection
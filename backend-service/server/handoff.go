@@ -0,0 +1,148 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/logging"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"github.com/gorilla/websocket"
+)
+
+// observerConns returns the connections watching the session. The caller
+// must hold s.mu or own the session.
+func (c *ChatSession) observerConns() []*Connection {
+	conns := make([]*Connection, 0, len(c.observers))
+	for observer := range c.observers {
+		conns = append(conns, observer)
+	}
+	return conns
+}
+
+// broadcastToObservers sends msg to every observer of the session
+func (s *WebSocketServer) broadcastToObservers(sessionID string, msg *pb.WebSocketMessage) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	session, exists := s.sessions[sessionID]
+	if !exists {
+		return
+	}
+	for observer := range session.observers {
+		if err := observer.send(msg); err != nil {
+			slog.Warn("failed to send websocket message to observer", logging.SessionID(sessionID), logging.DoctorID(observer.doctorID), logging.Err(err))
+		}
+	}
+}
+
+// announceObserver tells the participants a supervisor started or
+// stopped watching the session, and records it in the transcript
+func (s *WebSocketServer) announceObserver(conn *Connection, joined bool) {
+	text := "A supervising doctor is now observing this session"
+	transcript := fmt.Sprintf("Doctor %s started observing the session", conn.doctorID)
+	if !joined {
+		text = "The supervising doctor stopped observing this session"
+		transcript = fmt.Sprintf("Doctor %s stopped observing the session", conn.doctorID)
+	}
+
+	slog.Info(transcript, logging.SessionID(conn.sessionID), logging.DoctorID(conn.doctorID))
+	s.broadcastToRole(conn.sessionID, "patient", newSystemMessage(text))
+	s.broadcastToRole(conn.sessionID, "doctor", newSystemMessage(text))
+	s.recordSystemMessage(conn.sessionID, transcript)
+}
+
+// handOff transfers conn's session to the doctor named in handoff. The
+// receiving doctor gets the assignment with the note, the patient only
+// learns that a new doctor is taking over.
+func (s *WebSocketServer) handOff(conn *Connection, handoff *pb.SessionHandoff) error {
+	if handoff.ToDoctorId == "" {
+		return fmt.Errorf("to_doctor_id is required")
+	}
+
+	s.mu.RLock()
+	session, exists := s.sessions[conn.sessionID]
+	isDoctor := exists && session.doctorConn == conn
+	s.mu.RUnlock()
+	if !isDoctor {
+		return fmt.Errorf("only the session's doctor can hand it off")
+	}
+
+	if err := s.router.Transfer(conn.sessionID, conn.doctorID, handoff.ToDoctorId, handoff.Note); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if session.doctorConn == conn {
+		session.doctorConn = nil
+	}
+	s.mu.Unlock()
+
+	conn.send(newSystemMessage(fmt.Sprintf("Session handed off to doctor %s", handoff.ToDoctorId)))
+	s.notifyParticipants(conn.sessionID, conn, s.sessionEvent(pb.MessageType_PARTICIPANT_LEFT, conn.sessionID, conn, "handed off"))
+	s.broadcastToRole(conn.sessionID, "patient", newSystemMessage("You are being transferred to another doctor, please stay connected"))
+	s.broadcastToObservers(conn.sessionID, newSystemMessage(fmt.Sprintf(
+		"Session handed off from doctor %s to doctor %s", conn.doctorID, handoff.ToDoctorId)))
+
+	transcript := fmt.Sprintf("Session handed off from doctor %s to doctor %s", conn.doctorID, handoff.ToDoctorId)
+	if handoff.Note != "" {
+		transcript += ". Note: " + handoff.Note
+	}
+	s.recordSystemMessage(conn.sessionID, transcript)
+	s.auditConn(conn, audit.ActionSessionTransfer, "", map[string]string{"to_doctor_id": handoff.ToDoctorId})
+	return nil
+}
+
+// reassign transfers the session a supervisor observes to the doctor named
+// in handoff. The doctor holding the session is disconnected from it, the
+// supervisor keeps observing.
+func (s *WebSocketServer) reassign(conn *Connection, handoff *pb.SessionHandoff) error {
+	if handoff.ToDoctorId == "" {
+		return fmt.Errorf("to_doctor_id is required")
+	}
+
+	s.mu.RLock()
+	session, exists := s.sessions[conn.sessionID]
+	var current *Connection
+	if exists {
+		current = session.doctorConn
+	}
+	s.mu.RUnlock()
+	if !exists {
+		return fmt.Errorf("session not found")
+	}
+
+	fromID, assigned := s.router.AssignedDoctor(conn.sessionID)
+	if !assigned {
+		fromID = conn.doctorID
+	}
+	if err := s.router.Transfer(conn.sessionID, fromID, handoff.ToDoctorId, handoff.Note); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if current != nil && session.doctorConn == current {
+		session.doctorConn = nil
+	} else {
+		current = nil
+	}
+	s.mu.Unlock()
+
+	if current != nil {
+		current.send(newSystemMessage(fmt.Sprintf("A supervisor reassigned this session to doctor %s", handoff.ToDoctorId)))
+		current.closeWithReason(websocket.CloseNormalClosure, "session reassigned")
+		current.conn.Close()
+		s.notifyParticipants(conn.sessionID, current, s.sessionEvent(pb.MessageType_PARTICIPANT_LEFT, conn.sessionID, current, "reassigned"))
+	}
+	s.broadcastToRole(conn.sessionID, "patient", newSystemMessage("You are being transferred to another doctor, please stay connected"))
+
+	transcript := fmt.Sprintf("Supervisor %s reassigned the session from doctor %s to doctor %s", conn.doctorID, fromID, handoff.ToDoctorId)
+	s.broadcastToObservers(conn.sessionID, newSystemMessage(transcript))
+	if handoff.Note != "" {
+		transcript += ". Note: " + handoff.Note
+	}
+	s.recordSystemMessage(conn.sessionID, transcript)
+	s.auditConn(conn, audit.ActionSessionTransfer, "", map[string]string{"from_doctor_id": fromID, "to_doctor_id": handoff.ToDoctorId})
+	return nil
+}
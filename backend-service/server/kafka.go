@@ -3,8 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
-	"log"
-	"time"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
+	"log/slog"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -13,6 +14,7 @@ import (
 const (
 	TopicLLMResponses    = "llm-responses"
 	TopicPatientMessages = "patient-messages"
+	TopicSLABreaches     = "review-sla-breaches"
 	// TopicDoctorReviews = "doctor-reviews"
 	// Add other topics as needed
 )
@@ -23,68 +25,67 @@ const (
 	GroupIDWebSocket = "websocket-server"
 )
 
-// KafkaConfig holds configuration for Kafka
-type KafkaConfig struct {
-	Brokers []string
-	Topics  []string
-}
-
 // GetDefaultTopics returns all Kafka topics used by the system
 func GetDefaultTopics() []string {
 	return []string{
 		TopicLLMResponses,
 		TopicPatientMessages,
+		TopicSLABreaches,
 	}
 }
 
 // InitializeKafka creates required topics if they don't exist
-func InitializeKafka(config KafkaConfig) error {
-	conn, err := kafka.DialContext(context.Background(), "tcp", config.Brokers[0])
+func InitializeKafka(cfg config.KafkaConfig, topics []string) error {
+	conn, err := kafka.DialContext(context.Background(), "tcp", cfg.Brokers[0])
 	if err != nil {
 		return fmt.Errorf("failed to connect to kafka: %v", err)
 	}
 	defer conn.Close()
 
 	var topicConfigs []kafka.TopicConfig
-	for _, topic := range config.Topics {
+	for _, topic := range topics {
 		topicConfigs = append(topicConfigs, kafka.TopicConfig{
 			Topic:             topic,
-			NumPartitions:     1,
-			ReplicationFactor: 1,
+			NumPartitions:     cfg.NumPartitions,
+			ReplicationFactor: cfg.ReplicationFactor,
 		})
 	}
 
 	err = conn.CreateTopics(topicConfigs...)
 	if err != nil {
-		log.Printf("Note: %v (this is usually fine if topics already exist)", err)
+		slog.Info("kafka topic creation skipped, this is usually fine if topics already exist", logging.Err(err))
 		return nil
 	}
 
-	log.Printf("Successfully created Kafka topics: %v", config.Topics)
+	slog.Info("created kafka topics", "topics", topics)
 	return nil
 }
 
 // NewKafkaWriter creates a new Kafka writer instance
-func NewKafkaWriter(brokers []string, topic string) *kafka.Writer {
+func NewKafkaWriter(cfg config.KafkaConfig, topic string) *kafka.Writer {
 	return &kafka.Writer{
-		Addr:         kafka.TCP(brokers...),
+		Addr:         kafka.TCP(cfg.Brokers...),
 		Topic:        topic,
 		Balancer:     &kafka.LeastBytes{},
-		WriteTimeout: 10 * time.Second,
-		BatchSize:    1, // For immediate writes
+		WriteTimeout: cfg.WriteTimeout,
+		BatchSize:    cfg.BatchSize, // 1 for immediate writes
 	}
 }
 
 // NewKafkaReader creates a new Kafka reader instance
-func NewKafkaReader(brokers []string, topic string, groupID string) *kafka.Reader {
+func NewKafkaReader(cfg config.KafkaConfig, topic string, groupID string) *kafka.Reader {
+	startOffset := kafka.FirstOffset // Start from oldest message if no offset is stored
+	if cfg.StartOffset == "last" {
+		startOffset = kafka.LastOffset
+	}
+
 	return kafka.NewReader(kafka.ReaderConfig{
-		Brokers: brokers,
-		Topic:   topic,
-		GroupID: groupID,
-		// Add some sensible defaults
-		MinBytes:    10e3,              // 10KB minimum batch size
-		MaxBytes:    10e6,              // 10MB maximum batch size
-		MaxWait:     time.Second,       // Maximum amount of time to wait for new data
-		StartOffset: kafka.FirstOffset, // Start from oldest message if no offset is stored
+		Brokers:     cfg.Brokers,
+		Topic:       topic,
+		GroupID:     groupID,
+		MinBytes:    cfg.MinBytes, // Minimum batch size
+		MaxBytes:    cfg.MaxBytes, // Maximum batch size
+		MaxWait:     cfg.MaxWait,  // Maximum amount of time to wait for new data
+		StartOffset: startOffset,
 	})
 }
@@ -4,55 +4,101 @@ import (
 	pb "llm-qa-system/backend-service/src/proto"
 
 	"context"
+	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type LLMClient struct {
-	client pb.MedicalQAServiceClient
-	conn   *grpc.ClientConn
-	reader *kafka.Reader
-	writer *kafka.Writer
+	*BaseServer
+	client         pb.MedicalQAServiceClient
+	conn           *grpc.ClientConn
+	reader         *kafka.Reader
+	writer         *kafka.Writer
+	requestTimeout time.Duration
+	triage         *TriageEngine
+
+	// fetchCancel stops consuming new patient messages, draftCancel aborts
+	// the draft in flight. done is closed once the consumer has returned.
+	fetchCancel context.CancelFunc
+	draftCancel context.CancelFunc
+	done        chan struct{}
 }
 
-func NewLLMClient(addr string, kafkaBrokers []string) (*LLMClient, error) {
-	log.Printf("Attempting to connect to LLM service at: %s", addr)
+func NewLLMClient(base *BaseServer, cfg config.LLMConfig, kafkaCfg config.KafkaConfig) (*LLMClient, error) {
+	slog.Info("connecting to LLM service", "addr", cfg.Addr, "tls", cfg.TLS.Enabled)
+
+	tlsCfg, err := NewClientTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	creds := insecure.NewCredentials()
+	if tlsCfg != nil {
+		creds = credentials.NewTLS(tlsCfg)
+	}
 
 	// Add connection timeout and retry
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DialTimeout)
 	defer cancel()
 
 	conn, err := grpc.DialContext(ctx,
-		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cfg.Addr,
+		grpc.WithTransportCredentials(creds),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to LLM service at %s: %v", addr, err)
+		return nil, fmt.Errorf("failed to connect to LLM service at %s: %v", cfg.Addr, err)
 	}
 
-	log.Printf("Successfully connected to LLM service")
+	slog.Info("connected to LLM service", "addr", cfg.Addr)
+	fetchCtx, fetchCancel := context.WithCancel(context.Background())
+	draftCtx, draftCancel := context.WithCancel(context.Background())
+
 	grpcClient := pb.NewMedicalQAServiceClient(conn)
 	client := &LLMClient{
-		client: grpcClient,
-		conn:   conn,
-		reader: NewKafkaReader(kafkaBrokers, TopicPatientMessages, GroupIDLLMClient),
-		writer: NewKafkaWriter(kafkaBrokers, TopicLLMResponses),
+		BaseServer:     base,
+		client:         grpcClient,
+		conn:           conn,
+		reader:         NewKafkaReader(kafkaCfg, TopicPatientMessages, GroupIDLLMClient),
+		writer:         NewKafkaWriter(kafkaCfg, TopicLLMResponses),
+		requestTimeout: cfg.RequestTimeout,
+		triage:         NewTriageEngine(DefaultTriageRules),
+		fetchCancel:    fetchCancel,
+		draftCancel:    draftCancel,
+		done:           make(chan struct{}),
 	}
 
 	// Start consuming patient messages
-	go client.processPatientMessages()
+	go client.processPatientMessages(fetchCtx, draftCtx)
 
 	return client, nil
 }
 
-func (c *LLMClient) RequestDraft(sessionID string, message string) error {
+func (c *LLMClient) RequestDraft(ctx context.Context, sessionID string, message string, triage TriageResult) error {
+	biometrics, err := c.sessionBiometrics(ctx, sessionID)
+	if err != nil {
+		// The draft is still useful without vitals
+		slog.Warn("failed to load patient biometrics", logging.SessionID(sessionID), logging.Err(err))
+	}
+	version, template, err := sessionPromptTemplate(ctx, c.dbq, sessionID)
+	if err != nil {
+		slog.Warn("failed to pick prompt template, using the LLM service default", logging.SessionID(sessionID), logging.Err(err))
+	}
+
 	// Create request with proper protobuf structures
 	req := &pb.QuestionRequest{
 		QuestionId: &pb.UUID{
@@ -68,18 +114,7 @@ func (c *LLMClient) RequestDraft(sessionID string, message string) error {
 					"Hypertension",
 				},
 			},
-			BiometricData: []*pb.BiometricData{
-				{
-					Type:      pb.BiometricType_BIOMETRIC_BLOOD_PRESSURE,
-					Value:     "120/80",
-					Timestamp: timestamppb.Now(),
-				},
-				{
-					Type:      pb.BiometricType_BIOMETRIC_HEART_RATE,
-					Value:     "75",
-					Timestamp: timestamppb.Now(),
-				},
-			},
+			BiometricData: biometrics,
 			ChatHistory: []*pb.ChatMessage{
 				{
 					Role:      pb.Role_ROLE_PATIENT,
@@ -88,20 +123,32 @@ func (c *LLMClient) RequestDraft(sessionID string, message string) error {
 				},
 			},
 		},
+		PromptTemplate: template,
 	}
 
 	// Make gRPC call to LLM service
-	resp, err := c.client.GenerateDraftAnswer(context.Background(), req)
+	slog.Debug("requesting draft", logging.SessionID(sessionID))
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GenerateDraftAnswer(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to generate answer: %v", err)
 	}
 
 	// Create draft message using AIDraftReady protobuf
 	draftMsg := &pb.AIDraftReady{
-		MessageId:       fmt.Sprintf("msg_%d", time.Now().Unix()),
+		MessageId:       uuid.NewString(),
 		OriginalMessage: message,
 		Draft:           resp.DraftAnswer, // Changed from Answer to DraftAnswer as per proto
 		Timestamp:       timestamppb.Now(),
+		Urgency:         triage.Urgency,
+		TriageReasons:   triage.Reasons,
+	}
+
+	// Store the draft before doctors can review it
+	if err := c.recordDraft(ctx, sessionID, draftMsg.MessageId, version, message, resp); err != nil {
+		slog.Error("failed to record draft", logging.SessionID(sessionID), logging.MessageID(draftMsg.MessageId), logging.Err(err))
 	}
 
 	// Send to Kafka
@@ -110,7 +157,7 @@ func (c *LLMClient) RequestDraft(sessionID string, message string) error {
 		return fmt.Errorf("failed to marshal draft: %v", err)
 	}
 
-	err = c.writer.WriteMessages(context.Background(),
+	err = c.writer.WriteMessages(ctx,
 		kafka.Message{
 			Key:   []byte(sessionID),
 			Value: msgBytes,
@@ -120,33 +167,144 @@ func (c *LLMClient) RequestDraft(sessionID string, message string) error {
 		return fmt.Errorf("failed to write to kafka: %v", err)
 	}
 
+	slog.Info("draft published", logging.SessionID(sessionID), logging.MessageID(draftMsg.MessageId), "confidence", resp.ConfidenceScore, "urgency", triage.Urgency.String(), "prompt_template", version)
 	return nil
 }
 
-func (c *LLMClient) processPatientMessages() {
+// processPatientMessages turns patient messages into drafts until fetchCtx is
+// cancelled. Offsets are committed only once a message has been handled, so a
+// draft aborted through draftCtx is handed back to the consumer group.
+func (c *LLMClient) processPatientMessages(fetchCtx, draftCtx context.Context) {
+	defer close(c.done)
+
 	for {
-		msg, err := c.reader.ReadMessage(context.Background())
+		msg, err := c.reader.FetchMessage(fetchCtx)
 		if err != nil {
-			log.Printf("Error reading patient message: %v", err)
+			if fetchCtx.Err() != nil {
+				return
+			}
+			slog.Error("failed to read patient message from kafka", logging.Err(err))
 			continue
 		}
 
 		var patientMsg pb.Message
 		if err := proto.Unmarshal(msg.Value, &patientMsg); err != nil {
-			log.Printf("Error unmarshaling patient message: %v", err)
+			slog.Error("failed to unmarshal patient message", logging.SessionID(string(msg.Key)), logging.Err(err))
+			c.commit(msg)
 			continue
 		}
 
-		// Process with LLM service
-		if err := c.RequestDraft(string(msg.Key), patientMsg.Content); err != nil {
-			log.Printf("Error processing with LLM: %v", err)
+		// Classify urgency, then draft with the LLM service
+		triage := c.Triage(draftCtx, string(msg.Key), patientMsg.Content)
+		if err := c.RequestDraft(draftCtx, string(msg.Key), patientMsg.Content, triage); err != nil {
+			if draftCtx.Err() != nil {
+				slog.Warn("draft aborted by shutdown, handing message back", logging.SessionID(string(msg.Key)))
+				return
+			}
+			slog.Error("failed to generate draft", logging.SessionID(string(msg.Key)), logging.Err(err))
 			// Could implement retry logic here
 		}
+		c.commit(msg)
+	}
+}
+
+// sessionBiometrics returns the latest vitals of the session's patient.
+// Sessions opened without a patient ID have none.
+func (c *LLMClient) sessionBiometrics(ctx context.Context, sessionID string) ([]*pb.BiometricData, error) {
+	chatID, err := pg.ParseUUID(sessionID)
+	if err != nil {
+		return nil, nil
+	}
+
+	chat, err := c.dbq.GetChatSession(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up chat session: %v", err)
+	}
+	return latestBiometrics(ctx, c.dbq, chat.PatientID)
+}
+
+// recordDraft adds a draft to a persisted session's transcript, with the
+// ai_interactions row naming the template version it was generated with.
+// Drafts of the LLM service's default prompt have none.
+func (c *LLMClient) recordDraft(ctx context.Context, sessionID, messageID, version, question string, resp *pb.QuestionResponse) error {
+	chatID, err := pg.ParseUUID(sessionID)
+	if err != nil {
+		return nil
+	}
+	id, err := pg.ParseUUID(messageID)
+	if err != nil {
+		return fmt.Errorf("invalid message id: %v", err)
+	}
+	components, err := json.Marshal(map[string]string{"question": question})
+	if err != nil {
+		return fmt.Errorf("failed to encode prompt components: %v", err)
+	}
+
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback(ctx)
+	q := c.dbq.WithTx(tx)
+
+	err = q.CreateDraftMessage(ctx, db.CreateDraftMessageParams{
+		ID:            id,
+		ChatSessionID: chatID,
+		SenderID:      SystemUserID,
+		Content:       resp.DraftAnswer,
+		MessageType:   MessageTypeAIDraft,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store draft message: %v", err)
+	}
+	err = q.CreateAIInteraction(ctx, db.CreateAIInteractionParams{
+		ChatMessageID:         id,
+		PromptTemplateVersion: pgtype.Text{String: version, Valid: version != ""},
+		PromptComponents:      components,
+		AiResponse:            resp.DraftAnswer,
+		ConfidenceScore:       pgtype.Float8{Float64: float64(resp.ConfidenceScore), Valid: true},
+		References:            resp.References,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store ai interaction: %v", err)
+	}
+	return tx.Commit(ctx)
+}
+
+func (c *LLMClient) commit(msg kafka.Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := c.reader.CommitMessages(ctx, msg); err != nil {
+		slog.Error("failed to commit patient message offset", logging.SessionID(string(msg.Key)), logging.Err(err))
+	}
+}
+
+// Drain stops consuming patient messages and waits for the draft in flight.
+// If ctx expires first the draft is aborted and its message left uncommitted
+// so another instance picks it up.
+func (c *LLMClient) Drain(ctx context.Context) error {
+	c.fetchCancel()
+
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		c.draftCancel()
+		<-c.done
+		return fmt.Errorf("draft in flight was handed back: %v", ctx.Err())
 	}
 }
 
 func (c *LLMClient) Close() error {
+	c.fetchCancel()
+	c.draftCancel()
+	<-c.done
+
 	var errs []error
+	if err := c.reader.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close kafka reader: %v", err))
+	}
 	if c.writer != nil {
 		if err := c.writer.Close(); err != nil {
 			errs = append(errs, fmt.Errorf("failed to close kafka writer: %v", err))
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultConditionStatus is the status of conditions added without one
+const defaultConditionStatus = "ACTIVE"
+
+// MedicalHistoryServer manages the conditions of a patient's medical history
+type MedicalHistoryServer struct {
+	pb.UnimplementedMedicalHistoryServiceServer
+	*recordsServer
+}
+
+func NewMedicalHistoryServer(records *recordsServer) *MedicalHistoryServer {
+	return &MedicalHistoryServer{recordsServer: records}
+}
+
+func (s *MedicalHistoryServer) AddCondition(ctx context.Context, req *pb.AddConditionRequest) (*pb.MedicalCondition, error) {
+	patientID, err := parseID("patient_id", req.PatientId)
+	if err != nil {
+		return nil, err
+	}
+	condition := strings.TrimSpace(req.Condition)
+	if condition == "" {
+		return nil, status.Error(codes.InvalidArgument, "condition is required")
+	}
+	statusID := req.StatusId
+	if statusID == "" {
+		statusID = defaultConditionStatus
+	}
+	if err := s.checkConditionStatus(ctx, statusID); err != nil {
+		return nil, err
+	}
+	diagnosed, err := diagnosedDate(req.DiagnosedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.dbq.GetPatientByUserID(ctx, patientID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "patient not found")
+	} else if err != nil {
+		return nil, internalError("failed to load patient", err)
+	}
+
+	row, err := s.dbq.AddMedicalHistory(ctx, db.AddMedicalHistoryParams{
+		PatientID:     patientID,
+		Condition:     condition,
+		DiagnosedDate: diagnosed,
+		StatusID:      statusID,
+		Notes:         pgtype.Text{String: req.Notes, Valid: req.Notes != ""},
+	})
+	if err != nil {
+		return nil, internalError("failed to add condition", err)
+	}
+	s.auditAdmin(ctx, "AddCondition", patientID, pg.ToUUID(row.ID).String(), nil)
+	return conditionMessage(db.ListMedicalHistoryRow(row)), nil
+}
+
+func (s *MedicalHistoryServer) ListConditions(ctx context.Context, req *pb.ListConditionsRequest) (*pb.ListConditionsResponse, error) {
+	patientID, err := parseID("patient_id", req.PatientId)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := pageBounds(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.ListMedicalHistoryParams{PatientID: patientID, Limit: limit + 1, Offset: offset}
+	if req.StatusId != "" {
+		params.StatusID = pgtype.Text{String: req.StatusId, Valid: true}
+	}
+	rows, err := s.dbq.ListMedicalHistory(ctx, params)
+	if err != nil {
+		return nil, internalError("failed to list conditions", err)
+	}
+
+	resp := &pb.ListConditionsResponse{NextPageToken: nextPageToken(offset, limit, len(rows))}
+	for i, row := range rows {
+		if i == int(limit) {
+			break
+		}
+		resp.Conditions = append(resp.Conditions, conditionMessage(row))
+	}
+	s.auditAdmin(ctx, "ListConditions", patientID, req.PatientId, nil)
+	return resp, nil
+}
+
+func (s *MedicalHistoryServer) UpdateCondition(ctx context.Context, req *pb.UpdateConditionRequest) (*pb.MedicalCondition, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateMedicalHistoryParams{ID: id}
+	if req.Condition != nil {
+		condition := strings.TrimSpace(*req.Condition)
+		if condition == "" {
+			return nil, status.Error(codes.InvalidArgument, "condition cannot be empty")
+		}
+		params.Condition = pgtype.Text{String: condition, Valid: true}
+	}
+	if req.DiagnosedDate != nil {
+		if params.DiagnosedDate, err = diagnosedDate(req.DiagnosedDate); err != nil {
+			return nil, err
+		}
+	}
+	if req.StatusId != nil {
+		if err := s.checkConditionStatus(ctx, *req.StatusId); err != nil {
+			return nil, err
+		}
+		params.StatusID = pgtype.Text{String: *req.StatusId, Valid: true}
+	}
+	if req.Notes != nil {
+		params.Notes = pgtype.Text{String: *req.Notes, Valid: true}
+	}
+
+	row, err := s.dbq.UpdateMedicalHistory(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "condition not found")
+	}
+	if err != nil {
+		return nil, internalError("failed to update condition", err)
+	}
+	s.auditAdmin(ctx, "UpdateCondition", row.PatientID, req.Id, nil)
+	return conditionMessage(db.ListMedicalHistoryRow(row)), nil
+}
+
+func (s *MedicalHistoryServer) DeleteCondition(ctx context.Context, req *pb.DeleteConditionRequest) (*pb.DeleteResponse, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.dbq.SoftDeleteMedicalHistory(ctx, id)
+	if err != nil {
+		return nil, internalError("failed to delete condition", err)
+	}
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "condition not found")
+	}
+	s.auditAdmin(ctx, "DeleteCondition", pgtype.UUID{}, req.Id, nil)
+	return &pb.DeleteResponse{}, nil
+}
+
+func conditionMessage(row db.ListMedicalHistoryRow) *pb.MedicalCondition {
+	c := &pb.MedicalCondition{
+		Id:        pg.ToUUID(row.ID).String(),
+		PatientId: pg.ToUUID(row.PatientID).String(),
+		Condition: row.Condition,
+		StatusId:  row.StatusID,
+		Notes:     row.Notes.String,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+	if row.DiagnosedDate.Valid {
+		c.DiagnosedDate = timestamppb.New(row.DiagnosedDate.Time)
+	}
+	return c
+}
+
+// diagnosedDate converts an optional diagnosis date, which cannot be in the
+// future
+func diagnosedDate(ts *timestamppb.Timestamp) (pgtype.Timestamptz, error) {
+	if ts == nil {
+		return pgtype.Timestamptz{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return pgtype.Timestamptz{}, status.Errorf(codes.InvalidArgument, "invalid diagnosed_date: %v", err)
+	}
+	if ts.AsTime().After(time.Now()) {
+		return pgtype.Timestamptz{}, status.Error(codes.InvalidArgument, "diagnosed_date cannot be in the future")
+	}
+	return pgtype.Timestamptz{Time: ts.AsTime(), Valid: true}, nil
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PatientServer registers and manages patients
+type PatientServer struct {
+	pb.UnimplementedPatientServiceServer
+	*recordsServer
+}
+
+func NewPatientServer(records *recordsServer) *PatientServer {
+	return &PatientServer{recordsServer: records}
+}
+
+func (s *PatientServer) CreatePatient(ctx context.Context, req *pb.CreatePatientRequest) (*pb.Patient, error) {
+	email, err := validEmail(req.Email)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := checkAge(req.Age); err != nil {
+		return nil, err
+	}
+	if err := s.checkGender(ctx, req.Gender); err != nil {
+		return nil, err
+	}
+
+	var userID pgtype.UUID
+	err = s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		user, err := userForEmail(ctx, q, email, name)
+		if err != nil {
+			return err
+		}
+		userID = user.ID
+
+		_, err = q.CreatePatient(ctx, db.CreatePatientParams{
+			UserID: user.ID,
+			Age:    req.Age,
+			Gender: req.Gender,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.AlreadyExists, "a patient with this email already exists")
+		}
+		if err != nil {
+			return internalError("failed to create patient", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("patient created")
+	s.auditAdmin(ctx, "CreatePatient", userID, pg.ToUUID(userID).String(), nil)
+	return s.getPatient(ctx, userID)
+}
+
+func (s *PatientServer) GetPatient(ctx context.Context, req *pb.GetPatientRequest) (*pb.Patient, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	patient, err := s.getPatient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.auditAdmin(ctx, "GetPatient", id, req.Id, nil)
+	return patient, nil
+}
+
+func (s *PatientServer) ListPatients(ctx context.Context, req *pb.ListPatientsRequest) (*pb.ListPatientsResponse, error) {
+	limit, offset, err := pageBounds(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.dbq.ListPatients(ctx, db.ListPatientsParams{Limit: limit + 1, Offset: offset})
+	if err != nil {
+		return nil, internalError("failed to list patients", err)
+	}
+
+	resp := &pb.ListPatientsResponse{NextPageToken: nextPageToken(offset, limit, len(rows))}
+	for i, row := range rows {
+		if i == int(limit) {
+			break
+		}
+		resp.Patients = append(resp.Patients, patientMessage(db.GetPatientByUserIDRow(row)))
+	}
+	s.auditAdmin(ctx, "ListPatients", pgtype.UUID{}, "", map[string]string{"count": strconv.Itoa(len(resp.Patients))})
+	return resp, nil
+}
+
+func (s *PatientServer) UpdatePatient(ctx context.Context, req *pb.UpdatePatientRequest) (*pb.Patient, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdatePatientParams{UserID: id}
+	if req.Age != nil {
+		if err := checkAge(*req.Age); err != nil {
+			return nil, err
+		}
+		params.Age = pgtype.Int4{Int32: *req.Age, Valid: true}
+	}
+	if req.Gender != nil {
+		if err := s.checkGender(ctx, *req.Gender); err != nil {
+			return nil, err
+		}
+		params.Gender = pgtype.Text{String: *req.Gender, Valid: true}
+	}
+
+	err = s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		if _, err := q.UpdatePatient(ctx, params); errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "patient not found")
+		} else if err != nil {
+			return internalError("failed to update patient", err)
+		}
+		return updateUser(ctx, q, id, req.Email, req.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.auditAdmin(ctx, "UpdatePatient", id, req.Id, nil)
+	return s.getPatient(ctx, id)
+}
+
+// DeletePatient soft deletes the patient and their medical history. Their
+// transcripts and readings are kept.
+func (s *PatientServer) DeletePatient(ctx context.Context, req *pb.DeletePatientRequest) (*pb.DeleteResponse, error) {
+	id, err := parseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		rows, err := q.SoftDeletePatient(ctx, id)
+		if err != nil {
+			return internalError("failed to delete patient", err)
+		}
+		if rows == 0 {
+			return status.Error(codes.NotFound, "patient not found")
+		}
+		if err := q.SoftDeletePatientMedicalHistory(ctx, id); err != nil {
+			return internalError("failed to delete medical history", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("patient deleted")
+	s.auditAdmin(ctx, "DeletePatient", id, req.Id, nil)
+	return &pb.DeleteResponse{}, nil
+}
+
+func (s *PatientServer) getPatient(ctx context.Context, id pgtype.UUID) (*pb.Patient, error) {
+	row, err := s.dbq.GetPatientByUserID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "patient not found")
+	}
+	if err != nil {
+		return nil, internalError("failed to load patient", err)
+	}
+	return patientMessage(row), nil
+}
+
+func patientMessage(row db.GetPatientByUserIDRow) *pb.Patient {
+	return &pb.Patient{
+		Id:        pg.ToUUID(row.ID).String(),
+		Email:     row.Email,
+		Name:      row.Name,
+		Age:       row.Age,
+		Gender:    row.Gender,
+		CreatedAt: timestamppb.New(row.CreatedAt.Time),
+	}
+}
+
+// checkAge returns an InvalidArgument error unless age fits patients.age
+func checkAge(age int32) error {
+	if age < 0 || age > 150 {
+		return status.Errorf(codes.InvalidArgument, "age %d is out of range", age)
+	}
+	return nil
+}
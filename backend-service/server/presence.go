@@ -0,0 +1,144 @@
+package server
+
+import (
+	"log/slog"
+
+	"llm-qa-system/backend-service/logging"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// presenceOf returns a doctor's presence over all their connections: online
+// while any of them is, away once all of them report away, unspecified with
+// none. The caller must hold r.mu.
+func (r *Router) presenceOf(doctorID string) pb.PresenceStatus {
+	conns := r.presence[doctorID]
+	if len(conns) == 0 {
+		return pb.PresenceStatus_PRESENCE_UNSPECIFIED
+	}
+	for _, status := range conns {
+		if status == pb.PresenceStatus_PRESENCE_ONLINE {
+			return pb.PresenceStatus_PRESENCE_ONLINE
+		}
+	}
+	return pb.PresenceStatus_PRESENCE_AWAY
+}
+
+// away reports whether a doctor is away from every connection. The caller
+// must hold r.mu.
+func (r *Router) away(doctorID string) bool {
+	return r.presenceOf(doctorID) == pb.PresenceStatus_PRESENCE_AWAY
+}
+
+// SetPresence records the presence one of a doctor's connections reported,
+// PRESENCE_UNSPECIFIED for a connection that closed. It returns the doctor's
+// presence over all their connections before and after. Waiting sessions are
+// assigned again when a doctor comes back.
+func (r *Router) SetPresence(doctorID string, conn *Connection, status pb.PresenceStatus) (before, after pb.PresenceStatus) {
+	r.mu.Lock()
+	before = r.presenceOf(doctorID)
+	if status == pb.PresenceStatus_PRESENCE_UNSPECIFIED {
+		delete(r.presence[doctorID], conn)
+		if len(r.presence[doctorID]) == 0 {
+			delete(r.presence, doctorID)
+		}
+	} else {
+		if r.presence[doctorID] == nil {
+			r.presence[doctorID] = make(map[*Connection]pb.PresenceStatus)
+		}
+		r.presence[doctorID][conn] = status
+	}
+	after = r.presenceOf(doctorID)
+
+	var out []outgoing
+	if before == pb.PresenceStatus_PRESENCE_AWAY && after == pb.PresenceStatus_PRESENCE_ONLINE {
+		out = r.assignWaiting()
+	}
+	r.mu.Unlock()
+
+	if after != before {
+		slog.Info("doctor presence changed", logging.DoctorID(doctorID), "presence", after.String())
+	}
+	deliver(out)
+	return before, after
+}
+
+// participantID returns the user ID of conn's participant, empty for staff
+// who did not identify themselves. The caller must not hold s.mu.
+func (s *WebSocketServer) participantID(conn *Connection) string {
+	if id := s.senderID(conn); id.Valid {
+		return pg.ToUUID(id).String()
+	}
+	return ""
+}
+
+// relayTyping passes conn's typing indicator on to the rest of its session.
+// Indicators are not recorded, published or audited.
+func (s *WebSocketServer) relayTyping(conn *Connection, typing *pb.TypingIndicator) {
+	if conn.sessionID == "" {
+		return
+	}
+	s.notifyParticipants(conn.sessionID, conn, &pb.WebSocketMessage{
+		Type: pb.MessageType_TYPING,
+		Payload: &pb.WebSocketMessage_Typing{
+			Typing: &pb.TypingIndicator{
+				Typing:        typing.Typing,
+				SessionId:     conn.sessionID,
+				Role:          string(conn.role),
+				ParticipantId: s.participantID(conn),
+				Timestamp:     timestamppb.Now(),
+			},
+		},
+	})
+}
+
+// presenceMessage builds the PRESENCE frame about conn's participant
+func (s *WebSocketServer) presenceMessage(conn *Connection, sessionID string, status pb.PresenceStatus) *pb.WebSocketMessage {
+	return &pb.WebSocketMessage{
+		Type: pb.MessageType_PRESENCE,
+		Payload: &pb.WebSocketMessage_Presence{
+			Presence: &pb.Presence{
+				Status:        status,
+				SessionId:     sessionID,
+				Role:          string(conn.role),
+				ParticipantId: s.participantID(conn),
+				Timestamp:     timestamppb.Now(),
+			},
+		},
+	}
+}
+
+// updatePresence records the presence conn reported and tells the sessions
+// it concerns. A doctor's presence covers all their connections, lobby
+// included, so going away on one while another is online changes nothing.
+// Only moves between online and away are announced, connecting and leaving
+// have their session events.
+func (s *WebSocketServer) updatePresence(conn *Connection, status pb.PresenceStatus) {
+	if !conn.role.IsStaff() || conn.doctorID == "" {
+		if conn.sessionID != "" && status != pb.PresenceStatus_PRESENCE_UNSPECIFIED {
+			s.notifyParticipants(conn.sessionID, conn, s.presenceMessage(conn, conn.sessionID, status))
+		}
+		return
+	}
+
+	before, after := s.router.SetPresence(conn.doctorID, conn, status)
+	if before == after || before == pb.PresenceStatus_PRESENCE_UNSPECIFIED || after == pb.PresenceStatus_PRESENCE_UNSPECIFIED {
+		return
+	}
+
+	// Every session the doctor is in hears it, whichever connection changed
+	s.mu.RLock()
+	var doctorConns []*Connection
+	for _, session := range s.sessions {
+		if session.doctorConn != nil && session.doctorConn.doctorID == conn.doctorID {
+			doctorConns = append(doctorConns, session.doctorConn)
+		}
+	}
+	s.mu.RUnlock()
+
+	for _, doctorConn := range doctorConns {
+		s.notifyParticipants(doctorConn.sessionID, doctorConn, s.presenceMessage(doctorConn, doctorConn.sessionID, after))
+	}
+}
@@ -0,0 +1,294 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// validVersion matches the versions ref_prompt_templates can hold
+var validVersion = regexp.MustCompile(`^[A-Za-z0-9._-]{1,50}$`)
+
+// PromptTemplateServer manages prompt template versions and the experiments
+// comparing them
+type PromptTemplateServer struct {
+	pb.UnimplementedPromptTemplateServiceServer
+	*recordsServer
+}
+
+func NewPromptTemplateServer(records *recordsServer) *PromptTemplateServer {
+	return &PromptTemplateServer{recordsServer: records}
+}
+
+func (s *PromptTemplateServer) CreatePromptTemplate(ctx context.Context, req *pb.CreatePromptTemplateRequest) (*pb.PromptTemplate, error) {
+	if !validVersion.MatchString(req.Version) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid version %q", req.Version)
+	}
+	if strings.TrimSpace(req.Template) == "" {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+
+	var template db.RefPromptTemplate
+	err := s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		_, err := q.CreatePromptTemplate(ctx, db.CreatePromptTemplateParams{
+			Version:     req.Version,
+			Template:    req.Template,
+			Description: pgtype.Text{String: req.Description, Valid: req.Description != ""},
+		})
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return status.Errorf(codes.AlreadyExists, "version %q already exists", req.Version)
+			}
+			return internalError("failed to create prompt template", err)
+		}
+		if req.Activate {
+			if err := q.ActivatePromptTemplate(ctx, req.Version); err != nil {
+				return internalError("failed to activate prompt template", err)
+			}
+		}
+
+		template, err = q.GetPromptTemplate(ctx, req.Version)
+		if err != nil {
+			return internalError("failed to load prompt template", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("prompt template created", "version", req.Version, "active", req.Activate)
+	s.auditAdmin(ctx, "CreatePromptTemplate", pgtype.UUID{}, req.Version, map[string]string{"activate": strconv.FormatBool(req.Activate)})
+	return promptTemplateMessage(template), nil
+}
+
+func (s *PromptTemplateServer) GetPromptTemplate(ctx context.Context, req *pb.GetPromptTemplateRequest) (*pb.PromptTemplate, error) {
+	template, err := getPromptTemplate(ctx, s.dbq, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	return promptTemplateMessage(template), nil
+}
+
+func (s *PromptTemplateServer) ListPromptTemplates(ctx context.Context, req *pb.ListPromptTemplatesRequest) (*pb.ListPromptTemplatesResponse, error) {
+	templates, err := s.dbq.ListPromptTemplates(ctx)
+	if err != nil {
+		return nil, internalError("failed to list prompt templates", err)
+	}
+
+	resp := &pb.ListPromptTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, promptTemplateMessage(t))
+	}
+	return resp, nil
+}
+
+func (s *PromptTemplateServer) ActivatePromptTemplate(ctx context.Context, req *pb.ActivatePromptTemplateRequest) (*pb.PromptTemplate, error) {
+	var template db.RefPromptTemplate
+	err := s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		if _, err := getPromptTemplate(ctx, q, req.Version); err != nil {
+			return err
+		}
+		if err := q.ActivatePromptTemplate(ctx, req.Version); err != nil {
+			return internalError("failed to activate prompt template", err)
+		}
+
+		var err error
+		template, err = getPromptTemplate(ctx, q, req.Version)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("prompt template activated", "version", req.Version)
+	s.auditAdmin(ctx, "ActivatePromptTemplate", pgtype.UUID{}, req.Version, nil)
+	return promptTemplateMessage(template), nil
+}
+
+// maxExperimentWeight bounds each arm's weight, so the sum of any number of
+// arms cannot overflow
+const maxExperimentWeight = 10000
+
+func (s *PromptTemplateServer) SetPromptExperiment(ctx context.Context, req *pb.SetPromptExperimentRequest) (*pb.PromptExperiment, error) {
+	seen := make(map[string]bool, len(req.Arms))
+	for _, arm := range req.Arms {
+		if arm.Weight <= 0 || arm.Weight > maxExperimentWeight {
+			return nil, status.Errorf(codes.InvalidArgument, "weight of %q must be between 1 and %d", arm.Version, maxExperimentWeight)
+		}
+		if seen[arm.Version] {
+			return nil, status.Errorf(codes.InvalidArgument, "version %q is listed twice", arm.Version)
+		}
+		seen[arm.Version] = true
+	}
+
+	err := s.inTx(ctx, func(q *db.EncryptedQueries) error {
+		if err := q.ClearPromptExperiment(ctx); err != nil {
+			return internalError("failed to stop prompt experiment", err)
+		}
+		for _, arm := range req.Arms {
+			rows, err := q.SetPromptExperimentWeight(ctx, db.SetPromptExperimentWeightParams{
+				Version:          arm.Version,
+				ExperimentWeight: arm.Weight,
+			})
+			if err != nil {
+				return internalError("failed to start prompt experiment", err)
+			}
+			if rows == 0 {
+				return status.Errorf(codes.NotFound, "prompt template %q not found", arm.Version)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("prompt experiment set", "arms", len(req.Arms))
+	details := make(map[string]string, len(req.Arms))
+	for _, arm := range req.Arms {
+		details["weight:"+arm.Version] = strconv.Itoa(int(arm.Weight))
+	}
+	s.auditAdmin(ctx, "SetPromptExperiment", pgtype.UUID{}, "", details)
+	return s.experiment(ctx)
+}
+
+func (s *PromptTemplateServer) GetPromptExperiment(ctx context.Context, req *pb.GetPromptExperimentRequest) (*pb.PromptExperiment, error) {
+	return s.experiment(ctx)
+}
+
+func (s *PromptTemplateServer) GetPromptTemplateStats(ctx context.Context, req *pb.GetPromptTemplateStatsRequest) (*pb.GetPromptTemplateStatsResponse, error) {
+	start, end := time.Unix(0, 0), time.Now()
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	if start.After(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_time is after end_time")
+	}
+
+	rows, err := s.dbq.GetAIInteractionStatsByTemplate(ctx, db.GetAIInteractionStatsByTemplateParams{
+		StartTime: pgtype.Timestamptz{Time: start, Valid: true},
+		EndTime:   pgtype.Timestamptz{Time: end, Valid: true},
+	})
+	if err != nil {
+		return nil, internalError("failed to load prompt template stats", err)
+	}
+
+	resp := &pb.GetPromptTemplateStatsResponse{}
+	for _, row := range rows {
+		resp.Templates = append(resp.Templates, &pb.PromptTemplateStats{
+			Version:            row.PromptTemplateVersion,
+			TotalInteractions:  row.TotalInteractions,
+			ApprovedCount:      row.ApprovedCount,
+			RejectedCount:      row.RejectedCount,
+			ModifiedCount:      row.ModifiedCount,
+			PendingCount:       row.PendingCount,
+			AvgConfidenceScore: row.AvgConfidenceScore,
+		})
+	}
+	return resp, nil
+}
+
+func (s *PromptTemplateServer) experiment(ctx context.Context) (*pb.PromptExperiment, error) {
+	arms, err := s.dbq.ListPromptExperimentArms(ctx)
+	if err != nil {
+		return nil, internalError("failed to load prompt experiment", err)
+	}
+
+	resp := &pb.PromptExperiment{}
+	for _, arm := range arms {
+		resp.Arms = append(resp.Arms, &pb.ExperimentArm{Version: arm.Version, Weight: arm.ExperimentWeight})
+	}
+	return resp, nil
+}
+
+func getPromptTemplate(ctx context.Context, q *db.EncryptedQueries, version string) (db.RefPromptTemplate, error) {
+	template, err := q.GetPromptTemplate(ctx, version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return template, status.Errorf(codes.NotFound, "prompt template %q not found", version)
+	}
+	if err != nil {
+		return template, internalError("failed to load prompt template", err)
+	}
+	return template, nil
+}
+
+func promptTemplateMessage(t db.RefPromptTemplate) *pb.PromptTemplate {
+	return &pb.PromptTemplate{
+		Version:          t.Version,
+		Template:         t.Template,
+		Description:      t.Description.String,
+		IsActive:         t.IsActive.Bool,
+		ExperimentWeight: t.ExperimentWeight,
+		CreatedAt:        timestamppb.New(t.CreatedAt.Time),
+		UpdatedAt:        timestamppb.New(t.UpdatedAt.Time),
+	}
+}
+
+// sessionPromptTemplate returns the version and text of the template a
+// session's drafts are generated with: its experiment arm while an experiment
+// runs, the active version otherwise. Both are empty if no version is active.
+func sessionPromptTemplate(ctx context.Context, q *db.EncryptedQueries, sessionID string) (string, string, error) {
+	arms, err := q.ListPromptExperimentArms(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load prompt experiment: %v", err)
+	}
+	if len(arms) > 0 {
+		arm := experimentArm(arms, sessionID)
+		return arm.Version, arm.Template, nil
+	}
+
+	active, err := q.GetActivePromptTemplate(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load active prompt template: %v", err)
+	}
+	return active.Version, active.Template, nil
+}
+
+// experimentArm assigns a session to an arm with a probability proportional
+// to its weight. A session keeps its arm for as long as the weights stay the
+// same.
+func experimentArm(arms []db.ListPromptExperimentArmsRow, sessionID string) db.ListPromptExperimentArmsRow {
+	var total uint64
+	for _, arm := range arms {
+		total += uint64(max(arm.ExperimentWeight, 0))
+	}
+	if total == 0 {
+		return arms[0]
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(sessionID))
+	n := uint64(h.Sum32()) % total
+	for _, arm := range arms {
+		weight := uint64(max(arm.ExperimentWeight, 0))
+		if n < weight {
+			return arm
+		}
+		n -= weight
+	}
+	return arms[len(arms)-1]
+}
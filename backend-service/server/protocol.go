@@ -0,0 +1,211 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"llm-qa-system/backend-service/logging"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebSocket subprotocols, both carrying WebSocketMessages
+const (
+	ProtocolV1      = "medqa.v1"       // protojson in text frames
+	ProtocolV1Proto = "medqa.v1+proto" // Binary protobuf in binary frames
+)
+
+// subprotocols lists the subprotocols of /ws. The first one a client offers
+// in Sec-WebSocket-Protocol is used; clients offering none get ProtocolV1.
+var subprotocols = []string{ProtocolV1Proto, ProtocolV1}
+
+// selectProtocol returns the upgrade response header choosing the first
+// subprotocol offered in r that is supported, or nil when there is none.
+// The upgrader's own selection would prefer the order of subprotocols.
+func selectProtocol(r *http.Request) http.Header {
+	for _, offered := range websocket.Subprotocols(r) {
+		if _, ok := protocols[offered]; ok {
+			return http.Header{"Sec-Websocket-Protocol": {offered}}
+		}
+	}
+	return nil
+}
+
+// wireFormat is how a subprotocol frames WebSocketMessages
+type wireFormat struct {
+	version   uint32 // Sent in SESSION_STARTED and SESSION_JOINED
+	frameType int    // websocket.TextMessage or websocket.BinaryMessage
+	marshal   func(proto.Message) ([]byte, error)
+	unmarshal func([]byte, proto.Message) error
+}
+
+var protocols = map[string]*wireFormat{
+	ProtocolV1: {
+		version:   1,
+		frameType: websocket.TextMessage,
+		marshal:   protojson.MarshalOptions{UseProtoNames: true}.Marshal,
+		unmarshal: protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal,
+	},
+	ProtocolV1Proto: {
+		version:   1,
+		frameType: websocket.BinaryMessage,
+		marshal:   proto.Marshal,
+		unmarshal: proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal,
+	},
+}
+
+// Close codes sent when a connection is refused, in the range RFC 6455
+// leaves to applications
+const (
+	CloseBadRequest      = 4000 // Missing or invalid query parameters
+	CloseUnauthorized    = 4001 // Invalid token
+	CloseForbidden       = 4003 // The role may not do what was asked
+	CloseSessionNotFound = 4004
+	CloseIdleTimeout     = 4008
+	CloseConflict        = 4009 // The session already has a doctor, or the doctor is already on duty
+)
+
+// maxCloseReason is the longest reason that fits a close frame
+const maxCloseReason = 123
+
+// closeError refuses a connection with a close code and a reason shown to
+// the client
+type closeError struct {
+	code   int
+	reason string
+}
+
+func (e *closeError) Error() string {
+	return e.reason
+}
+
+// refuse returns a closeError with a formatted reason
+func refuse(code int, format string, args ...any) error {
+	return &closeError{code: code, reason: fmt.Sprintf(format, args...)}
+}
+
+// closeCode returns the close code and reason for err. Errors without a
+// code are internal, their text is not sent.
+func closeCode(err error) (int, string) {
+	var ce *closeError
+	if errors.As(err, &ce) {
+		return ce.code, ce.reason
+	}
+	return websocket.CloseInternalServerErr, "internal error"
+}
+
+// negotiateProtocol returns the wire format of the subprotocol conn was
+// upgraded with. It fails when r offered subprotocols and none is supported.
+func negotiateProtocol(r *http.Request, conn *websocket.Conn) (*wireFormat, error) {
+	if wire, ok := protocols[conn.Subprotocol()]; ok {
+		return wire, nil
+	}
+	if offered := websocket.Subprotocols(r); len(offered) > 0 {
+		return nil, refuse(websocket.CloseProtocolError, "unsupported protocol %q, supported: %v", offered[0], subprotocols)
+	}
+	return protocols[ProtocolV1], nil
+}
+
+// refuseConnection closes conn with the close code of err
+func refuseConnection(conn *websocket.Conn, err error) {
+	code, reason := closeCode(err)
+	if len(reason) > maxCloseReason {
+		reason = reason[:maxCloseReason]
+		for !utf8.ValidString(reason) {
+			reason = reason[:len(reason)-1]
+		}
+	}
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	conn.Close()
+}
+
+// errMessageTooLarge is returned by readMessage for a message over the limit
+var errMessageTooLarge = errors.New("message too large")
+
+// readMessage reads the next message from conn, at most limit bytes of it.
+// Unlike conn.SetReadLimit, it leaves the connection open on a larger
+// message, so the client can be told why before it is closed with 1009.
+func readMessage(conn *websocket.Conn, limit int64) (int, []byte, error) {
+	frameType, r, err := conn.NextReader()
+	if err != nil {
+		return frameType, nil, err
+	}
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return frameType, nil, err
+	}
+	if int64(len(data)) > limit {
+		return frameType, nil, errMessageTooLarge
+	}
+	return frameType, data, nil
+}
+
+// sessionEvent builds a SESSION_STARTED, SESSION_JOINED, PARTICIPANT_LEFT or
+// SESSION_CLOSED frame about the participant conn, nil for none. The caller
+// must not hold s.mu.
+func (s *WebSocketServer) sessionEvent(msgType pb.MessageType, sessionID string, conn *Connection, reason string) *pb.WebSocketMessage {
+	event := &pb.SessionEvent{
+		SessionId: sessionID,
+		Reason:    reason,
+		Timestamp: timestamppb.Now(),
+	}
+	if conn != nil {
+		event.Role = string(conn.role)
+		event.Observer = conn.observer
+		event.ParticipantId = s.participantID(conn)
+	}
+	return &pb.WebSocketMessage{
+		Type:    msgType,
+		Payload: &pb.WebSocketMessage_SessionEvent{SessionEvent: event},
+	}
+}
+
+// greet sends conn the event that opens its connection, SESSION_STARTED for
+// patients and SESSION_JOINED for staff, with the negotiated version
+func (s *WebSocketServer) greet(conn *Connection, msgType pb.MessageType) error {
+	msg := s.sessionEvent(msgType, conn.sessionID, conn, "")
+	msg.GetSessionEvent().ProtocolVersion = conn.wire.version
+	return conn.send(msg)
+}
+
+// participants returns every connection of the session. The caller must hold
+// s.mu or own the session.
+func (c *ChatSession) participants() []*Connection {
+	conns := c.observerConns()
+	if c.patientConn != nil {
+		conns = append(conns, c.patientConn)
+	}
+	if c.doctorConn != nil {
+		conns = append(conns, c.doctorConn)
+	}
+	return conns
+}
+
+// notifyParticipants sends msg to every participant of the session except
+// the one it is about
+func (s *WebSocketServer) notifyParticipants(sessionID string, except *Connection, msg *pb.WebSocketMessage) {
+	s.mu.RLock()
+	var conns []*Connection
+	if session, exists := s.sessions[sessionID]; exists {
+		conns = session.participants()
+	}
+	s.mu.RUnlock()
+
+	for _, conn := range conns {
+		if conn == except {
+			continue
+		}
+		if err := conn.send(msg); err != nil {
+			slog.Warn("failed to send session event", logging.SessionID(sessionID), logging.Role(string(conn.role)), logging.Err(err))
+		}
+	}
+}
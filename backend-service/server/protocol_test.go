@@ -0,0 +1,45 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestSelectProtocolPrefersClientOrder(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, selectProtocol(r))
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}))
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	tests := []struct {
+		offered []string
+		want    string
+	}{
+		{[]string{ProtocolV1, ProtocolV1Proto}, ProtocolV1},
+		{[]string{ProtocolV1Proto, ProtocolV1}, ProtocolV1Proto},
+		{[]string{"medqa.v9", ProtocolV1}, ProtocolV1},
+		{[]string{"medqa.v9"}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		dialer := websocket.Dialer{Subprotocols: tt.offered}
+		conn, _, err := dialer.Dial(url, nil)
+		if err != nil {
+			t.Fatalf("dial offering %v: %v", tt.offered, err)
+		}
+		if got := conn.Subprotocol(); got != tt.want {
+			t.Errorf("offering %v selected %q, want %q", tt.offered, got, tt.want)
+		}
+		conn.Close()
+	}
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	pb "llm-qa-system/backend-service/src/proto"
+)
+
+// PendingDraft is an AI draft waiting for a doctor's review
+type PendingDraft struct {
+	SessionID string
+	Draft     *pb.AIDraftReady
+	QueuedAt  time.Time
+	DueAt     time.Time // Review deadline from the SLA for the draft's urgency
+	Escalated bool      // Set once the missed deadline has been escalated
+}
+
+// ReviewQueue holds drafts awaiting review. Listings are ordered most urgent
+// first, then oldest first, so emergencies always reach the top.
+type ReviewQueue struct {
+	mu     sync.Mutex
+	sla    config.SLAConfig
+	drafts map[string]*PendingDraft // keyed by draft message ID
+}
+
+func NewReviewQueue(sla config.SLAConfig) *ReviewQueue {
+	return &ReviewQueue{
+		sla:    sla,
+		drafts: make(map[string]*PendingDraft),
+	}
+}
+
+// Push adds a draft to the queue
+func (q *ReviewQueue) Push(sessionID string, draft *pb.AIDraftReady) *PendingDraft {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	pending := &PendingDraft{
+		SessionID: sessionID,
+		Draft:     draft,
+		QueuedAt:  now,
+		DueAt:     now.Add(reviewDeadline(q.sla, draft.Urgency)),
+	}
+	q.drafts[draft.MessageId] = pending
+	return pending
+}
+
+// Remove takes a reviewed draft of sessionID off the queue. Drafts of other
+// sessions are left where they are.
+func (q *ReviewQueue) Remove(messageID, sessionID string) (*PendingDraft, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	pending, ok := q.drafts[messageID]
+	if !ok || pending.SessionID != sessionID {
+		return nil, false
+	}
+	delete(q.drafts, messageID)
+	return pending, true
+}
+
+// RemoveSession drops every draft belonging to a closed session
+func (q *ReviewQueue) RemoveSession(sessionID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for id, pending := range q.drafts {
+		if pending.SessionID == sessionID {
+			delete(q.drafts, id)
+		}
+	}
+}
+
+// TakeOverdue returns the drafts past their deadline that have not been
+// escalated yet, and marks them escalated
+func (q *ReviewQueue) TakeOverdue(now time.Time) []*PendingDraft {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var out []*PendingDraft
+	for _, pending := range q.drafts {
+		if !pending.Escalated && now.After(pending.DueAt) {
+			pending.Escalated = true
+			out = append(out, pending)
+		}
+	}
+	return out
+}
+
+// ForSession returns the drafts of one session in priority order
+func (q *ReviewQueue) ForSession(sessionID string) []*PendingDraft {
+	return q.filter(func(p *PendingDraft) bool { return p.SessionID == sessionID })
+}
+
+// List returns every pending draft in priority order
+func (q *ReviewQueue) List() []*PendingDraft {
+	return q.filter(func(*PendingDraft) bool { return true })
+}
+
+func (q *ReviewQueue) filter(keep func(*PendingDraft) bool) []*PendingDraft {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	out := make([]*PendingDraft, 0, len(q.drafts))
+	for _, pending := range q.drafts {
+		if keep(pending) {
+			out = append(out, pending)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Draft.Urgency != out[j].Draft.Urgency {
+			return out[i].Draft.Urgency > out[j].Draft.Urgency
+		}
+		return out[i].QueuedAt.Before(out[j].QueuedAt)
+	})
+	return out
+}
+
+// reviewDeadline returns how long a draft of the given urgency may wait
+func reviewDeadline(sla config.SLAConfig, urgency pb.UrgencyLevel) time.Duration {
+	switch urgency {
+	case pb.UrgencyLevel_URGENCY_EMERGENCY:
+		return sla.Emergency
+	case pb.UrgencyLevel_URGENCY_URGENT:
+		return sla.Urgent
+	default:
+		return sla.Routine
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	pb "llm-qa-system/backend-service/src/proto"
+)
+
+var testSLA = config.SLAConfig{Routine: time.Hour, Urgent: 10 * time.Minute, Emergency: time.Minute}
+
+func draftIDs(drafts []*PendingDraft) []string {
+	var ids []string
+	for _, d := range drafts {
+		ids = append(ids, d.Draft.MessageId)
+	}
+	return ids
+}
+
+func TestReviewQueueOrder(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	q := NewReviewQueue(testSLA)
+	for i, d := range []struct {
+		id, session string
+		urgency     pb.UrgencyLevel
+	}{
+		{"routine-old", "s1", pb.UrgencyLevel_URGENCY_ROUTINE},
+		{"urgent", "s2", pb.UrgencyLevel_URGENCY_URGENT},
+		{"routine-new", "s1", pb.UrgencyLevel_URGENCY_ROUTINE},
+		{"emergency-new", "s2", pb.UrgencyLevel_URGENCY_EMERGENCY},
+		{"emergency-old", "s1", pb.UrgencyLevel_URGENCY_EMERGENCY},
+	} {
+		pending := q.Push(d.session, &pb.AIDraftReady{MessageId: d.id, Urgency: d.urgency})
+		pending.QueuedAt = start.Add(time.Duration(i) * time.Minute)
+	}
+	q.drafts["emergency-old"].QueuedAt = start.Add(-time.Minute)
+
+	tests := []struct {
+		name string
+		got  []*PendingDraft
+		want []string
+	}{
+		{"all", q.List(), []string{"emergency-old", "emergency-new", "urgent", "routine-old", "routine-new"}},
+		{"one session", q.ForSession("s1"), []string{"emergency-old", "routine-old", "routine-new"}},
+		{"no session", q.ForSession("s3"), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := draftIDs(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("order = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReviewQueueDeadlines(t *testing.T) {
+	q := NewReviewQueue(testSLA)
+	tests := []struct {
+		urgency pb.UrgencyLevel
+		want    time.Duration
+	}{
+		{pb.UrgencyLevel_URGENCY_UNSPECIFIED, time.Hour},
+		{pb.UrgencyLevel_URGENCY_ROUTINE, time.Hour},
+		{pb.UrgencyLevel_URGENCY_URGENT, 10 * time.Minute},
+		{pb.UrgencyLevel_URGENCY_EMERGENCY, time.Minute},
+	}
+	for _, tt := range tests {
+		pending := q.Push("s1", &pb.AIDraftReady{MessageId: tt.urgency.String(), Urgency: tt.urgency})
+		if got := pending.DueAt.Sub(pending.QueuedAt); got != tt.want {
+			t.Errorf("%v draft due after %v, want %v", tt.urgency, got, tt.want)
+		}
+	}
+}
+
+func TestReviewQueueTakeOverdue(t *testing.T) {
+	q := NewReviewQueue(testSLA)
+	now := time.Now()
+	q.Push("s1", &pb.AIDraftReady{MessageId: "routine", Urgency: pb.UrgencyLevel_URGENCY_ROUTINE})
+	q.Push("s1", &pb.AIDraftReady{MessageId: "urgent", Urgency: pb.UrgencyLevel_URGENCY_URGENT})
+	q.Push("s2", &pb.AIDraftReady{MessageId: "emergency", Urgency: pb.UrgencyLevel_URGENCY_EMERGENCY})
+
+	tests := []struct {
+		name  string
+		after time.Duration
+		want  []string
+	}{
+		{"none due", 30 * time.Second, nil},
+		{"emergency due", 2 * time.Minute, []string{"emergency"}},
+		{"escalated once", 3 * time.Minute, nil},
+		{"urgent due", 11 * time.Minute, []string{"urgent"}},
+		{"routine due", 2 * time.Hour, []string{"routine"}},
+		{"all escalated", 3 * time.Hour, nil},
+	}
+	for _, tt := range tests {
+		got := draftIDs(q.TakeOverdue(now.Add(tt.after)))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: TakeOverdue() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if n := len(q.List()); n != 3 {
+		t.Fatalf("escalated drafts left the queue, %d remain", n)
+	}
+}
+
+func TestReviewQueueRemove(t *testing.T) {
+	q := NewReviewQueue(testSLA)
+	q.Push("s1", &pb.AIDraftReady{MessageId: "d1", Draft: "Rest."})
+	q.Push("s2", &pb.AIDraftReady{MessageId: "d2"})
+	q.Push("s2", &pb.AIDraftReady{MessageId: "d3"})
+
+	if _, ok := q.Remove("d1", "s2"); ok {
+		t.Fatal("Remove() took a draft of another session")
+	}
+	pending, ok := q.Remove("d1", "s1")
+	if !ok || pending.Draft.Draft != "Rest." {
+		t.Fatalf("Remove() = %v, %v, want the draft", pending, ok)
+	}
+	if _, ok := q.Remove("d1", "s1"); ok {
+		t.Fatal("Remove() took the same draft twice")
+	}
+	q.RemoveSession("s2")
+	if n := len(q.List()); n != 0 {
+		t.Fatalf("%d drafts left after removing every session", n)
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"llm-qa-system/backend-service/config"
+
+	"golang.org/x/time/rate"
+)
+
+// ipConnLimiter caps the number of concurrent WebSocket connections per client IP
+type ipConnLimiter struct {
+	max   int
+	mu    sync.Mutex
+	conns map[string]int
+}
+
+func newIPConnLimiter(max int) *ipConnLimiter {
+	return &ipConnLimiter{
+		max:   max,
+		conns: make(map[string]int),
+	}
+}
+
+// acquire reserves a connection slot for ip and reports whether one was free
+func (l *ipConnLimiter) acquire(ip string) bool {
+	if l.max <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conns[ip] >= l.max {
+		return false
+	}
+	l.conns[ip]++
+	return true
+}
+
+// release frees a slot reserved by acquire
+func (l *ipConnLimiter) release(ip string) {
+	if l.max <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conns[ip] <= 1 {
+		delete(l.conns, ip)
+		return
+	}
+	l.conns[ip]--
+}
+
+// newMessageLimiter returns the per-connection message rate limiter, or nil
+// when no rate is configured
+func newMessageLimiter(cfg config.LimitsConfig) *rate.Limiter {
+	if cfg.MessagesPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(cfg.MessagesPerSecond), cfg.MessageBurst)
+}
+
+// newOriginChecker returns a CheckOrigin function for the upgrader. With no
+// allowed origins it keeps gorilla's same-origin default.
+func newOriginChecker(allowed []string) func(r *http.Request) bool {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	allowAll := false
+	set := make(map[string]struct{}, len(allowed))
+	for _, origin := range allowed {
+		if origin == "*" {
+			allowAll = true
+		}
+		set[strings.ToLower(strings.TrimRight(origin, "/"))] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || allowAll {
+			// Non-browser clients such as the CLI do not send an Origin
+			return true
+		}
+
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		_, ok := set[strings.ToLower(u.Scheme+"://"+u.Host)]
+		return ok
+	}
+}
+
+// clientIP returns the IP address of the remote end of r
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"net/mail"
+	"strconv"
+	"strings"
+
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Page sizes of the management API lists
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// recordsServer holds what the management services share: authorization of
+// their HTTP routes, reference data checks and pagination
+type recordsServer struct {
+	*BaseServer
+	intercept grpc.UnaryServerInterceptor // Authorizes RPCs received over HTTP
+}
+
+func newRecordsServer(base *BaseServer, intercept grpc.UnaryServerInterceptor) *recordsServer {
+	return &recordsServer{
+		BaseServer: base,
+		intercept:  intercept,
+	}
+}
+
+// checkGender returns an InvalidArgument error unless gender is an active
+// ref_gender entry
+func (s *recordsServer) checkGender(ctx context.Context, gender string) error {
+	genders, err := s.dbq.ListActiveGenders(ctx)
+	if err != nil {
+		return internalError("failed to load genders", err)
+	}
+	for _, g := range genders {
+		if g.ID == gender {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown gender %q", gender)
+}
+
+// checkDepartment returns an InvalidArgument error unless department is an
+// active ref_departments entry
+func (s *recordsServer) checkDepartment(ctx context.Context, department string) error {
+	departments, err := s.dbq.ListActiveDepartments(ctx)
+	if err != nil {
+		return internalError("failed to load departments", err)
+	}
+	for _, d := range departments {
+		if d.ID == department {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown department_id %q", department)
+}
+
+// checkConditionStatus returns an InvalidArgument error unless statusID is
+// an active ref_medical_condition_status entry
+func (s *recordsServer) checkConditionStatus(ctx context.Context, statusID string) error {
+	statuses, err := s.dbq.ListActiveConditionStatuses(ctx)
+	if err != nil {
+		return internalError("failed to load condition statuses", err)
+	}
+	for _, st := range statuses {
+		if st.ID == statusID {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown status_id %q", statusID)
+}
+
+// userForEmail returns the user registered under email, creating them if new
+func userForEmail(ctx context.Context, q *db.EncryptedQueries, email, name string) (db.User, error) {
+	user, err := q.GetUserByEmail(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		user, err = q.CreateUser(ctx, db.CreateUserParams{Email: email, Name: name})
+		if err != nil {
+			return db.User{}, internalError("failed to create user", err)
+		}
+		return user, nil
+	}
+	if err != nil {
+		return db.User{}, internalError("failed to look up user", err)
+	}
+	return user, nil
+}
+
+// updateUser changes the email and name that are set
+func updateUser(ctx context.Context, q *db.EncryptedQueries, id pgtype.UUID, email, name *string) error {
+	if email == nil && name == nil {
+		return nil
+	}
+
+	params := db.UpdateUserParams{ID: id}
+	if email != nil {
+		address, err := validEmail(*email)
+		if err != nil {
+			return err
+		}
+		params.Email = pgtype.Text{String: address, Valid: true}
+	}
+	if name != nil {
+		if strings.TrimSpace(*name) == "" {
+			return status.Error(codes.InvalidArgument, "name cannot be empty")
+		}
+		params.Name = pgtype.Text{String: strings.TrimSpace(*name), Valid: true}
+	}
+
+	if _, err := q.UpdateUser(ctx, params); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return status.Error(codes.AlreadyExists, "email is already registered")
+		}
+		return internalError("failed to update user", err)
+	}
+	return nil
+}
+
+// validEmail returns the address in email, or an InvalidArgument error
+func validEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", status.Error(codes.InvalidArgument, "email is required")
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", status.Errorf(codes.InvalidArgument, "invalid email %q", email)
+	}
+	return email, nil
+}
+
+// parseID parses a UUID from a request, or returns an InvalidArgument error
+func parseID(field, value string) (pgtype.UUID, error) {
+	id, err := pg.ParseUUID(value)
+	if err != nil {
+		return id, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	return id, nil
+}
+
+// pageBounds returns the limit and offset of the page a list request asks for
+func pageBounds(pageSize int32, pageToken string) (int32, int32, error) {
+	switch {
+	case pageSize < 0:
+		return 0, 0, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	if pageToken == "" {
+		return pageSize, 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	offset, err := strconv.ParseInt(string(raw), 10, 32)
+	if err != nil || offset < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return pageSize, int32(offset), nil
+}
+
+// nextPageToken returns the token of the page after one at offset. Lists
+// fetch limit+1 rows, an extra row means there is another page.
+func nextPageToken(offset, limit int32, rows int) string {
+	if rows <= int(limit) {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(offset + limit))))
+}
+
+// internalError logs err and returns an Internal status that does not leak it
+func internalError(msg string, err error) error {
+	slog.Error(msg, logging.Err(err))
+	return status.Error(codes.Internal, msg)
+}
+
+// inTx runs fn in a transaction, committing if it returns nil
+func (s *recordsServer) inTx(ctx context.Context, fn func(q *db.EncryptedQueries) error) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return internalError("failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(s.dbq.WithTx(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return internalError("failed to commit transaction", err)
+	}
+	return nil
+}
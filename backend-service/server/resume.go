@@ -0,0 +1,99 @@
+package server
+
+import (
+	"log/slog"
+	"time"
+
+	"llm-qa-system/backend-service/logging"
+	pg "llm-qa-system/backend-service/utils"
+)
+
+// holdForResume keeps a verified patient's session open for the resume
+// window once their connection drops, reporting whether it did. Anonymous
+// patients cannot prove the session is theirs, so theirs close at once. The
+// caller must hold s.mu.
+func (s *WebSocketServer) holdForResume(session *ChatSession) bool {
+	if s.sessionCfg.ResumeWindow <= 0 || !session.patientID.Valid || s.draining.Load() {
+		return false
+	}
+	session.resumeTimer = time.AfterFunc(s.sessionCfg.ResumeWindow, func() { s.expireSession(session) })
+	return true
+}
+
+// resumePatientSession reattaches a patient to the session their connection
+// dropped from. Only the session's patient, by their verified patient token,
+// may resume it; other sessions are reported as not found.
+func (s *WebSocketServer) resumePatientSession(conn *Connection, req sessionRequest) error {
+	if req.patientID == "" {
+		return refuse(CloseUnauthorized, "a patient token is required to resume a session")
+	}
+	patientID, err := pg.ParseUUID(req.patientID)
+	if err != nil {
+		return refuse(CloseUnauthorized, "invalid patient token: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, exists := s.sessions[req.sessionID]
+	if !exists || session.patientID != patientID {
+		return refuse(CloseSessionNotFound, "session not found")
+	}
+	if session.patientConn != nil {
+		return refuse(CloseConflict, "session already has a patient")
+	}
+	if session.resumeTimer != nil {
+		session.resumeTimer.Stop()
+		session.resumeTimer = nil
+	}
+	session.patientConn = conn
+	session.alertOnly = false
+	conn.sessionID = session.sessionID
+	slog.Info("patient resumed session", logging.SessionID(session.sessionID), "last_seq", req.lastSeq)
+	return nil
+}
+
+// expireSession closes a session whose patient did not come back within the
+// resume window, or an alert session no one joined within session.alert_ttl.
+// An alert session with a doctor in it closes when the doctor leaves instead.
+func (s *WebSocketServer) expireSession(session *ChatSession) {
+	s.mu.Lock()
+	if s.sessions[session.sessionID] != session || session.patientConn != nil || session.alertOnly && session.doctorConn != nil {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.sessions, session.sessionID)
+	s.queue.RemoveSession(session.sessionID)
+	session.resumeTimer = nil
+	remaining := session.participants()
+	alertOnly := session.alertOnly
+	s.mu.Unlock()
+
+	if alertOnly {
+		slog.Warn("vital alert session not joined in time, closing it", logging.SessionID(session.sessionID))
+		s.endSession(session, remaining, nil, "vital alert expired")
+		return
+	}
+	slog.Info("patient did not resume, closing session", logging.SessionID(session.sessionID))
+	s.endSession(session, remaining, nil, "patient left")
+}
+
+// endHeldSessions closes the sessions still waiting for their patient to
+// resume. They live in this instance's memory, so they end with it.
+func (s *WebSocketServer) endHeldSessions() {
+	s.mu.Lock()
+	var held []*ChatSession
+	for id, session := range s.sessions {
+		if session.resumeTimer != nil && session.patientConn == nil {
+			session.resumeTimer.Stop()
+			delete(s.sessions, id)
+			s.queue.RemoveSession(id)
+			held = append(held, session)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, session := range held {
+		s.router.Close(session.sessionID)
+		s.closeChatSession(session)
+	}
+}
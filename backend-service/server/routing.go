@@ -0,0 +1,554 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dutyDoctor is a doctor holding a lobby connection, ready for assignments
+type dutyDoctor struct {
+	id           string
+	department   string
+	conn         *Connection // Lobby connection, receives SESSION_ASSIGNMENT
+	availability pb.DoctorAvailability
+	sessions     map[string]struct{} // Sessions offered to or joined by the doctor
+	lastAssigned time.Time
+}
+
+// sessionRoute tracks the assignment of one session
+type sessionRoute struct {
+	sessionID   string
+	department  string
+	urgency     pb.UrgencyLevel
+	doctorID    string              // Empty while the session waits for a doctor
+	accepted    bool                // A doctor has joined the session
+	declined    map[string]struct{} // Doctors who let the assignment time out
+	timer       *time.Timer
+	queuedAt    time.Time
+	handoffFrom string // Doctor who handed the session over, until it is accepted
+	handoffNote string
+}
+
+func (r *sessionRoute) stopTimer() {
+	if r.timer != nil {
+		r.timer.Stop()
+		r.timer = nil
+	}
+}
+
+// outgoing is a message to send once the router lock is released
+type outgoing struct {
+	conn *Connection
+	msg  *pb.WebSocketMessage
+}
+
+// Router assigns new sessions to available doctors of the session's
+// department. An assignment not picked up within the accept timeout moves on
+// to the next doctor.
+type Router struct {
+	cfg     config.RoutingConfig
+	mu      sync.Mutex
+	doctors map[string]*dutyDoctor   // keyed by doctor ID
+	routes  map[string]*sessionRoute // keyed by session ID
+	cursor  map[string]int           // Round-robin position per department
+	// Presence each doctor's connections last reported, keyed by doctor ID
+	presence map[string]map[*Connection]pb.PresenceStatus
+}
+
+func NewRouter(cfg config.RoutingConfig) *Router {
+	return &Router{
+		cfg:      cfg,
+		doctors:  make(map[string]*dutyDoctor),
+		routes:   make(map[string]*sessionRoute),
+		cursor:   make(map[string]int),
+		presence: make(map[string]map[*Connection]pb.PresenceStatus),
+	}
+}
+
+// GoOnDuty registers a doctor's lobby connection. Doctors start available.
+func (r *Router) GoOnDuty(doctorID, department string, conn *Connection) error {
+	r.mu.Lock()
+	if _, exists := r.doctors[doctorID]; exists {
+		r.mu.Unlock()
+		return refuse(CloseConflict, "doctor is already on duty")
+	}
+	r.doctors[doctorID] = &dutyDoctor{
+		id:           doctorID,
+		department:   department,
+		conn:         conn,
+		availability: pb.DoctorAvailability_AVAILABILITY_AVAILABLE,
+		sessions:     make(map[string]struct{}),
+	}
+	out := r.assignWaiting()
+	r.mu.Unlock()
+
+	slog.Info("doctor on duty", logging.DoctorID(doctorID), "department", department)
+	deliver(out)
+	return nil
+}
+
+// GoOffDuty removes a doctor whose lobby connection closed. Sessions offered
+// to the doctor but not yet joined are assigned again.
+func (r *Router) GoOffDuty(doctorID string, conn *Connection) {
+	r.mu.Lock()
+	d, ok := r.doctors[doctorID]
+	if !ok || d.conn != conn {
+		r.mu.Unlock()
+		return
+	}
+	delete(r.doctors, doctorID)
+
+	for sessionID := range d.sessions {
+		if route := r.routes[sessionID]; route != nil && !route.accepted && route.doctorID == doctorID {
+			route.stopTimer()
+			route.doctorID = ""
+		}
+	}
+	out := r.assignWaiting()
+	r.mu.Unlock()
+
+	slog.Info("doctor off duty", logging.DoctorID(doctorID))
+	deliver(out)
+}
+
+// SetAvailability changes whether a doctor receives new sessions
+func (r *Router) SetAvailability(doctorID string, availability pb.DoctorAvailability) {
+	r.mu.Lock()
+	d, ok := r.doctors[doctorID]
+	if !ok {
+		r.mu.Unlock()
+		return
+	}
+	d.availability = availability
+
+	var out []outgoing
+	if availability == pb.DoctorAvailability_AVAILABILITY_AVAILABLE {
+		out = r.assignWaiting()
+	}
+	r.mu.Unlock()
+
+	slog.Info("doctor availability changed", logging.DoctorID(doctorID), "availability", availability.String())
+	deliver(out)
+}
+
+// Route queues a session for assignment to a doctor of department, or of the
+// default department when empty. A session already routed keeps its doctor,
+// but a higher urgency moves it up while it waits.
+func (r *Router) Route(sessionID, department string, urgency pb.UrgencyLevel) {
+	if department == "" {
+		department = r.cfg.DefaultDepartment
+	}
+
+	r.mu.Lock()
+	if route, exists := r.routes[sessionID]; exists {
+		if urgency > route.urgency {
+			route.urgency = urgency
+		}
+		r.mu.Unlock()
+		return
+	}
+	r.routes[sessionID] = &sessionRoute{
+		sessionID:  sessionID,
+		department: department,
+		urgency:    urgency,
+		declined:   make(map[string]struct{}),
+		queuedAt:   time.Now(),
+	}
+	out := r.assignWaiting()
+	waiting := r.routes[sessionID].doctorID == ""
+	r.mu.Unlock()
+
+	if waiting {
+		slog.Warn("no doctor available, session waiting", logging.SessionID(sessionID), "department", department)
+	}
+	deliver(out)
+}
+
+// Accept records that a doctor joined a session. Joining a session offered to
+// someone else takes it over.
+func (r *Router) Accept(sessionID, doctorID string) {
+	r.mu.Lock()
+	route, exists := r.routes[sessionID]
+	if !exists {
+		// Joined by session ID before the session was routed
+		route = &sessionRoute{
+			sessionID: sessionID,
+			declined:  make(map[string]struct{}),
+			queuedAt:  time.Now(),
+		}
+		r.routes[sessionID] = route
+	}
+
+	var out []outgoing
+	if route.doctorID != "" && route.doctorID != doctorID {
+		if prev := r.doctors[route.doctorID]; prev != nil {
+			delete(prev.sessions, sessionID)
+			if !route.accepted {
+				out = append(out, outgoing{prev.conn, assignmentMessage(route, time.Time{}, true)})
+			}
+		}
+	}
+	route.stopTimer()
+	route.doctorID = doctorID
+	route.accepted = true
+	route.handoffFrom = ""
+	route.handoffNote = ""
+	if d := r.doctors[doctorID]; d != nil {
+		d.sessions[sessionID] = struct{}{}
+	}
+	r.mu.Unlock()
+
+	deliver(out)
+}
+
+// Transfer hands a session from one doctor to another on-duty doctor. The
+// assignment carries the handoff note and, like any other, moves on if the
+// receiving doctor does not join in time.
+func (r *Router) Transfer(sessionID, fromID, toID, note string) error {
+	r.mu.Lock()
+	to, ok := r.doctors[toID]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("doctor %s is not on duty", toID)
+	}
+	if toID == fromID {
+		r.mu.Unlock()
+		return fmt.Errorf("cannot hand a session to yourself")
+	}
+
+	route, exists := r.routes[sessionID]
+	if !exists {
+		route = &sessionRoute{
+			sessionID:  sessionID,
+			department: to.department,
+			declined:   make(map[string]struct{}),
+			queuedAt:   time.Now(),
+		}
+		r.routes[sessionID] = route
+	}
+	route.stopTimer()
+	if from := r.doctors[fromID]; from != nil {
+		delete(from.sessions, sessionID)
+	}
+	route.accepted = false
+	clear(route.declined)
+	route.handoffFrom = fromID
+	route.handoffNote = note
+	out := []outgoing{r.assign(route, to)}
+	r.mu.Unlock()
+
+	slog.Info("session handed off", logging.SessionID(sessionID), "from_doctor_id", fromID, logging.DoctorID(toID))
+	deliver(out)
+	return nil
+}
+
+// Close forgets a finished session and frees its doctor for new ones
+func (r *Router) Close(sessionID string) {
+	r.mu.Lock()
+	route, exists := r.routes[sessionID]
+	if !exists {
+		r.mu.Unlock()
+		return
+	}
+	delete(r.routes, sessionID)
+	route.stopTimer()
+
+	var out []outgoing
+	if d := r.doctors[route.doctorID]; d != nil {
+		delete(d.sessions, sessionID)
+		if !route.accepted {
+			out = append(out, outgoing{d.conn, assignmentMessage(route, time.Time{}, true)})
+		}
+	}
+	out = append(out, r.assignWaiting()...)
+	r.mu.Unlock()
+
+	deliver(out)
+}
+
+// AssignedDoctor returns the doctor a session is assigned to, if any
+func (r *Router) AssignedDoctor(sessionID string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	route, exists := r.routes[sessionID]
+	if !exists || route.doctorID == "" {
+		return "", false
+	}
+	return route.doctorID, true
+}
+
+// Lobby returns the lobby connections of every on-duty doctor
+func (r *Router) Lobby() []*Connection {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conns := make([]*Connection, 0, len(r.doctors))
+	for _, d := range r.doctors {
+		conns = append(conns, d.conn)
+	}
+	return conns
+}
+
+// expire moves an assignment the doctor did not pick up in time to the next
+// doctor
+func (r *Router) expire(sessionID, doctorID string) {
+	r.mu.Lock()
+	route, exists := r.routes[sessionID]
+	if !exists || route.accepted || route.doctorID != doctorID {
+		r.mu.Unlock()
+		return
+	}
+
+	slog.Warn("assignment not picked up in time, reassigning", logging.SessionID(sessionID), logging.DoctorID(doctorID))
+	route.timer = nil
+	route.doctorID = ""
+	route.declined[doctorID] = struct{}{}
+
+	var out []outgoing
+	if d := r.doctors[doctorID]; d != nil {
+		delete(d.sessions, sessionID)
+		out = append(out, outgoing{d.conn, assignmentMessage(route, time.Time{}, true)})
+	}
+	out = append(out, r.assignWaiting()...)
+	r.mu.Unlock()
+
+	deliver(out)
+}
+
+// assignWaiting offers every waiting session to a doctor, most urgent first
+// and then oldest first. The caller must hold r.mu.
+func (r *Router) assignWaiting() []outgoing {
+	var waiting []*sessionRoute
+	for _, route := range r.routes {
+		if route.doctorID == "" && !route.accepted {
+			waiting = append(waiting, route)
+		}
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		if waiting[i].urgency != waiting[j].urgency {
+			return waiting[i].urgency > waiting[j].urgency
+		}
+		return waiting[i].queuedAt.Before(waiting[j].queuedAt)
+	})
+
+	var out []outgoing
+	for _, route := range waiting {
+		if d := r.pick(route); d != nil {
+			out = append(out, r.assign(route, d))
+		}
+	}
+	return out
+}
+
+// assign offers route to d and starts the accept timer. The caller must hold r.mu.
+func (r *Router) assign(route *sessionRoute, d *dutyDoctor) outgoing {
+	now := time.Now()
+	route.doctorID = d.id
+	d.sessions[route.sessionID] = struct{}{}
+	d.lastAssigned = now
+
+	sessionID, doctorID := route.sessionID, d.id
+	route.timer = time.AfterFunc(r.cfg.AcceptTimeout, func() {
+		r.expire(sessionID, doctorID)
+	})
+
+	slog.Info("session assigned", logging.SessionID(sessionID), logging.DoctorID(doctorID),
+		"department", route.department, "urgency", route.urgency.String(), "policy", r.cfg.Policy)
+	return outgoing{d.conn, assignmentMessage(route, now.Add(r.cfg.AcceptTimeout), false)}
+}
+
+// pick chooses the doctor for route under the configured policy. Doctors who
+// let it time out are skipped until every candidate has. The caller must hold r.mu.
+func (r *Router) pick(route *sessionRoute) *dutyDoctor {
+	candidates := r.candidates(route, true)
+	if len(candidates) == 0 {
+		candidates = r.candidates(route, false)
+		clear(route.declined)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	switch r.cfg.Policy {
+	case config.RoutingRoundRobin:
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].id < candidates[j].id
+		})
+		i := r.cursor[route.department] % len(candidates)
+		r.cursor[route.department] = i + 1
+		return candidates[i]
+
+	default:
+		sort.Slice(candidates, func(i, j int) bool {
+			if len(candidates[i].sessions) != len(candidates[j].sessions) {
+				return len(candidates[i].sessions) < len(candidates[j].sessions)
+			}
+			return candidates[i].lastAssigned.Before(candidates[j].lastAssigned)
+		})
+		return candidates[0]
+	}
+}
+
+// candidates returns the available doctors of route's department with room
+// for another session. Doctors who are away from their client are skipped.
+func (r *Router) candidates(route *sessionRoute, skipDeclined bool) []*dutyDoctor {
+	var out []*dutyDoctor
+	for _, d := range r.doctors {
+		if d.department != route.department || d.availability != pb.DoctorAvailability_AVAILABILITY_AVAILABLE || r.away(d.id) {
+			continue
+		}
+		if r.cfg.MaxSessionsPerDoctor > 0 && len(d.sessions) >= r.cfg.MaxSessionsPerDoctor {
+			continue
+		}
+		if _, declined := route.declined[d.id]; skipDeclined && declined {
+			continue
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// assignmentMessage builds the SESSION_ASSIGNMENT frame for route
+func assignmentMessage(route *sessionRoute, acceptBy time.Time, withdrawn bool) *pb.WebSocketMessage {
+	assignment := &pb.SessionAssignment{
+		SessionId:    route.sessionID,
+		DepartmentId: route.department,
+		Urgency:      route.urgency,
+		Withdrawn:    withdrawn,
+		FromDoctorId: route.handoffFrom,
+		HandoffNote:  route.handoffNote,
+	}
+	if !acceptBy.IsZero() {
+		assignment.AcceptBy = timestamppb.New(acceptBy)
+	}
+
+	return &pb.WebSocketMessage{
+		Type: pb.MessageType_SESSION_ASSIGNMENT,
+		Payload: &pb.WebSocketMessage_Assignment{
+			Assignment: assignment,
+		},
+	}
+}
+
+func deliver(out []outgoing) {
+	for _, o := range out {
+		if err := o.conn.send(o.msg); err != nil {
+			slog.Warn("failed to send assignment", logging.SessionID(o.msg.GetAssignment().GetSessionId()), logging.Err(err))
+		}
+	}
+}
+
+// goOnDuty authenticates a staff member's lobby connection and registers it
+// with the router under their department
+func (s *WebSocketServer) goOnDuty(ctx context.Context, conn *Connection, req sessionRequest) error {
+	if err := s.authenticateStaff(conn, req); err != nil {
+		return err
+	}
+
+	department, err := s.doctorDepartment(ctx, conn.doctorID)
+	if err != nil {
+		return err
+	}
+	return s.router.GoOnDuty(conn.doctorID, department, conn)
+}
+
+// doctorDepartment looks up the department a doctor takes sessions for
+func (s *WebSocketServer) doctorDepartment(ctx context.Context, doctorID string) (string, error) {
+	id, err := pg.ParseUUID(doctorID)
+	if err != nil {
+		return "", refuse(CloseBadRequest, "invalid doctor_id: %v", err)
+	}
+
+	doctor, err := s.dbq.GetDoctorByUserID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", refuse(CloseSessionNotFound, "doctor not found")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to look up doctor: %v", err)
+	}
+	return doctor.DepartmentID.String, nil
+}
+
+// sessionDepartment returns the department a session is routed to: the
+// patient's choice, then the triage rule's, then empty for the default
+func (s *WebSocketServer) sessionDepartment(sessionID string, result TriageResult) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if session, exists := s.sessions[sessionID]; exists && session.department != "" {
+		return session.department
+	}
+	return result.Department
+}
+
+// escalation describes where an overdue review goes
+type escalation struct {
+	department string
+	assignedID string      // Doctor the session is assigned to, empty if none
+	targetID   string      // Empty when nobody is available
+	target     *Connection // Lobby connection of the target
+}
+
+// escalationFor picks who an overdue review of sessionID goes to: an on-duty
+// supervisor of the session's department, then any on-duty supervisor, then
+// the least loaded available doctor of the department other than the one
+// assigned. Doctors who are away, by status or presence, are never picked.
+func (r *Router) escalationFor(sessionID string, supervisors map[string]struct{}) escalation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e := escalation{department: r.cfg.DefaultDepartment}
+	if route, exists := r.routes[sessionID]; exists {
+		if route.department != "" {
+			e.department = route.department
+		}
+		e.assignedID = route.doctorID
+	}
+
+	var best *dutyDoctor
+	bestRank := 0
+	for _, d := range r.doctors {
+		if d.id == e.assignedID || d.availability == pb.DoctorAvailability_AVAILABILITY_AWAY || r.away(d.id) {
+			continue
+		}
+
+		rank := 0
+		_, supervisor := supervisors[d.id]
+		supervisor = supervisor || d.conn.role == authz.RoleSupervisor
+		switch {
+		case supervisor && d.department == e.department:
+			rank = 3
+		case supervisor:
+			rank = 2
+		case d.department == e.department && d.availability == pb.DoctorAvailability_AVAILABILITY_AVAILABLE:
+			rank = 1
+		}
+		if rank == 0 {
+			continue
+		}
+		if rank > bestRank || (rank == bestRank && len(d.sessions) < len(best.sessions)) {
+			best, bestRank = d, rank
+		}
+	}
+
+	if best != nil {
+		e.targetID = best.id
+		e.target = best.conn
+	}
+	return e
+}
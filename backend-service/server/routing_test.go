@@ -0,0 +1,210 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	pb "llm-qa-system/backend-service/src/proto"
+
+	"github.com/gorilla/websocket"
+)
+
+// testConn returns the server end of a WebSocket speaking ProtocolV1, and
+// the client end reading what it is sent
+func testConn(t *testing.T) (*Connection, *websocket.Conn) {
+	t.Helper()
+	accepted := make(chan *websocket.Conn, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		accepted <- conn
+	}))
+	t.Cleanup(srv.Close)
+
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	conn := <-accepted
+	t.Cleanup(func() { conn.Close() })
+	return &Connection{conn: conn, wire: protocols[ProtocolV1]}, client
+}
+
+// readFrame returns the next message sent to a test client
+func readFrame(t *testing.T, client *websocket.Conn) *pb.WebSocketMessage {
+	t.Helper()
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, data, err := client.ReadMessage()
+	if err != nil {
+		t.Fatalf("no message received: %v", err)
+	}
+	var msg pb.WebSocketMessage
+	if err := protocols[ProtocolV1].unmarshal(data, &msg); err != nil {
+		t.Fatal(err)
+	}
+	return &msg
+}
+
+func TestRouterPick(t *testing.T) {
+	doctor := func(id, department string, sessions int, lastAssigned time.Duration) *dutyDoctor {
+		d := &dutyDoctor{
+			id:           id,
+			department:   department,
+			availability: pb.DoctorAvailability_AVAILABILITY_AVAILABLE,
+			sessions:     make(map[string]struct{}),
+			lastAssigned: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC).Add(lastAssigned),
+		}
+		for i := 0; i < sessions; i++ {
+			d.sessions[strings.Repeat("s", i+1)] = struct{}{}
+		}
+		return d
+	}
+
+	tests := []struct {
+		name     string
+		cfg      config.RoutingConfig
+		doctors  []*dutyDoctor
+		edit     func(*Router)
+		declined []string
+		want     []string // Doctor of each successive pick, "" for none
+	}{
+		{
+			name:    "least loaded",
+			doctors: []*dutyDoctor{doctor("a", "cardio", 2, 0), doctor("b", "cardio", 1, 0), doctor("c", "cardio", 3, 0)},
+			want:    []string{"b"},
+		},
+		{
+			name:    "least loaded tie goes to the longest idle",
+			doctors: []*dutyDoctor{doctor("a", "cardio", 1, time.Minute), doctor("b", "cardio", 1, -time.Minute)},
+			want:    []string{"b"},
+		},
+		{
+			name:    "round robin",
+			cfg:     config.RoutingConfig{Policy: config.RoutingRoundRobin},
+			doctors: []*dutyDoctor{doctor("c", "cardio", 0, 0), doctor("a", "cardio", 5, 0), doctor("b", "cardio", 0, 0)},
+			want:    []string{"a", "b", "c", "a"},
+		},
+		{
+			name:    "other department",
+			doctors: []*dutyDoctor{doctor("a", "neuro", 0, 0)},
+			want:    []string{""},
+		},
+		{
+			name:    "busy doctor",
+			doctors: []*dutyDoctor{doctor("a", "cardio", 0, 0), doctor("b", "cardio", 3, 0)},
+			edit: func(r *Router) {
+				r.doctors["a"].availability = pb.DoctorAvailability_AVAILABILITY_BUSY
+			},
+			want: []string{"b"},
+		},
+		{
+			name:    "away doctor",
+			doctors: []*dutyDoctor{doctor("a", "cardio", 0, 0), doctor("b", "cardio", 3, 0)},
+			edit: func(r *Router) {
+				r.presence["a"] = map[*Connection]pb.PresenceStatus{{}: pb.PresenceStatus_PRESENCE_AWAY}
+			},
+			want: []string{"b"},
+		},
+		{
+			name:    "full doctor",
+			cfg:     config.RoutingConfig{MaxSessionsPerDoctor: 2},
+			doctors: []*dutyDoctor{doctor("a", "cardio", 2, 0)},
+			want:    []string{""},
+		},
+		{
+			name:     "declined doctor skipped",
+			doctors:  []*dutyDoctor{doctor("a", "cardio", 0, 0), doctor("b", "cardio", 3, 0)},
+			declined: []string{"a"},
+			want:     []string{"b"},
+		},
+		{
+			name:     "every doctor declined",
+			doctors:  []*dutyDoctor{doctor("a", "cardio", 0, 0), doctor("b", "cardio", 3, 0)},
+			declined: []string{"a", "b"},
+			want:     []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRouter(tt.cfg)
+			for _, d := range tt.doctors {
+				r.doctors[d.id] = d
+			}
+			if tt.edit != nil {
+				tt.edit(r)
+			}
+			route := &sessionRoute{sessionID: "new", department: "cardio", declined: make(map[string]struct{})}
+			for _, id := range tt.declined {
+				route.declined[id] = struct{}{}
+			}
+
+			for i, want := range tt.want {
+				var got string
+				if d := r.pick(route); d != nil {
+					got = d.id
+				}
+				if got != want {
+					t.Fatalf("pick %d = %q, want %q", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRouterReassignsExpiredAssignment(t *testing.T) {
+	r := NewRouter(config.RoutingConfig{DefaultDepartment: "cardio", AcceptTimeout: 200 * time.Millisecond})
+	clients := make(map[string]*websocket.Conn)
+	for _, id := range []string{"a", "b"} {
+		conn, client := testConn(t)
+		clients[id] = client
+		if err := r.GoOnDuty(id, "cardio", conn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r.Route("s1", "", pb.UrgencyLevel_URGENCY_URGENT)
+	first, ok := r.AssignedDoctor("s1")
+	if !ok {
+		t.Fatal("session was not assigned")
+	}
+	second := map[string]string{"a": "b", "b": "a"}[first]
+	offer := readFrame(t, clients[first]).GetAssignment()
+	if offer.GetSessionId() != "s1" || offer.GetWithdrawn() || offer.GetAcceptBy() == nil || offer.GetUrgency() != pb.UrgencyLevel_URGENCY_URGENT {
+		t.Fatalf("first doctor was offered %v", offer)
+	}
+
+	// Not picked up in time: withdrawn from the first doctor, offered to the second
+	if withdrawn := readFrame(t, clients[first]).GetAssignment(); !withdrawn.GetWithdrawn() || withdrawn.GetSessionId() != "s1" {
+		t.Fatalf("first doctor received %v, want the assignment withdrawn", withdrawn)
+	}
+	if offer := readFrame(t, clients[second]).GetAssignment(); offer.GetSessionId() != "s1" || offer.GetWithdrawn() {
+		t.Fatalf("second doctor was offered %v", offer)
+	}
+	if got, _ := r.AssignedDoctor("s1"); got != second {
+		t.Fatalf("session assigned to %q, want %q", got, second)
+	}
+
+	// Once joined, the assignment no longer expires
+	r.Accept("s1", second)
+	time.Sleep(300 * time.Millisecond)
+	if got, _ := r.AssignedDoctor("s1"); got != second {
+		t.Fatalf("accepted session moved to %q", got)
+	}
+
+	// Closing it frees the doctor
+	r.Close("s1")
+	if _, ok := r.AssignedDoctor("s1"); ok {
+		t.Fatal("closed session is still assigned")
+	}
+	if n := len(r.doctors[second].sessions); n != 0 {
+		t.Fatalf("doctor still holds %d sessions", n)
+	}
+}
@@ -3,20 +3,28 @@ package server
 import (
 	"context"
 
+	"llm-qa-system/backend-service/audit"
 	db "llm-qa-system/backend-service/src/db"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type BaseServer struct {
-	db  *pgxpool.Pool
-	dbq *db.Queries
+	db         *pgxpool.Pool
+	dbq        *db.EncryptedQueries
+	auditLog   *audit.Log
+	auditQueue *audit.Queue
 }
 
-func NewBaseServer(pool *pgxpool.Pool) *BaseServer {
+// NewBaseServer creates the shared state of the servers. cipher encrypts
+// message content and clinical notes, nil stores them in plaintext.
+func NewBaseServer(pool *pgxpool.Pool, cipher db.FieldCipher) *BaseServer {
+	auditLog := audit.NewLog(pool)
 	return &BaseServer{
-		db:  pool,
-		dbq: db.New(pool),
+		db:         pool,
+		dbq:        db.NewEncrypted(db.New(pool), cipher),
+		auditLog:   auditLog,
+		auditQueue: audit.NewQueue(auditLog, auditQueueSize),
 	}
 }
 
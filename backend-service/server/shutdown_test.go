@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	"llm-qa-system/backend-service/config"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestNotifyShutdownKeepsHistoryOnlyWhenSaved(t *testing.T) {
+	saved, savedClient := testConn(t)
+	saved.sessionID = "saved"
+	anonymous, anonymousClient := testConn(t)
+	anonymous.sessionID = "anonymous"
+
+	s := &WebSocketServer{
+		sessions: map[string]*ChatSession{
+			"saved":     {sessionID: "saved", patientConn: saved, chatID: pgtype.UUID{Valid: true}},
+			"anonymous": {sessionID: "anonymous", patientConn: anonymous},
+		},
+		router: NewRouter(config.RoutingConfig{}),
+	}
+	s.NotifyShutdown(ShutdownNotice, ShutdownNoticePersisted)
+
+	if got := readFrame(t, savedClient).GetMessage().GetContent(); got != ShutdownNoticePersisted {
+		t.Errorf("saved session notice = %q, want %q", got, ShutdownNoticePersisted)
+	}
+	if got := readFrame(t, anonymousClient).GetMessage().GetContent(); got != ShutdownNotice {
+		t.Errorf("anonymous session notice = %q, want %q", got, ShutdownNotice)
+	}
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"llm-qa-system/backend-service/logging"
+	db "llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// runSLAScheduler escalates drafts that miss their review deadline until ctx
+// is cancelled
+func (s *WebSocketServer) runSLAScheduler(ctx context.Context) {
+	defer close(s.schedulerDone)
+
+	ticker := time.NewTicker(s.sla.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, pending := range s.queue.TakeOverdue(now) {
+				s.escalateReview(ctx, pending)
+			}
+		}
+	}
+}
+
+// escalateReview alerts the escalation target and the session's doctor about
+// an overdue draft, then publishes and records the SLA breach
+func (s *WebSocketServer) escalateReview(ctx context.Context, pending *PendingDraft) {
+	e := s.router.escalationFor(pending.SessionID, s.supervisors)
+
+	event := &pb.ReviewEscalation{
+		SessionId:        pending.SessionID,
+		MessageId:        pending.Draft.MessageId,
+		Urgency:          pending.Draft.Urgency,
+		QueuedAt:         timestamppb.New(pending.QueuedAt),
+		DueAt:            timestamppb.New(pending.DueAt),
+		DepartmentId:     e.department,
+		AssignedDoctorId: e.assignedID,
+		EscalatedTo:      e.targetID,
+	}
+
+	slog.Warn("review overdue, escalating", logging.SessionID(pending.SessionID), logging.MessageID(pending.Draft.MessageId),
+		"urgency", pending.Draft.Urgency.String(), "overdue_by", time.Since(pending.DueAt).Round(time.Second), "escalated_to", e.targetID)
+
+	if e.target != nil {
+		msg := &pb.WebSocketMessage{
+			Type: pb.MessageType_REVIEW_ESCALATION,
+			Payload: &pb.WebSocketMessage_Escalation{
+				Escalation: event,
+			},
+		}
+		if err := e.target.send(msg); err != nil {
+			slog.Warn("failed to send escalation", logging.SessionID(pending.SessionID), logging.DoctorID(e.targetID), logging.Err(err))
+		}
+	} else {
+		slog.Error("no doctor available to escalate overdue review to", logging.SessionID(pending.SessionID), "department", e.department)
+	}
+
+	s.broadcastToRole(pending.SessionID, "doctor", newSystemMessage(fmt.Sprintf(
+		"Review overdue: the %s draft %s has waited past its deadline and has been escalated",
+		strings.ToLower(urgencyLabel(pending.Draft.Urgency)), pending.Draft.MessageId)))
+
+	s.publishSLABreach(ctx, event)
+	s.recordSLABreach(ctx, pending, e.targetID)
+}
+
+// publishSLABreach writes the breach to the SLA breach topic
+func (s *WebSocketServer) publishSLABreach(ctx context.Context, event *pb.ReviewEscalation) {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		slog.Error("failed to marshal sla breach", logging.SessionID(event.SessionId), logging.Err(err))
+		return
+	}
+
+	err = s.slaWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.SessionId),
+		Value: payload,
+	})
+	if err != nil {
+		slog.Error("failed to publish sla breach", logging.SessionID(event.SessionId), logging.MessageID(event.MessageId), logging.Err(err))
+	}
+}
+
+// recordSLABreach stores the breach on the draft's ai_interactions row
+func (s *WebSocketServer) recordSLABreach(ctx context.Context, pending *PendingDraft, escalatedTo string) {
+	messageID, err := pg.ParseUUID(pending.Draft.MessageId)
+	if err != nil {
+		slog.Warn("draft id is not a UUID, sla breach not recorded", logging.MessageID(pending.Draft.MessageId))
+		return
+	}
+	// Left NULL if the target is not a doctor ID
+	target, _ := pg.ParseUUID(escalatedTo)
+
+	rows, err := s.dbq.RecordAIInteractionSLABreach(ctx, db.RecordAIInteractionSLABreachParams{
+		ChatMessageID: messageID,
+		ReviewDueAt:   pgtype.Timestamptz{Time: pending.DueAt, Valid: true},
+		SlaBreachedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		EscalatedTo:   target,
+	})
+	if err != nil {
+		slog.Error("failed to record sla breach", logging.MessageID(pending.Draft.MessageId), logging.Err(err))
+		return
+	}
+	if rows == 0 {
+		slog.Debug("no ai_interactions row for draft, sla breach only published", logging.MessageID(pending.Draft.MessageId))
+	}
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Chat message types, see ref_chat_message_type
+const (
+	MessageTypePatient         = "PATIENT_MESSAGE"
+	MessageTypeDoctor          = "DOCTOR_MESSAGE"
+	MessageTypeSystem          = "SYSTEM_MESSAGE"
+	MessageTypeAIDraft         = "AI_DRAFT"
+	MessageTypeAIDraftApproved = "AI_DRAFT_APPROVED"
+	MessageTypeAIDraftModified = "AI_DRAFT_MODIFIED"
+)
+
+// Chat session statuses, see ref_chat_session_status
+const (
+	ChatSessionStatusOpen   = "CHAT_SESSION_STATUS_OPEN"
+	ChatSessionStatusClosed = "CHAT_SESSION_STATUS_CLOSED"
+)
+
+// SystemUserID is the sender of SYSTEM messages in transcripts
+var SystemUserID = pgtype.UUID{Valid: true}
+
+const transcriptTimeout = 5 * time.Second
+
+// openChatSession creates the chat_sessions row of a patient's new session
+func (s *WebSocketServer) openChatSession(ctx context.Context, patientID string) (db.ChatSession, error) {
+	id, err := pg.ParseUUID(patientID)
+	if err != nil {
+		return db.ChatSession{}, refuse(CloseBadRequest, "invalid patient_id: %v", err)
+	}
+
+	chat, err := s.dbq.CreateChatSession(ctx, id)
+	if err != nil {
+		return db.ChatSession{}, fmt.Errorf("failed to create chat session: %v", err)
+	}
+	return chat, nil
+}
+
+// closeChatSession marks a persisted session closed
+func (s *WebSocketServer) closeChatSession(session *ChatSession) {
+	if !session.chatID.Valid {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transcriptTimeout)
+	defer cancel()
+
+	err := s.dbq.UpdateChatSessionStatus(ctx, db.UpdateChatSessionStatusParams{
+		ID:     session.chatID,
+		Status: ChatSessionStatusClosed,
+	})
+	if err != nil {
+		slog.Error("failed to close chat session", logging.SessionID(session.sessionID), logging.Err(err))
+	}
+}
+
+// transcriptID returns the chat_sessions row a message of senderID is
+// recorded under, invalid when it is not recorded. Sessions opened without a
+// patient ID are not persisted.
+func (s *WebSocketServer) transcriptID(sessionID string, senderID pgtype.UUID, messageType string) pgtype.UUID {
+	s.mu.RLock()
+	session, exists := s.sessions[sessionID]
+	var chatID pgtype.UUID
+	if exists {
+		chatID = session.chatID
+	}
+	s.mu.RUnlock()
+
+	if chatID.Valid && !senderID.Valid {
+		slog.Warn("message sender unknown, not recorded in transcript", logging.SessionID(sessionID), "message_type", messageType)
+		return pgtype.UUID{}
+	}
+	return chatID
+}
+
+// recordMessage appends a message to the session's transcript
+func (s *WebSocketServer) recordMessage(sessionID string, senderID pgtype.UUID, messageType, content string) {
+	chatID := s.transcriptID(sessionID, senderID, messageType)
+	if !chatID.Valid {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transcriptTimeout)
+	defer cancel()
+
+	_, err := s.dbq.CreateChatMessage(ctx, db.CreateChatMessageParams{
+		ChatSessionID: chatID,
+		SenderID:      senderID,
+		Content:       content,
+		MessageType:   messageType,
+	})
+	if err != nil {
+		slog.Error("failed to record chat message", logging.SessionID(sessionID), "message_type", messageType, logging.Err(err))
+	}
+}
+
+// recordTrackedMessage appends a message between patient and doctor to the
+// session's transcript under its ID, with a queued delivery
+func (s *WebSocketServer) recordTrackedMessage(sessionID string, m *trackedMessage, senderID pgtype.UUID, messageType, content string) {
+	chatID := s.transcriptID(sessionID, senderID, messageType)
+	if !chatID.Valid {
+		return
+	}
+	id, err := pg.ParseUUID(m.id)
+	if err != nil {
+		slog.Error("invalid message ID", logging.SessionID(sessionID), logging.MessageID(m.id), logging.Err(err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transcriptTimeout)
+	defer cancel()
+
+	err = s.dbq.CreateTrackedMessage(ctx, db.CreateTrackedMessageParams{
+		ID:            id,
+		ChatSessionID: chatID,
+		SenderID:      senderID,
+		Content:       content,
+		MessageType:   messageType,
+		RecipientRole: m.recipient,
+	})
+	if err != nil {
+		slog.Error("failed to record chat message", logging.SessionID(sessionID), logging.MessageID(m.id), "message_type", messageType, logging.Err(err))
+	}
+}
+
+// recordSystemMessage appends a SYSTEM message to the session's transcript
+func (s *WebSocketServer) recordSystemMessage(sessionID, content string) {
+	s.recordMessage(sessionID, SystemUserID, MessageTypeSystem, content)
+}
+
+// reviewStatuses maps review actions to ai_interactions.review_status
+var reviewStatuses = map[pb.ReviewAction]string{
+	pb.ReviewAction_ACCEPT: db.ReviewApproved,
+	pb.ReviewAction_MODIFY: db.ReviewModified,
+	pb.ReviewAction_REJECT: db.ReviewRejected,
+}
+
+// takeDraft removes a draft from the review queue for conn to review. Only
+// the doctor of the draft's session may review it, once.
+func (s *WebSocketServer) takeDraft(conn *Connection, messageID string) (*PendingDraft, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	session, exists := s.sessions[conn.sessionID]
+	if !exists || session.doctorConn != conn {
+		return nil, fmt.Errorf("only the session's doctor may review its drafts")
+	}
+	pending, ok := s.queue.Remove(messageID, conn.sessionID)
+	if !ok {
+		return nil, fmt.Errorf("draft %s is not awaiting review in this session", messageID)
+	}
+	return pending, nil
+}
+
+// recordReview stores a doctor's review on the draft's ai_interactions row.
+// Drafts of sessions that are not persisted have none.
+func (s *WebSocketServer) recordReview(conn *Connection, review *pb.DraftReview) {
+	reviewStatus, ok := reviewStatuses[review.Action]
+	if !ok {
+		return
+	}
+	id, err := pg.ParseUUID(review.MessageId)
+	if err != nil {
+		return
+	}
+	s.mu.RLock()
+	var chatID pgtype.UUID
+	if session, exists := s.sessions[conn.sessionID]; exists {
+		chatID = session.chatID
+	}
+	s.mu.RUnlock()
+	if !chatID.Valid {
+		return
+	}
+
+	params := db.UpdateAIInteractionReviewParams{
+		ChatMessageID: id,
+		ReviewStatus:  pgtype.Text{String: reviewStatus, Valid: true},
+		ReviewedBy:    s.senderID(conn),
+		ChatSessionID: chatID,
+	}
+	if review.Action == pb.ReviewAction_MODIFY {
+		params.ModifiedContent = pgtype.Text{String: review.Content, Valid: true}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transcriptTimeout)
+	defer cancel()
+
+	n, err := s.dbq.UpdateAIInteractionReview(ctx, params)
+	if err != nil {
+		slog.Error("failed to record draft review", logging.SessionID(conn.sessionID), logging.MessageID(review.MessageId), logging.Err(err))
+		return
+	}
+	if n == 0 {
+		slog.Warn("draft review matched no draft of the session", logging.SessionID(conn.sessionID), logging.MessageID(review.MessageId))
+	}
+}
+
+// senderID returns the user ID a connection's messages are recorded under
+func (s *WebSocketServer) senderID(conn *Connection) pgtype.UUID {
+	if conn.role == authz.RolePatient {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		if session, exists := s.sessions[conn.sessionID]; exists {
+			return session.patientID
+		}
+		return pgtype.UUID{}
+	}
+
+	id, _ := pg.ParseUUID(conn.doctorID)
+	return id
+}
@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+
+	"llm-qa-system/backend-service/logging"
+	pb "llm-qa-system/backend-service/src/proto"
+)
+
+// Safety-net messages sent to the patient as soon as a rule matches
+const (
+	SafetyNetEmergency = "Your message may describe a medical emergency. If you have chest pain, trouble breathing, " +
+		"face drooping, arm weakness or slurred speech, call 911 (or your local emergency number) now. " +
+		"A doctor has been alerted, but do not wait for a reply."
+	SafetyNetCrisis = "If you are thinking about harming yourself, please call or text 988 (Suicide & Crisis Lifeline) " +
+		"or call 911 now. You are not alone, and a doctor has been alerted."
+	SafetyNetUrgent = "Your message has been marked urgent and moved to the front of the doctor's queue. " +
+		"If your symptoms get worse, call 911 (or your local emergency number)."
+)
+
+// TriageRule flags a message as urgent when any of its patterns match
+type TriageRule struct {
+	Name          string // Reported as the triage reason, never contains patient text
+	Urgency       pb.UrgencyLevel
+	Patterns      []*regexp.Regexp
+	SafetyMessage string
+	Department    string // Routes the session to this department, empty keeps the default
+}
+
+// TriageResult is the combined outcome of the rule engine and the LLM triage
+type TriageResult struct {
+	Urgency       pb.UrgencyLevel
+	Category      string
+	Reasons       []string
+	SafetyMessage string // Empty when no safety-net message is needed
+	Department    string // Empty when no rule asks for a department
+}
+
+// IsUrgent reports whether the message needs to jump the review queue
+func (r TriageResult) IsUrgent() bool {
+	return r.Urgency >= pb.UrgencyLevel_URGENCY_URGENT
+}
+
+func patterns(exprs ...string) []*regexp.Regexp {
+	out := make([]*regexp.Regexp, 0, len(exprs))
+	for _, expr := range exprs {
+		out = append(out, regexp.MustCompile(`(?i)`+expr))
+	}
+	return out
+}
+
+// DefaultTriageRules covers the presentations that must never wait for review
+var DefaultTriageRules = []TriageRule{
+	{
+		Name:    "chest pain",
+		Urgency: pb.UrgencyLevel_URGENCY_EMERGENCY,
+		Patterns: patterns(
+			`chest (pain|pressure|tightness|hurts?)`,
+			`pain in (my )?chest`,
+			`heart attack`,
+			`crushing (pain|feeling)`,
+			`pain (spreading|radiating) (to|down) (my )?(left )?(arm|jaw)`,
+		),
+		SafetyMessage: SafetyNetEmergency,
+		Department:    "DEPT_CARDIOLOGY",
+	},
+	{
+		Name:    "stroke signs",
+		Urgency: pb.UrgencyLevel_URGENCY_EMERGENCY,
+		Patterns: patterns(
+			`\bstroke\b`,
+			`face (is )?(drooping|droops|numb)`,
+			`(drooping|droopy) (face|mouth|smile)`,
+			`slurr(ed|ing) (speech|words)`,
+			`can'?t (speak|talk) (properly|clearly)`,
+			`(sudden )?(weakness|numbness) (in|on) (one|my left|my right) (side|arm|leg)`,
+			`(arm|leg) (went|is|feels) (numb|weak|dead)`,
+		),
+		SafetyMessage: SafetyNetEmergency,
+	},
+	{
+		Name:    "suicidal ideation",
+		Urgency: pb.UrgencyLevel_URGENCY_EMERGENCY,
+		Patterns: patterns(
+			`suicid`,
+			`kill(ing)? myself`,
+			`end (it all|my life)`,
+			`(want|wish) (to|i could) die`,
+			`better off dead`,
+			`hurt(ing)? myself`,
+			`self[- ]harm`,
+		),
+		SafetyMessage: SafetyNetCrisis,
+	},
+	{
+		Name:    "breathing difficulty",
+		Urgency: pb.UrgencyLevel_URGENCY_EMERGENCY,
+		Patterns: patterns(
+			`can'?t breathe`,
+			`(trouble|difficulty|hard time) breathing`,
+			`short(ness)? of breath`,
+			`choking`,
+		),
+		SafetyMessage: SafetyNetEmergency,
+	},
+	{
+		Name:    "severe bleeding or injury",
+		Urgency: pb.UrgencyLevel_URGENCY_URGENT,
+		Patterns: patterns(
+			`(won'?t|will not|can'?t) stop bleeding`,
+			`bleeding (heavily|a lot)`,
+			`passed out|fainted|unconscious`,
+			`overdose`,
+		),
+		SafetyMessage: SafetyNetUrgent,
+	},
+}
+
+// TriageEngine classifies patient messages with local rules
+type TriageEngine struct {
+	rules []TriageRule
+}
+
+// NewTriageEngine creates an engine with the given rules
+func NewTriageEngine(rules []TriageRule) *TriageEngine {
+	return &TriageEngine{rules: rules}
+}
+
+// Evaluate runs every rule against text and keeps the highest urgency. Its
+// safety message and department belong to the most urgent rule that matched.
+func (e *TriageEngine) Evaluate(text string) TriageResult {
+	result := TriageResult{Urgency: pb.UrgencyLevel_URGENCY_ROUTINE}
+
+	for _, rule := range e.rules {
+		if !rule.matches(text) {
+			continue
+		}
+		result.Reasons = append(result.Reasons, rule.Name)
+		if rule.Urgency > result.Urgency {
+			result.Urgency = rule.Urgency
+			result.SafetyMessage = rule.SafetyMessage
+			result.Department = rule.Department
+		}
+	}
+
+	return result
+}
+
+func (r TriageRule) matches(text string) bool {
+	for _, p := range r.Patterns {
+		if p.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// Triage combines the local rules with the LLM service's TriageQuestion RPC.
+// The rules always win on urgency; the RPC can only raise it. If the RPC
+// fails the rule result is used on its own so drafts are never held back.
+func (c *LLMClient) Triage(ctx context.Context, sessionID string, message string) TriageResult {
+	result := c.triage.Evaluate(message)
+
+	ctx, cancel := context.WithTimeout(ctx, c.requestTimeout)
+	defer cancel()
+
+	resp, err := c.client.TriageQuestion(ctx, &pb.TriageRequest{
+		QuestionId:   &pb.UUID{Value: []byte(sessionID)},
+		QuestionText: message,
+	})
+	if err != nil {
+		slog.Warn("LLM triage failed, using rule engine only", logging.SessionID(sessionID), logging.Err(err))
+		return result
+	}
+
+	result.Category = resp.Category
+	if resp.Urgency > result.Urgency {
+		result.Urgency = resp.Urgency
+	}
+	result.Reasons = append(result.Reasons, resp.Reasons...)
+
+	slog.Info("message triaged", logging.SessionID(sessionID), "urgency", result.Urgency.String(), "category", result.Category)
+	return result
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	pb "llm-qa-system/backend-service/src/proto"
+)
+
+func TestTriageEvaluate(t *testing.T) {
+	engine := NewTriageEngine(DefaultTriageRules)
+	tests := []struct {
+		name        string
+		text        string
+		wantUrgency pb.UrgencyLevel
+		wantReasons []string
+		wantSafety  string
+		wantDept    string
+	}{
+		{"routine", "I have had a mild headache since yesterday", pb.UrgencyLevel_URGENCY_ROUTINE, nil, "", ""},
+		{"chest pain", "I have chest pain when I climb stairs", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"chest pain"}, SafetyNetEmergency, "DEPT_CARDIOLOGY"},
+		{"radiating pain", "sharp pain radiating down my left arm", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"chest pain"}, SafetyNetEmergency, "DEPT_CARDIOLOGY"},
+		{"stroke signs", "My dad's face is drooping and he has slurred speech", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"stroke signs"}, SafetyNetEmergency, ""},
+		{"suicidal ideation", "sometimes I think everyone would be better off dead without me", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"suicidal ideation"}, SafetyNetCrisis, ""},
+		{"self-harm", "I keep thinking about self-harm", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"suicidal ideation"}, SafetyNetCrisis, ""},
+		{"urgent only", "I fainted this morning", pb.UrgencyLevel_URGENCY_URGENT, []string{"severe bleeding or injury"}, SafetyNetUrgent, ""},
+		{"upper case", "CHEST PAIN AND I CAN'T BREATHE", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"chest pain", "breathing difficulty"}, SafetyNetEmergency, "DEPT_CARDIOLOGY"},
+		{"mixed case", "Crushing Pain in my Chest", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"chest pain"}, SafetyNetEmergency, "DEPT_CARDIOLOGY"},
+		{"apostrophe left out", "i cant breathe", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"breathing difficulty"}, SafetyNetEmergency, ""},
+		{"most urgent rule decides", "I passed out and now I want to die", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"suicidal ideation", "severe bleeding or injury"}, SafetyNetCrisis, ""},
+		// The safety net errs on the side of alerting: a negated symptom is
+		// still flagged, a doctor sorts it out on review
+		{"negated chest pain", "no chest pain, just a cough", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"chest pain"}, SafetyNetEmergency, "DEPT_CARDIOLOGY"},
+		{"negated suicidal thoughts", "I don't want to die, I just feel tired", pb.UrgencyLevel_URGENCY_EMERGENCY, []string{"suicidal ideation"}, SafetyNetCrisis, ""},
+		{"related words", "my chest X-ray was clear and my heart rate is normal", pb.UrgencyLevel_URGENCY_ROUTINE, nil, "", ""},
+		{"word containing stroke", "I had a heatstroke last summer", pb.UrgencyLevel_URGENCY_ROUTINE, nil, "", ""},
+		{"empty", "", pb.UrgencyLevel_URGENCY_ROUTINE, nil, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := engine.Evaluate(tt.text)
+			if got.Urgency != tt.wantUrgency {
+				t.Fatalf("Evaluate(%q) urgency = %v, want %v", tt.text, got.Urgency, tt.wantUrgency)
+			}
+			if !reflect.DeepEqual(got.Reasons, tt.wantReasons) {
+				t.Fatalf("Evaluate(%q) reasons = %q, want %q", tt.text, got.Reasons, tt.wantReasons)
+			}
+			if got.SafetyMessage != tt.wantSafety || got.Department != tt.wantDept {
+				t.Fatalf("Evaluate(%q) = safety %q, department %q, want %q, %q", tt.text, got.SafetyMessage, got.Department, tt.wantSafety, tt.wantDept)
+			}
+			if got.IsUrgent() != (tt.wantUrgency >= pb.UrgencyLevel_URGENCY_URGENT) {
+				t.Fatalf("Evaluate(%q).IsUrgent() = %v", tt.text, got.IsUrgent())
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var alertUrgencies = map[string]pb.UrgencyLevel{
+	config.UrgencyRoutine:   pb.UrgencyLevel_URGENCY_ROUTINE,
+	config.UrgencyUrgent:    pb.UrgencyLevel_URGENCY_URGENT,
+	config.UrgencyEmergency: pb.UrgencyLevel_URGENCY_EMERGENCY,
+}
+
+// VitalsMonitor evaluates the vitals rules against newly stored readings. A
+// rule fires at most once per patient within the cooldown.
+type VitalsMonitor struct {
+	rules    []config.VitalRule
+	cooldown time.Duration
+	mu       sync.Mutex
+	fired    map[string]time.Time // keyed by patient ID and rule name
+}
+
+func NewVitalsMonitor(cfg config.VitalsConfig) *VitalsMonitor {
+	return &VitalsMonitor{
+		rules:    cfg.Rules,
+		cooldown: cfg.Cooldown,
+		fired:    make(map[string]time.Time),
+	}
+}
+
+// Evaluate returns the alerts raised by readings just stored for patientID.
+// units maps biometric type IDs to their unit, for readings loaded from the
+// database by trend rules.
+func (m *VitalsMonitor) Evaluate(ctx context.Context, q *db.EncryptedQueries, patientID pgtype.UUID, readings []*pb.BiometricReading, units map[string]string) ([]*pb.VitalAlert, error) {
+	var alerts []*pb.VitalAlert
+	for _, rule := range m.rules {
+		var matching []*pb.BiometricReading
+		for _, r := range readings {
+			if r.TypeId == rule.TypeID {
+				matching = append(matching, r)
+			}
+		}
+		if len(matching) == 0 {
+			continue
+		}
+
+		var alert *pb.VitalAlert
+		if rule.Rise > 0 {
+			var err error
+			if alert, err = evaluateTrend(ctx, q, rule, patientID, matching, units[rule.TypeID]); err != nil {
+				return alerts, err
+			}
+		} else {
+			alert = evaluateThreshold(rule, matching)
+		}
+		if alert == nil || !m.allow(patientID, rule.Name) {
+			continue
+		}
+
+		alert.Rule = rule.Name
+		alert.TypeId = rule.TypeID
+		alert.Urgency = alertUrgencies[rule.Urgency]
+		alert.TriggeredAt = timestamppb.Now()
+		alerts = append(alerts, alert)
+	}
+	return alerts, nil
+}
+
+// allow reports whether a rule may fire for the patient, and if so starts
+// its cooldown
+func (m *VitalsMonitor) allow(patientID pgtype.UUID, rule string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := pg.ToUUID(patientID).String() + "/" + rule
+	now := time.Now()
+	if last, ok := m.fired[key]; ok && now.Sub(last) < m.cooldown {
+		return false
+	}
+	m.fired[key] = now
+
+	// Forget expired cooldowns so the map does not grow without bound
+	for k, t := range m.fired {
+		if now.Sub(t) >= m.cooldown {
+			delete(m.fired, k)
+		}
+	}
+	return true
+}
+
+// evaluateThreshold returns an alert listing the readings outside the
+// rule's bounds, or nil if there are none
+func evaluateThreshold(rule config.VitalRule, readings []*pb.BiometricReading) *pb.VitalAlert {
+	var breaches []*pb.BiometricReading
+	var reasons []string
+	for _, r := range readings {
+		var reason string
+		switch {
+		case rule.Above > 0 && r.Value > rule.Above:
+			reason = fmt.Sprintf("%s above %s", formatReading(r), formatValue(rule.Above))
+		case rule.Below > 0 && r.Value < rule.Below:
+			reason = fmt.Sprintf("%s below %s", formatReading(r), formatValue(rule.Below))
+		case rule.SecondaryAbove > 0 && r.SecondaryValue > rule.SecondaryAbove:
+			reason = fmt.Sprintf("%s, diastolic above %s", formatReading(r), formatValue(rule.SecondaryAbove))
+		case rule.SecondaryBelow > 0 && r.SecondaryValue < rule.SecondaryBelow:
+			reason = fmt.Sprintf("%s, diastolic below %s", formatReading(r), formatValue(rule.SecondaryBelow))
+		default:
+			continue
+		}
+		breaches = append(breaches, r)
+		reasons = append(reasons, reason)
+	}
+	if len(breaches) == 0 {
+		return nil
+	}
+
+	return &pb.VitalAlert{
+		Description: fmt.Sprintf("%s: %s", rule.TypeID, strings.Join(reasons, "; ")),
+		Readings:    breaches,
+	}
+}
+
+// evaluateTrend checks the readings of the rule's window ending at the newest
+// of readings. It fires when they climb by at least Rise without any reading
+// dipping below the first one.
+func evaluateTrend(ctx context.Context, q *db.EncryptedQueries, rule config.VitalRule, patientID pgtype.UUID, readings []*pb.BiometricReading, unit string) (*pb.VitalAlert, error) {
+	latest := readings[0].MeasuredAt.AsTime()
+	for _, r := range readings[1:] {
+		if t := r.MeasuredAt.AsTime(); t.After(latest) {
+			latest = t
+		}
+	}
+
+	rows, err := q.ListRecentBiometrics(ctx, db.ListRecentBiometricsParams{
+		PatientID:  patientID,
+		TypeID:     rule.TypeID,
+		MeasuredAt: pgtype.Timestamptz{Time: latest.Add(-rule.Window), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load recent %s readings: %v", rule.TypeID, err)
+	}
+
+	var window []*pb.BiometricReading
+	for _, row := range rows {
+		if row.MeasuredAt.Time.After(latest) {
+			break
+		}
+		value, err := row.Value.Float64Value()
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", rule.TypeID, err)
+		}
+		window = append(window, &pb.BiometricReading{
+			TypeId:     rule.TypeID,
+			Value:      value.Float64,
+			Unit:       unit,
+			MeasuredAt: timestamppb.New(row.MeasuredAt.Time),
+		})
+	}
+	if len(window) < rule.MinReadings {
+		return nil, nil
+	}
+
+	first, last := window[0], window[len(window)-1]
+	if last.Value-first.Value < rule.Rise {
+		return nil, nil
+	}
+	for _, r := range window[1:] {
+		if r.Value < first.Value {
+			return nil, nil
+		}
+	}
+
+	return &pb.VitalAlert{
+		Description: fmt.Sprintf("%s rose from %s to %s over %d readings in %s",
+			rule.TypeID, formatReading(first), formatReading(last), len(window), last.MeasuredAt.AsTime().Sub(first.MeasuredAt.AsTime()).Round(time.Minute)),
+		Readings: window,
+	}, nil
+}
+
+// formatReading renders a reading as "120/80 mmHg" or "92 %"
+func formatReading(r *pb.BiometricReading) string {
+	text := formatValue(r.Value)
+	if r.TypeId == BloodPressureType {
+		text += "/" + formatValue(r.SecondaryValue)
+	}
+	return text + " " + r.Unit
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// raiseVitalAlert attaches an alert to the patient's live session, opening a
+// session if the patient has none, records it in the transcript, sends it to
+// the session's doctor and routes the session with the alert's urgency
+func (s *WebSocketServer) raiseVitalAlert(ctx context.Context, patientID pgtype.UUID, alert *pb.VitalAlert) error {
+	session, err := s.alertSession(ctx, patientID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	alert.SessionId = session.sessionID
+	session.alerts = append(session.alerts, alert)
+	department := session.department
+	if session.alertOnly && session.resumeTimer != nil {
+		// A new alert gives doctors as long to pick it up as the first
+		session.resumeTimer.Reset(s.sessionCfg.AlertTTL)
+	}
+	s.mu.Unlock()
+
+	slog.Warn("vital alert raised", logging.SessionID(session.sessionID), "rule", alert.Rule, "urgency", alert.Urgency.String())
+	s.recordSystemMessage(session.sessionID, fmt.Sprintf("%s vital alert (%s): %s", urgencyLabel(alert.Urgency), alert.Rule, alert.Description))
+	s.broadcastToRole(session.sessionID, "doctor", vitalAlertMessage(alert))
+	s.router.Route(session.sessionID, department, alert.Urgency)
+	return nil
+}
+
+// alertSession returns the patient's live session, or opens a persisted one
+// that closes once its doctor leaves, or after session.alert_ttl if neither a
+// doctor nor the patient joins it
+func (s *WebSocketServer) alertSession(ctx context.Context, patientID pgtype.UUID) (*ChatSession, error) {
+	if session := s.patientSession(patientID); session != nil {
+		return session, nil
+	}
+
+	chat, err := s.dbq.CreateChatSession(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat session: %v", err)
+	}
+	session := &ChatSession{
+		sessionID: pg.ToUUID(chat.ID).String(),
+		chatID:    chat.ID,
+		patientID: chat.PatientID,
+		alertOnly: true,
+		created:   time.Now(),
+	}
+
+	s.mu.Lock()
+	for _, existing := range s.sessions {
+		if existing.patientID == patientID {
+			// Opened concurrently by another alert
+			s.mu.Unlock()
+			s.closeChatSession(session)
+			return existing, nil
+		}
+	}
+	s.sessions[session.sessionID] = session
+	session.resumeTimer = time.AfterFunc(s.sessionCfg.AlertTTL, func() { s.expireSession(session) })
+	s.mu.Unlock()
+
+	slog.Info("session opened for vital alert", logging.SessionID(session.sessionID))
+	return session, nil
+}
+
+// resumeAlertSession connects a patient to the alert session opened for them
+// while they were away, reporting whether there was one. patientID must come
+// from a verified patient token; an anonymous patient resumes nothing.
+// Messages the doctor sent in the meantime are retransmitted once the patient
+// is greeted.
+func (s *WebSocketServer) resumeAlertSession(conn *Connection, patientID string) bool {
+	if patientID == "" {
+		return false
+	}
+	id, err := pg.ParseUUID(patientID)
+	if err != nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, session := range s.sessions {
+		if session.patientID == id && session.alertOnly && session.patientConn == nil {
+			if session.resumeTimer != nil {
+				session.resumeTimer.Stop()
+				session.resumeTimer = nil
+			}
+			session.patientConn = conn
+			session.alertOnly = false
+			conn.sessionID = session.sessionID
+			slog.Info("patient joined alert session", logging.SessionID(session.sessionID))
+			return true
+		}
+	}
+	return false
+}
+
+// patientSession returns the live session of a patient, if any
+func (s *WebSocketServer) patientSession(patientID pgtype.UUID) *ChatSession {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, session := range s.sessions {
+		if session.patientID == patientID {
+			return session
+		}
+	}
+	return nil
+}
+
+// vitalAlertMessage builds the VITAL_ALERT frame for alert
+func vitalAlertMessage(alert *pb.VitalAlert) *pb.WebSocketMessage {
+	return &pb.WebSocketMessage{
+		Type: pb.MessageType_VITAL_ALERT,
+		Payload: &pb.WebSocketMessage_VitalAlert{
+			VitalAlert: alert,
+		},
+	}
+}
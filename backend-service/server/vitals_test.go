@@ -0,0 +1,298 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/src/db"
+	pb "llm-qa-system/backend-service/src/proto"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var vitalsStart = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func reading(typeID string, value, secondary float64, minutes int) *pb.BiometricReading {
+	unit := map[string]string{"HEART_RATE": "bpm", BloodPressureType: "mmHg", "OXYGEN_SATURATION": "%"}[typeID]
+	return &pb.BiometricReading{
+		TypeId:         typeID,
+		Value:          value,
+		SecondaryValue: secondary,
+		Unit:           unit,
+		MeasuredAt:     timestamppb.New(vitalsStart.Add(time.Duration(minutes) * time.Minute)),
+	}
+}
+
+func TestEvaluateThreshold(t *testing.T) {
+	heartRate := config.VitalRule{Name: "tachycardia", TypeID: "HEART_RATE", Above: 120, Below: 40}
+	bloodPressure := config.VitalRule{Name: "hypertensive crisis", TypeID: BloodPressureType, Above: 180, SecondaryAbove: 120}
+	tests := []struct {
+		name     string
+		rule     config.VitalRule
+		readings []*pb.BiometricReading
+		want     string // Alert description, "" for none
+		wantN    int    // Readings listed in the alert
+	}{
+		{"within bounds", heartRate, []*pb.BiometricReading{reading("HEART_RATE", 80, 0, 0), reading("HEART_RATE", 120, 0, 1)}, "", 0},
+		{"above", heartRate, []*pb.BiometricReading{reading("HEART_RATE", 80, 0, 0), reading("HEART_RATE", 135, 0, 1)}, "HEART_RATE: 135 bpm above 120", 1},
+		{"below", heartRate, []*pb.BiometricReading{reading("HEART_RATE", 38.5, 0, 0)}, "HEART_RATE: 38.5 bpm below 40", 1},
+		{"several breaches", heartRate, []*pb.BiometricReading{reading("HEART_RATE", 130, 0, 0), reading("HEART_RATE", 35, 0, 1)}, "HEART_RATE: 130 bpm above 120; 35 bpm below 40", 2},
+		{"systolic", bloodPressure, []*pb.BiometricReading{reading(BloodPressureType, 190, 100, 0)}, "BLOOD_PRESSURE: 190/100 mmHg above 180", 1},
+		{"diastolic", bloodPressure, []*pb.BiometricReading{reading(BloodPressureType, 170, 125, 0)}, "BLOOD_PRESSURE: 170/125 mmHg, diastolic above 120", 1},
+		{"unset bound", config.VitalRule{TypeID: "OXYGEN_SATURATION", Below: 90}, []*pb.BiometricReading{reading("OXYGEN_SATURATION", 100, 0, 0)}, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alert := evaluateThreshold(tt.rule, tt.readings)
+			if tt.want == "" {
+				if alert != nil {
+					t.Fatalf("evaluateThreshold() = %q, want no alert", alert.Description)
+				}
+				return
+			}
+			if alert == nil || alert.Description != tt.want || len(alert.Readings) != tt.wantN {
+				t.Fatalf("evaluateThreshold() = %v, want %q with %d readings", alert, tt.want, tt.wantN)
+			}
+		})
+	}
+}
+
+// biometricsDB answers ListRecentBiometrics from stored readings, oldest
+// first
+type biometricsDB struct {
+	readings []*pb.BiometricReading
+	fail     bool
+}
+
+func (f *biometricsDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, fmt.Errorf("unexpected query %s", sql)
+}
+
+func (f *biometricsDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if !strings.HasPrefix(sql, "-- name: ListRecentBiometrics ") {
+		return nil, fmt.Errorf("unexpected query %s", sql)
+	}
+	if f.fail {
+		return nil, fmt.Errorf("connection reset")
+	}
+	since := args[2].(pgtype.Timestamptz).Time
+	rows := &valueRows{}
+	for _, r := range f.readings {
+		if at := r.MeasuredAt.AsTime(); !at.Before(since) {
+			rows.rows = append(rows.rows, []any{pg.Float64ToNumeric(r.Value), pg.Float64ToNumeric(r.SecondaryValue), pgtype.Timestamptz{Time: at, Valid: true}})
+		}
+	}
+	return rows, nil
+}
+
+func (f *biometricsDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	panic("unexpected query " + sql)
+}
+
+// valueRows hands out rows of values in column order
+type valueRows struct {
+	rows [][]any
+	next int
+}
+
+func (r *valueRows) Close()                                       {}
+func (r *valueRows) Err() error                                   { return nil }
+func (r *valueRows) CommandTag() pgconn.CommandTag                { return pgconn.NewCommandTag("SELECT") }
+func (r *valueRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *valueRows) Values() ([]any, error)                       { return r.rows[r.next-1], nil }
+func (r *valueRows) RawValues() [][]byte                          { return nil }
+func (r *valueRows) Conn() *pgx.Conn                              { return nil }
+
+func (r *valueRows) Next() bool {
+	r.next++
+	return r.next <= len(r.rows)
+}
+
+func (r *valueRows) Scan(dest ...any) error {
+	for i, value := range r.rows[r.next-1] {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+	}
+	return nil
+}
+
+func TestEvaluateTrend(t *testing.T) {
+	rule := config.VitalRule{Name: "rising heart rate", TypeID: "HEART_RATE", Rise: 20, Window: time.Hour, MinReadings: 3}
+	series := func(values ...float64) []*pb.BiometricReading {
+		var out []*pb.BiometricReading
+		for i, v := range values {
+			out = append(out, reading("HEART_RATE", v, 0, i*10))
+		}
+		return out
+	}
+	tests := []struct {
+		name     string
+		stored   []*pb.BiometricReading
+		ingested int // Index of the stored reading just ingested, -1 for the last
+		want     string
+	}{
+		{"steady rise", series(70, 80, 95), -1, "HEART_RATE rose from 70 bpm to 95 bpm over 3 readings in 20m0s"},
+		{"rise too small", series(70, 80, 85), -1, ""},
+		{"too few readings", series(70, 95), -1, ""},
+		{"dips below the first", series(80, 75, 90, 101), -1, ""},
+		{"plateau counts as rising", series(70, 70, 90), -1, "HEART_RATE rose from 70 bpm to 90 bpm over 3 readings in 20m0s"},
+		{"outside the window", append(series(60), reading("HEART_RATE", 80, 0, 70), reading("HEART_RATE", 85, 0, 80), reading("HEART_RATE", 88, 0, 90)), -1, ""},
+		// A later reading from another device is outside the window
+		{"window ends at the newest ingested reading", append(series(70, 80, 95), reading("HEART_RATE", 60, 0, 30)), 2, "HEART_RATE rose from 70 bpm to 95 bpm over 3 readings in 20m0s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &biometricsDB{readings: tt.stored}
+			q := db.NewEncrypted(db.New(fake), nil)
+			i := tt.ingested
+			if i < 0 {
+				i = len(tt.stored) - 1
+			}
+			ingested := tt.stored[i : i+1]
+
+			alert, err := evaluateTrend(context.Background(), q, rule, pg.NewUUID(), ingested, "bpm")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == "" {
+				if alert != nil {
+					t.Fatalf("evaluateTrend() = %q, want no alert", alert.Description)
+				}
+				return
+			}
+			if alert == nil || alert.Description != tt.want {
+				t.Fatalf("evaluateTrend() = %v, want %q", alert, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateTrendQueryFailure(t *testing.T) {
+	rule := config.VitalRule{TypeID: "HEART_RATE", Rise: 20, Window: time.Hour, MinReadings: 2}
+	q := db.NewEncrypted(db.New(&biometricsDB{fail: true}), nil)
+	if _, err := evaluateTrend(context.Background(), q, rule, pg.NewUUID(), []*pb.BiometricReading{reading("HEART_RATE", 90, 0, 0)}, "bpm"); err == nil {
+		t.Fatal("evaluateTrend() succeeded when loading readings failed")
+	}
+}
+
+func TestVitalsMonitorCooldown(t *testing.T) {
+	m := NewVitalsMonitor(config.VitalsConfig{
+		Rules:    []config.VitalRule{{Name: "tachycardia", TypeID: "HEART_RATE", Above: 120, Urgency: config.UrgencyUrgent}},
+		Cooldown: time.Hour,
+	})
+	patient, other := pg.NewUUID(), pg.NewUUID()
+	high := []*pb.BiometricReading{reading("HEART_RATE", 140, 0, 0)}
+
+	tests := []struct {
+		name     string
+		patient  pgtype.UUID
+		readings []*pb.BiometricReading
+		want     int
+	}{
+		{"fires", patient, high, 1},
+		{"in cooldown", patient, high, 0},
+		{"other patient", other, high, 1},
+		{"other type", patient, []*pb.BiometricReading{reading("OXYGEN_SATURATION", 80, 0, 0)}, 0},
+	}
+	for _, tt := range tests {
+		alerts, err := m.Evaluate(context.Background(), nil, tt.patient, tt.readings, nil)
+		if err != nil || len(alerts) != tt.want {
+			t.Fatalf("%s: Evaluate() = %d alerts, %v, want %d", tt.name, len(alerts), err, tt.want)
+		}
+		if tt.want > 0 && (alerts[0].Rule != "tachycardia" || alerts[0].Urgency != pb.UrgencyLevel_URGENCY_URGENT) {
+			t.Fatalf("%s: alert = %v", tt.name, alerts[0])
+		}
+	}
+}
+
+// chatDB opens and closes chat_sessions rows in memory
+type chatDB struct {
+	mu     sync.Mutex
+	closed []pgtype.UUID
+}
+
+func (f *chatDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if !strings.HasPrefix(sql, "-- name: UpdateChatSessionStatus ") {
+		return pgconn.CommandTag{}, fmt.Errorf("unexpected query %s", sql)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = append(f.closed, args[0].(pgtype.UUID))
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (f *chatDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return nil, fmt.Errorf("unexpected query %s", sql)
+}
+
+func (f *chatDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if !strings.HasPrefix(sql, "-- name: CreateChatSession ") {
+		panic("unexpected query " + sql)
+	}
+	return &valueRows{rows: [][]any{{pg.NewUUID(), args[0].(pgtype.UUID), ChatSessionStatusOpen, pgtype.Timestamptz{Time: time.Now(), Valid: true}, pgtype.Timestamptz{}}}, next: 1}
+}
+
+func (f *chatDB) closedCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.closed)
+}
+
+func TestAlertSessionExpires(t *testing.T) {
+	const ttl = 50 * time.Millisecond
+	tests := []struct {
+		name       string
+		join       func(s *WebSocketServer, session *ChatSession, patientID pgtype.UUID)
+		wantClosed bool
+	}{
+		{"no one joins", func(*WebSocketServer, *ChatSession, pgtype.UUID) {}, true},
+		{"doctor joins", func(s *WebSocketServer, session *ChatSession, _ pgtype.UUID) {
+			s.mu.Lock()
+			session.doctorConn = &Connection{}
+			s.mu.Unlock()
+		}, false},
+		{"patient connects", func(s *WebSocketServer, _ *ChatSession, patientID pgtype.UUID) {
+			if !s.resumeAlertSession(&Connection{}, pg.ToUUID(patientID).String()) {
+				t.Fatal("patient did not join the alert session")
+			}
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &chatDB{}
+			s := &WebSocketServer{
+				BaseServer: &BaseServer{dbq: db.NewEncrypted(db.New(fake), nil)},
+				sessions:   make(map[string]*ChatSession),
+				sessionCfg: config.SessionConfig{AlertTTL: ttl},
+				queue:      NewReviewQueue(config.SLAConfig{}),
+				router:     NewRouter(config.RoutingConfig{}),
+			}
+			patientID := pg.NewUUID()
+			session, err := s.alertSession(context.Background(), patientID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again, _ := s.alertSession(context.Background(), patientID); again != session {
+				t.Fatal("a second alert opened another session")
+			}
+			tt.join(s, session, patientID)
+
+			time.Sleep(3 * ttl)
+			s.mu.RLock()
+			_, open := s.sessions[session.sessionID]
+			s.mu.RUnlock()
+			if open == tt.wantClosed || (fake.closedCount() == 1) != tt.wantClosed {
+				t.Fatalf("session open = %v, chat sessions closed = %d, want closed %v", open, fake.closedCount(), tt.wantClosed)
+			}
+		})
+	}
+}
@@ -2,126 +2,359 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"llm-qa-system/backend-service/audit"
+	"llm-qa-system/backend-service/authz"
+	"llm-qa-system/backend-service/config"
+	"llm-qa-system/backend-service/logging"
 	pb "llm-qa-system/backend-service/src/proto"
-	"log"
+	pg "llm-qa-system/backend-service/utils"
+	"log/slog"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/segmentio/kafka-go"
-	"google.golang.org/protobuf/encoding/protojson"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Connection struct {
-	conn      *websocket.Conn
-	role      string
-	sessionID string
+	conn       *websocket.Conn
+	role       authz.Role
+	sessionID  string
+	doctorID   string        // Staff user ID, from their token
+	principal  string        // Authenticated identity: authz.PrincipalID for staff, user ID for patients
+	observer   bool          // Supervisor watching a session read-only
+	wire       *wireFormat   // Framing of the negotiated subprotocol
+	compressAt int           // Frames of at least this many bytes are compressed, if negotiated
+	limiter    *rate.Limiter // nil when message rate is unlimited
+	writeMu    sync.Mutex    // gorilla connections allow one concurrent writer
+}
+
+// send marshals msg in the connection's wire format and writes it
+func (c *Connection) send(msg *pb.WebSocketMessage) error {
+	data, err := c.wire.marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %v", err)
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	// Short frames grow when deflated, only long ones like drafts are compressed
+	c.conn.EnableWriteCompression(len(data) >= c.compressAt)
+	return c.conn.WriteMessage(c.wire.frameType, data)
+}
+
+// sendError writes an ERROR frame to the connection
+func (c *Connection) sendError(text string) error {
+	return c.send(&pb.WebSocketMessage{
+		Type: pb.MessageType_ERROR,
+		Payload: &pb.WebSocketMessage_Error{
+			Error: &pb.Error{Message: text},
+		},
+	})
+}
+
+// closeWithReason sends a close frame with the given code and reason
+func (c *Connection) closeWithReason(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
 }
 
 type ChatSession struct {
 	patientConn *Connection
 	doctorConn  *Connection
+	observers   map[*Connection]struct{} // Supervising doctors watching read-only
 	sessionID   string
+	department  string      // Chosen by the patient, empty lets triage decide
+	chatID      pgtype.UUID // chat_sessions row, invalid when not persisted
+	patientID   pgtype.UUID
+	alerts      []*pb.VitalAlert  // Raised while the session is open, sent to each doctor who joins
+	alertOnly   bool              // Opened for a vital alert, closes when its doctor leaves
+	outbox      []*trackedMessage // Messages between patient and doctor not yet read, oldest first, bounded by pruneOutbox
+	seq         uint64            // Of the last tracked message
+	resumeTimer *time.Timer       // Set while the session waits for its patient to resume, or to be joined after an alert
 	created     time.Time
 }
 
+// sessionRequest holds the query parameters a connection was opened with
+type sessionRequest struct {
+	role       authz.Role
+	sessionID  string
+	token      string // Staff token, or the patient token identifying a patient
+	department string
+	patientID  string // Set from a verified patient token only
+	doctorID   string // Claimed by staff, only trusted with -insecure-dev
+	observe    bool
+	lastSeq    uint64 // Last message a resuming patient acknowledged
+}
+
 type WebSocketServer struct {
 	*BaseServer
-	llmClient  *LLMClient
-	sessions   map[string]*ChatSession
-	mu         sync.RWMutex
-	reader     *kafka.Reader
-	writer     *kafka.Writer
-	cancelFunc context.CancelFunc
+	llmClient     *LLMClient
+	sessions      map[string]*ChatSession
+	mu            sync.RWMutex
+	reader        *kafka.Reader
+	writer        *kafka.Writer
+	cancelFunc    context.CancelFunc
+	sessionCfg    config.SessionConfig
+	authn         *authz.Authenticator
+	limits        config.LimitsConfig
+	wsCfg         config.WebSocketConfig
+	upgrader      websocket.Upgrader
+	ipLimiter     *ipConnLimiter
+	triage        *TriageEngine
+	queue         *ReviewQueue
+	router        *Router
+	sla           config.SLAConfig
+	supervisors   map[string]struct{}
+	slaWriter     *kafka.Writer
+	draining      atomic.Bool    // set once shutdown starts, new connections are refused
+	handlers      sync.WaitGroup // one per connection handled by HandleWebSocket
+	consumerDone  chan struct{}  // closed when consumeKafkaMessages returns
+	schedulerDone chan struct{}  // closed when runSLAScheduler returns
 }
 
-func NewWebSocketServer(base *BaseServer, llmClient *LLMClient, kafkaBrokers []string) *WebSocketServer {
+func NewWebSocketServer(base *BaseServer, llmClient *LLMClient, authn *authz.Authenticator, cfg *config.Config) *WebSocketServer {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	supervisors := make(map[string]struct{}, len(cfg.SLA.SupervisorIDs))
+	for _, id := range cfg.SLA.SupervisorIDs {
+		supervisors[id] = struct{}{}
+	}
+
 	ws := &WebSocketServer{
 		BaseServer: base,
 		llmClient:  llmClient,
 		sessions:   make(map[string]*ChatSession),
 		mu:         sync.RWMutex{},
-		reader:     NewKafkaReader(kafkaBrokers, TopicLLMResponses, GroupIDWebSocket),
-		writer:     NewKafkaWriter(kafkaBrokers, TopicPatientMessages),
+		reader:     NewKafkaReader(cfg.Kafka, TopicLLMResponses, GroupIDWebSocket),
+		writer:     NewKafkaWriter(cfg.Kafka, TopicPatientMessages),
 		cancelFunc: cancel,
+		sessionCfg: cfg.Session,
+		authn:      authn,
+		limits:     cfg.Limits,
+		wsCfg:      cfg.WebSocket,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:    1024,
+			WriteBufferSize:   1024,
+			CheckOrigin:       newOriginChecker(cfg.Limits.AllowedOrigins),
+			EnableCompression: cfg.WebSocket.Compression,
+		},
+		ipLimiter:     newIPConnLimiter(cfg.Limits.MaxConnsPerIP),
+		triage:        NewTriageEngine(DefaultTriageRules),
+		queue:         NewReviewQueue(cfg.SLA),
+		router:        NewRouter(cfg.Routing),
+		sla:           cfg.SLA,
+		supervisors:   supervisors,
+		slaWriter:     NewKafkaWriter(cfg.Kafka, TopicSLABreaches),
+		consumerDone:  make(chan struct{}),
+		schedulerDone: make(chan struct{}),
 	}
 
-	// Start Kafka consumer
+	// Start Kafka consumer and the review deadline scheduler
 	go ws.consumeKafkaMessages(ctx)
+	go ws.runSLAScheduler(ctx)
 
 	return ws
 }
 
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		return true // You might want to restrict this in production
-	},
-}
-
 func (s *WebSocketServer) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
-	log.Println("HandleWebSocket called - this should happen only once per client")
+	slog.Debug("websocket connection requested", "remote_addr", r.RemoteAddr)
+
+	if s.draining.Load() {
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	s.handlers.Add(1)
+	defer s.handlers.Done()
 
-	// Configure protojson
-	unmarshaler := protojson.UnmarshalOptions{DiscardUnknown: true}
+	ip := clientIP(r)
+	if !s.ipLimiter.acquire(ip) {
+		slog.Warn("too many connections from client", "remote_addr", r.RemoteAddr)
+		http.Error(w, "too many connections", http.StatusTooManyRequests)
+		return
+	}
+	defer s.ipLimiter.release(ip)
 
-	conn, err := upgrader.Upgrade(w, r, nil)
+	conn, err := s.upgrader.Upgrade(w, r, selectProtocol(r))
+	if err != nil {
+		slog.Warn("failed to upgrade connection", "remote_addr", r.RemoteAddr, logging.Err(err))
+		return
+	}
+	wire, err := negotiateProtocol(r, conn)
 	if err != nil {
-		log.Printf("Failed to upgrade connection: %v", err)
+		slog.Warn("session setup failed", logging.Err(err))
+		refuseConnection(conn, err)
 		return
 	}
 
-	role := r.URL.Query().Get("role")
-	sessionID := r.URL.Query().Get("session")
-	doctorToken := r.URL.Query().Get("token")
+	query := r.URL.Query()
+	role, err := authz.ParseRole(query.Get("role"))
+	if err != nil {
+		slog.Warn("session setup failed", logging.Err(err))
+		refuseConnection(conn, refuse(CloseBadRequest, "%v", err))
+		return
+	}
+	req := sessionRequest{
+		role:       role,
+		sessionID:  query.Get("session"),
+		token:      query.Get("token"),
+		department: query.Get("department"),
+		doctorID:   query.Get("doctor_id"),
+		observe:    query.Get("observe") == "1",
+	}
+	if lastSeq := query.Get("last_seq"); lastSeq != "" {
+		if req.lastSeq, err = strconv.ParseUint(lastSeq, 10, 64); err != nil {
+			slog.Warn("session setup failed", logging.Err(err))
+			refuseConnection(conn, refuse(CloseBadRequest, "invalid last_seq: %v", err))
+			return
+		}
+	}
 
 	connection := &Connection{
-		conn:      conn,
-		role:      role,
-		sessionID: sessionID,
+		conn:       conn,
+		role:       role,
+		sessionID:  req.sessionID,
+		observer:   role.IsStaff() && req.observe,
+		wire:       wire,
+		compressAt: s.wsCfg.CompressionThreshold,
+		limiter:    newMessageLimiter(s.limits),
 	}
 
-	// Handle session management
-	if err := s.handleSession(connection, role, sessionID, doctorToken); err != nil {
-		log.Printf("Session error: %v", err)
-		conn.Close()
+	// Handle session management. Staff without a session go on duty and wait
+	// for assignments.
+	if role.IsStaff() && req.sessionID == "" {
+		err = s.goOnDuty(r.Context(), connection, req)
+	} else {
+		err = s.handleSession(r.Context(), connection, req)
+	}
+	if err != nil {
+		slog.Warn("session setup failed", logging.Role(string(role)), logging.SessionID(req.sessionID), logging.Err(err))
+		refuseConnection(conn, err)
 		return
 	}
 
 	defer s.handleDisconnect(connection)
 
-	log.Printf("New %s connected to session %s", role, sessionID)
+	// Open the connection with its session event, then tell the others
+	switch {
+	case role == authz.RolePatient:
+		s.greet(connection, pb.MessageType_SESSION_STARTED)
+		// Only a resumed session has someone to tell, or messages waiting
+		s.notifyParticipants(connection.sessionID, connection, s.sessionEvent(pb.MessageType_SESSION_JOINED, connection.sessionID, connection, ""))
+		s.retransmit(connection, req.lastSeq)
+	case req.sessionID != "":
+		s.greet(connection, pb.MessageType_SESSION_JOINED)
+		s.notifyParticipants(connection.sessionID, connection, s.sessionEvent(pb.MessageType_SESSION_JOINED, connection.sessionID, connection, ""))
+		if !connection.observer {
+			s.retransmit(connection, 0)
+		}
+	}
+
+	switch {
+	case connection.observer:
+		s.announceObserver(connection, true)
+	case role == authz.RolePatient && req.department != "":
+		s.router.Route(connection.sessionID, req.department, pb.UrgencyLevel_URGENCY_ROUTINE)
+	case role.IsStaff() && req.sessionID != "":
+		s.router.Accept(req.sessionID, connection.doctorID)
+		// Hand over drafts that arrived before the doctor joined, most urgent first
+		go s.deliverPendingDrafts(connection)
+	}
+
+	// A doctor connecting is back at their client, whatever they reported elsewhere
+	if role.IsStaff() && !connection.observer {
+		s.updatePresence(connection, pb.PresenceStatus_PRESENCE_ONLINE)
+	}
+
+	slog.Info("participant connected", logging.Role(string(role)), logging.SessionID(connection.sessionID))
+	s.auditConn(connection, audit.ActionConnect, "", map[string]string{"remote_addr": ip})
+	if role.IsStaff() && req.sessionID != "" {
+		s.auditConn(connection, audit.ActionSessionJoin, "", map[string]string{"observer": strconv.FormatBool(connection.observer)})
+	}
 
 	// Message handling loop
 	for {
-		_, rawMsg, err := conn.ReadMessage()
-		log.Println("Received message on existing connection")
+		if s.sessionCfg.IdleTimeout > 0 {
+			conn.SetReadDeadline(time.Now().Add(s.sessionCfg.IdleTimeout))
+		}
 
+		frameType, rawMsg, err := readMessage(conn, s.limits.MaxMessageBytes)
 		if err != nil {
-			log.Printf("ReadMessage error from %s: %v", role, err)
+			if errors.Is(err, errMessageTooLarge) {
+				slog.Warn("message size limit exceeded", logging.Role(string(role)), logging.SessionID(connection.sessionID))
+				connection.sendError("message too large")
+				connection.closeWithReason(websocket.CloseMessageTooBig, "message too large")
+				break
+			}
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				slog.Info("idle timeout, closing connection", logging.Role(string(role)), logging.SessionID(connection.sessionID))
+				connection.closeWithReason(CloseIdleTimeout, "idle timeout")
+				break
+			}
+			slog.Info("read failed, closing connection", logging.Role(string(role)), logging.SessionID(connection.sessionID), logging.Err(err))
+			break
+		}
+
+		if connection.limiter != nil && !connection.limiter.Allow() {
+			slog.Warn("message rate limit exceeded", logging.Role(string(role)), logging.SessionID(connection.sessionID))
+			connection.sendError("rate limit exceeded, disconnecting")
+			connection.closeWithReason(websocket.ClosePolicyViolation, "rate limit exceeded")
+			break
+		}
+
+		if frameType != wire.frameType {
+			slog.Warn("frame type does not match the protocol", logging.Role(string(role)), logging.SessionID(connection.sessionID), "protocol", conn.Subprotocol())
+			connection.closeWithReason(websocket.CloseUnsupportedData, "wrong frame type for protocol")
 			break
 		}
 
 		var wsMsg pb.WebSocketMessage
-		if err := unmarshaler.Unmarshal(rawMsg, &wsMsg); err != nil {
-			log.Printf("Unmarshal error from %s: %v", role, err)
+		if err := wire.unmarshal(rawMsg, &wsMsg); err != nil {
+			slog.Warn("failed to unmarshal websocket message", logging.Role(string(role)), logging.SessionID(connection.sessionID), logging.Err(err))
+			connection.closeWithReason(websocket.CloseInvalidFramePayloadData, "invalid message")
 			break
 		}
 
+		slog.Debug("websocket message received", logging.Role(string(role)), logging.SessionID(connection.sessionID), "type", wsMsg.Type.String())
+
+		// Observers may only reassign the session they watch
+		if connection.observer && wsMsg.Type != pb.MessageType_SESSION_HANDOFF {
+			connection.sendError("observers cannot send messages")
+			continue
+		}
+		if perm, ok := messagePermission(connection, &wsMsg); ok && !role.Can(perm) {
+			slog.Warn("websocket message refused", logging.Role(string(role)), logging.SessionID(connection.sessionID), "type", wsMsg.Type.String())
+			connection.sendError(fmt.Sprintf("%s is not allowed for role %s", wsMsg.Type, role))
+			continue
+		}
+
 		switch wsMsg.Type {
 		case pb.MessageType_PATIENT_MESSAGE:
 			if msg := wsMsg.GetMessage(); msg != nil {
-				// 1. Forward original message to doctor
-				s.broadcastToRole(connection.sessionID, "doctor", &wsMsg)
+				// 1. Record the original message and forward it to the doctor
+				s.relay(connection, pb.MessageType_PATIENT_MESSAGE, MessageTypePatient, msg.Content, msg.ClientMessageId)
+				s.auditConn(connection, audit.ActionMessageSend, "", map[string]string{"message_type": MessageTypePatient})
+
+				// 2. Check for emergencies before anything waits on the LLM,
+				// then route the session to a doctor of the right department
+				result := s.triage.Evaluate(msg.Content)
+				s.applySafetyNet(connection.sessionID, result)
+				s.router.Route(connection.sessionID, s.sessionDepartment(connection.sessionID, result), result.Urgency)
 
-				// 2. Write to Kafka for LLM processing
+				// 3. Write to Kafka for LLM processing
 				patientMsg := &pb.Message{
 					Content:   msg.Content,
 					Timestamp: timestamppb.Now(),
@@ -129,7 +362,7 @@ func (s *WebSocketServer) HandleWebSocket(w http.ResponseWriter, r *http.Request
 
 				msgBytes, err := proto.Marshal(patientMsg)
 				if err != nil {
-					log.Printf("Error marshaling message: %v", err)
+					slog.Error("failed to marshal patient message", logging.SessionID(connection.sessionID), logging.Err(err))
 					continue
 				}
 
@@ -139,7 +372,7 @@ func (s *WebSocketServer) HandleWebSocket(w http.ResponseWriter, r *http.Request
 				})
 
 				if err != nil {
-					log.Printf("Error writing to Kafka: %v", err)
+					slog.Error("failed to write patient message to kafka", logging.SessionID(connection.sessionID), logging.Err(err))
 					// Send error message to patient
 					s.broadcastToRole(connection.sessionID, "doctor", &pb.WebSocketMessage{
 						Type: pb.MessageType_ERROR,
@@ -152,89 +385,238 @@ func (s *WebSocketServer) HandleWebSocket(w http.ResponseWriter, r *http.Request
 
 		case pb.MessageType_DOCTOR_MESSAGE:
 			if msg := wsMsg.GetMessage(); msg != nil {
-				s.broadcastToRole(connection.sessionID, "patient", &wsMsg)
+				s.relay(connection, pb.MessageType_DOCTOR_MESSAGE, MessageTypeDoctor, msg.Content, msg.ClientMessageId)
+				s.auditConn(connection, audit.ActionMessageSend, "", map[string]string{"message_type": MessageTypeDoctor})
+			}
+
+		case pb.MessageType_SESSION_HANDOFF:
+			if handoff := wsMsg.GetHandoff(); handoff != nil {
+				if connection.observer {
+					if err := s.reassign(connection, handoff); err != nil {
+						slog.Warn("session reassignment failed", logging.SessionID(connection.sessionID), logging.DoctorID(connection.doctorID), logging.Err(err))
+						connection.sendError(fmt.Sprintf("reassignment failed: %v", err))
+					}
+					continue
+				}
+				if err := s.handOff(connection, handoff); err != nil {
+					slog.Warn("session handoff failed", logging.SessionID(connection.sessionID), logging.DoctorID(connection.doctorID), logging.Err(err))
+					connection.sendError(fmt.Sprintf("handoff failed: %v", err))
+					continue
+				}
+				connection.closeWithReason(websocket.CloseNormalClosure, "session handed off")
+				return
+			}
+
+		case pb.MessageType_MESSAGE_ACK:
+			if ack := wsMsg.GetAck(); ack != nil {
+				if err := s.acknowledge(connection, ack); err != nil {
+					slog.Debug("acknowledgement ignored", logging.SessionID(connection.sessionID), logging.MessageID(ack.MessageId), logging.Err(err))
+				}
+			}
+
+		case pb.MessageType_TYPING:
+			if typing := wsMsg.GetTyping(); typing != nil {
+				s.relayTyping(connection, typing)
+			}
+
+		case pb.MessageType_PRESENCE:
+			if presence := wsMsg.GetPresence(); presence != nil && presence.Status != pb.PresenceStatus_PRESENCE_UNSPECIFIED {
+				s.updatePresence(connection, presence.Status)
+			}
+
+		case pb.MessageType_DOCTOR_STATUS:
+			if status := wsMsg.GetDoctorStatus(); status != nil {
+				s.router.SetAvailability(connection.doctorID, status.Availability)
 			}
 
 		case pb.MessageType_DRAFT_REVIEW:
 			if review := wsMsg.GetReview(); review != nil {
+				pending, err := s.takeDraft(connection, review.MessageId)
+				if err != nil {
+					slog.Warn("draft review refused", logging.Role(string(role)), logging.SessionID(connection.sessionID), logging.MessageID(review.MessageId), logging.Err(err))
+					connection.sendError(err.Error())
+					continue
+				}
+				s.recordReview(connection, review)
+				s.auditConn(connection, audit.ActionDraftReview, review.MessageId, map[string]string{"review_action": review.Action.String()})
+
+				// An accepted draft is sent as generated, whatever content
+				// the reviewer sent with it. Receipts refer to the answer by
+				// its draft's ID.
 				switch review.Action {
-				case pb.ReviewAction_ACCEPT, pb.ReviewAction_MODIFY:
-					responseMsg := &pb.WebSocketMessage{
-						Type: pb.MessageType_DOCTOR_MESSAGE,
-						Payload: &pb.WebSocketMessage_Message{
-							Message: &pb.Message{
-								Content:   review.Content,
-								Timestamp: timestamppb.Now(),
-							},
-						},
-					}
-					s.broadcastToRole(connection.sessionID, "patient", responseMsg)
+				case pb.ReviewAction_ACCEPT:
+					s.relay(connection, pb.MessageType_DOCTOR_MESSAGE, MessageTypeAIDraftApproved, pending.Draft.GetDraft(), review.MessageId)
+				case pb.ReviewAction_MODIFY:
+					s.relay(connection, pb.MessageType_DOCTOR_MESSAGE, MessageTypeAIDraftModified, review.Content, review.MessageId)
 				}
 			}
 		}
 	}
 }
 
-func (s *WebSocketServer) handleSession(conn *Connection, role, sessionID, doctorToken string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *WebSocketServer) handleSession(ctx context.Context, conn *Connection, req sessionRequest) error {
+	switch {
+	case req.role == authz.RolePatient:
+		// Only a verified patient token says who the patient is, a patient
+		// without one gets an anonymous session
+		if req.token != "" {
+			patientID, err := s.authn.PatientID(req.token)
+			if err != nil {
+				return refuse(CloseUnauthorized, "invalid patient token: %v", err)
+			}
+			req.patientID = patientID
+			conn.principal = patientID
+		}
+		if req.sessionID != "" {
+			return s.resumePatientSession(conn, req)
+		}
+		if s.resumeAlertSession(conn, req.patientID) {
+			return nil
+		}
 
-	switch role {
-	case "patient":
-		if sessionID == "" {
-			// Create new session for patient
-			sessionID = generateSessionID()
-			s.sessions[sessionID] = &ChatSession{
-				patientConn: conn,
-				sessionID:   sessionID,
-				created:     time.Now(),
+		// Patients who identify themselves get a persisted transcript, keyed
+		// by the chat_sessions row
+		session := &ChatSession{
+			department: req.department,
+			created:    time.Now(),
+		}
+		if req.patientID != "" {
+			chat, err := s.openChatSession(ctx, req.patientID)
+			if err != nil {
+				return err
 			}
-			// Inform patient of their session ID
-			conn.conn.WriteJSON(map[string]string{"session_id": sessionID})
-			conn.sessionID = sessionID
+			session.chatID = chat.ID
+			session.patientID = chat.PatientID
+			session.sessionID = pg.ToUUID(chat.ID).String()
 		} else {
-			return fmt.Errorf("patients cannot join existing sessions")
+			session.sessionID = generateSessionID()
 		}
 
-	case "doctor":
-		if !isValidDoctorToken(doctorToken) {
-			return fmt.Errorf("invalid doctor token")
+		s.mu.Lock()
+		if s.sessionCfg.MaxSessions > 0 && len(s.sessions) >= s.sessionCfg.MaxSessions {
+			s.mu.Unlock()
+			s.closeChatSession(session)
+			return refuse(websocket.CloseTryAgainLater, "session limit reached")
+		}
+
+		// Create new session for patient
+		session.patientConn = conn
+		s.sessions[session.sessionID] = session
+		conn.sessionID = session.sessionID
+		s.mu.Unlock()
+
+	case req.role.IsStaff():
+		if err := s.authenticateStaff(conn, req); err != nil {
+			return err
+		}
+		if conn.observer && !req.role.Can(authz.ObserveSession) {
+			return refuse(CloseForbidden, "role %s cannot observe sessions", req.role)
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		session, exists := s.sessions[req.sessionID]
+		if !exists {
+			return refuse(CloseSessionNotFound, "session not found")
 		}
-		if session, exists := s.sessions[sessionID]; exists {
-			if session.doctorConn != nil {
-				return fmt.Errorf("session already has a doctor")
+		if conn.observer {
+			if session.observers == nil {
+				session.observers = make(map[*Connection]struct{})
 			}
-			session.doctorConn = conn
-		} else {
-			return fmt.Errorf("session not found")
+			session.observers[conn] = struct{}{}
+			return nil
+		}
+		if session.doctorConn != nil {
+			return refuse(CloseConflict, "session already has a doctor")
 		}
+		session.doctorConn = conn
 
 	default:
-		return fmt.Errorf("invalid role: %s", role)
+		return refuse(CloseForbidden, "role %s cannot connect to sessions", req.role)
 	}
 
 	return nil
 }
 
+// endSession tells the remaining participants of a session that was removed
+// that it closed, except the one who closed it, and closes it
+func (s *WebSocketServer) endSession(closed *ChatSession, remaining []*Connection, except *Connection, reason string) {
+	s.router.Close(closed.sessionID)
+	event := s.sessionEvent(pb.MessageType_SESSION_CLOSED, closed.sessionID, nil, reason)
+	for _, participant := range remaining {
+		if participant == except {
+			continue
+		}
+		participant.send(event)
+		participant.closeWithReason(websocket.CloseNormalClosure, "session closed")
+	}
+	s.closeChatSession(closed)
+}
+
 func (s *WebSocketServer) handleDisconnect(conn *Connection) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	if conn.role.IsStaff() && conn.sessionID == "" {
+		s.router.GoOffDuty(conn.doctorID, conn)
+	}
 
+	s.mu.Lock()
+	var closed *ChatSession
+	var remaining []*Connection
+	observerLeft, left := false, false
 	if session, exists := s.sessions[conn.sessionID]; exists {
-		switch conn.role {
-		case "patient":
-			if session.patientConn == conn {
+		switch {
+		case conn.observer:
+			if _, ok := session.observers[conn]; ok {
+				delete(session.observers, conn)
+				observerLeft, left = true, true
+			}
+		case conn.role == authz.RolePatient:
+			switch {
+			case session.patientConn != conn:
+			case s.holdForResume(session):
+				session.patientConn = nil
+				left = true
+			default:
 				delete(s.sessions, conn.sessionID)
+				s.queue.RemoveSession(conn.sessionID)
+				closed = session
 			}
-		case "doctor":
+		case conn.role.IsStaff():
 			if session.doctorConn == conn {
 				session.doctorConn = nil
+				left = true
+				if session.alertOnly && session.patientConn == nil {
+					delete(s.sessions, conn.sessionID)
+					s.queue.RemoveSession(conn.sessionID)
+					closed = session
+				}
 			}
 		}
+		if closed != nil {
+			remaining = closed.participants()
+		}
+	}
+	s.mu.Unlock()
+
+	if conn.role.IsStaff() && !conn.observer {
+		s.updatePresence(conn, pb.PresenceStatus_PRESENCE_UNSPECIFIED)
+	}
+	if observerLeft {
+		s.announceObserver(conn, false)
+	}
+	if left && closed == nil {
+		s.notifyParticipants(conn.sessionID, conn, s.sessionEvent(pb.MessageType_PARTICIPANT_LEFT, conn.sessionID, conn, "disconnected"))
+	}
+	if closed != nil {
+		reason := "patient left"
+		if conn.role != authz.RolePatient {
+			reason = "doctor left"
+		}
+		s.endSession(closed, remaining, conn, reason)
 	}
 
 	conn.conn.Close()
-	log.Printf("%s disconnected from session %s", conn.role, conn.sessionID)
+	slog.Info("participant disconnected", logging.Role(string(conn.role)), logging.SessionID(conn.sessionID))
 }
 
 func (s *WebSocketServer) broadcastToRole(sessionID, targetRole string, msg *pb.WebSocketMessage) {
@@ -243,7 +625,7 @@ func (s *WebSocketServer) broadcastToRole(sessionID, targetRole string, msg *pb.
 
 	session, exists := s.sessions[sessionID]
 	if !exists {
-		log.Printf("Session %s not found", sessionID)
+		slog.Warn("broadcast to unknown session", logging.SessionID(sessionID), logging.Role(targetRole))
 		return
 	}
 
@@ -253,54 +635,136 @@ func (s *WebSocketServer) broadcastToRole(sessionID, targetRole string, msg *pb.
 		targetConn = session.patientConn
 	case "doctor":
 		targetConn = session.doctorConn
+		// Observers see everything the doctor sees
+		for observer := range session.observers {
+			if err := observer.send(msg); err != nil {
+				slog.Warn("failed to send websocket message to observer", logging.SessionID(sessionID), logging.DoctorID(observer.doctorID), logging.Err(err))
+			}
+		}
 	}
 
 	if targetConn == nil {
-		log.Printf("No %s connected to session %s", targetRole, sessionID)
+		slog.Warn("broadcast target not connected", logging.SessionID(sessionID), logging.Role(targetRole))
 		return
 	}
 
-	// Marshal message
-	marshaler := protojson.MarshalOptions{UseProtoNames: true}
-	jsonBytes, err := marshaler.Marshal(msg)
-	if err != nil {
-		log.Printf("Marshal error: %v", err)
+	if err := targetConn.send(msg); err != nil {
+		slog.Warn("failed to send websocket message", logging.SessionID(sessionID), logging.Role(targetRole), logging.Err(err))
+	} else {
+		slog.Debug("websocket message sent", logging.SessionID(sessionID), logging.Role(targetRole), "type", msg.Type.String())
+	}
+}
+
+// newSystemMessage builds a SYSTEM_MESSAGE frame
+func newSystemMessage(text string) *pb.WebSocketMessage {
+	return &pb.WebSocketMessage{
+		Type: pb.MessageType_SYSTEM_MESSAGE,
+		Payload: &pb.WebSocketMessage_Message{
+			Message: &pb.Message{
+				Content:   text,
+				Timestamp: timestamppb.Now(),
+			},
+		},
+	}
+}
+
+// applySafetyNet acts on the local triage result of a patient message. An
+// urgent result immediately sends the patient a safety-net message and alerts
+// the doctor, without waiting for a draft.
+func (s *WebSocketServer) applySafetyNet(sessionID string, result TriageResult) {
+	if !result.IsUrgent() {
 		return
 	}
 
-	if err := targetConn.conn.WriteMessage(websocket.TextMessage, jsonBytes); err != nil {
-		log.Printf("Error broadcasting to %s: %v", targetRole, err)
-	} else {
-		log.Printf("Successfully sent message to %s in session %s", targetRole, sessionID)
+	slog.Warn("urgent patient message", logging.SessionID(sessionID), "urgency", result.Urgency.String(), "reasons", result.Reasons)
+
+	if result.SafetyMessage != "" {
+		s.broadcastToRole(sessionID, "patient", newSystemMessage(result.SafetyMessage))
+		s.recordSystemMessage(sessionID, result.SafetyMessage)
 	}
+	s.broadcastToRole(sessionID, "doctor", newSystemMessage(fmt.Sprintf(
+		"%s: patient message flagged for %s, the draft will be at the top of your queue",
+		urgencyLabel(result.Urgency), strings.Join(result.Reasons, ", "))))
 }
 
-func generateSessionID() string {
-	return fmt.Sprintf("session_%d", time.Now().UnixNano())
+// deliverPendingDrafts sends a newly joined doctor the session's vital
+// alerts and queued drafts
+func (s *WebSocketServer) deliverPendingDrafts(conn *Connection) {
+	s.mu.RLock()
+	var alerts []*pb.VitalAlert
+	if session, exists := s.sessions[conn.sessionID]; exists {
+		alerts = append(alerts, session.alerts...)
+	}
+	s.mu.RUnlock()
+
+	for _, alert := range alerts {
+		if err := conn.send(vitalAlertMessage(alert)); err != nil {
+			slog.Warn("failed to deliver vital alert", logging.SessionID(conn.sessionID), logging.Err(err))
+			return
+		}
+	}
+
+	for _, pending := range s.queue.ForSession(conn.sessionID) {
+		msg := &pb.WebSocketMessage{
+			Type: pb.MessageType_AI_DRAFT_READY,
+			Payload: &pb.WebSocketMessage_AiDraft{
+				AiDraft: pending.Draft,
+			},
+		}
+		if err := conn.send(msg); err != nil {
+			slog.Warn("failed to deliver queued draft", logging.SessionID(conn.sessionID), logging.MessageID(pending.Draft.MessageId), logging.Err(err))
+			return
+		}
+		s.auditConn(conn, audit.ActionDraftView, pending.Draft.MessageId, nil)
+	}
 }
 
-func isValidDoctorToken(token string) bool {
-	// TODO: Implement proper token validation
-	return token != ""
+func (s *WebSocketServer) hasSession(sessionID string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.sessions[sessionID]
+	return ok
+}
+
+// urgencyLabel returns the short label shown to doctors
+func urgencyLabel(u pb.UrgencyLevel) string {
+	switch u {
+	case pb.UrgencyLevel_URGENCY_EMERGENCY:
+		return "EMERGENCY"
+	case pb.UrgencyLevel_URGENCY_URGENT:
+		return "URGENT"
+	default:
+		return "ROUTINE"
+	}
+}
+
+func generateSessionID() string {
+	return fmt.Sprintf("session_%d", time.Now().UnixNano())
 }
 
 // This consumer handles the LLM draft from Kafka and broadcasts to doctor
 func (s *WebSocketServer) consumeKafkaMessages(ctx context.Context) {
+	defer close(s.consumerDone)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			msg, err := s.reader.ReadMessage(ctx)
+			msg, err := s.reader.FetchMessage(ctx)
 			if err != nil {
-				log.Printf("Error reading kafka message: %v", err)
+				if ctx.Err() != nil {
+					return
+				}
+				slog.Error("failed to read draft from kafka", logging.Err(err))
 				continue
 			}
 
 			// Unmarshal the message
 			var draftReady pb.AIDraftReady
 			if err := proto.Unmarshal(msg.Value, &draftReady); err != nil {
-				log.Printf("Error unmarshaling draft: %v", err)
+				slog.Error("failed to unmarshal draft", logging.SessionID(string(msg.Key)), logging.Err(err))
+				s.commitDraft(msg)
 				continue
 			}
 
@@ -312,20 +776,128 @@ func (s *WebSocketServer) consumeKafkaMessages(ctx context.Context) {
 				},
 			}
 
-			// Get session ID from Kafka message key and broadcast to doctor
+			// Get session ID from Kafka message key, queue for review and broadcast to doctor
 			sessionID := string(msg.Key)
+			slog.Info("draft ready", logging.SessionID(sessionID), logging.MessageID(draftReady.MessageId), "urgency", draftReady.Urgency.String())
+			if s.hasSession(sessionID) {
+				s.queue.Push(sessionID, &draftReady)
+			}
 			s.broadcastToRole(sessionID, "doctor", wsMsg)
+			s.auditDraftView(sessionID, draftReady.MessageId)
+			s.commitDraft(msg)
+		}
+	}
+}
+
+func (s *WebSocketServer) commitDraft(msg kafka.Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.reader.CommitMessages(ctx, msg); err != nil {
+		slog.Error("failed to commit draft offset", logging.SessionID(string(msg.Key)), logging.Err(err))
+	}
+}
+
+// BeginDrain refuses new WebSocket connections from now on
+func (s *WebSocketServer) BeginDrain() {
+	s.draining.Store(true)
+}
+
+// NotifyShutdown sends a SYSTEM message to every connected participant,
+// persisted to those in sessions whose transcript is saved and text to the
+// others
+func (s *WebSocketServer) NotifyShutdown(text, persisted string) {
+	notice, persistedNotice := newSystemMessage(text), newSystemMessage(persisted)
+
+	for _, conn := range s.connections() {
+		s.mu.RLock()
+		session, exists := s.sessions[conn.sessionID]
+		saved := exists && session.chatID.Valid
+		s.mu.RUnlock()
+
+		msg := notice
+		if saved {
+			msg = persistedNotice
+		}
+		if err := conn.send(msg); err != nil {
+			slog.Warn("failed to send shutdown notice", logging.Role(string(conn.role)), logging.SessionID(conn.sessionID), logging.Err(err))
+		}
+	}
+}
+
+// StopConsumer stops delivering drafts and escalating overdue reviews, and
+// waits for both to return. Drafts not yet delivered stay uncommitted in Kafka.
+func (s *WebSocketServer) StopConsumer(ctx context.Context) error {
+	s.cancelFunc()
+
+	for _, done := range []chan struct{}{s.consumerDone, s.schedulerDone} {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return fmt.Errorf("draft consumer did not stop: %v", ctx.Err())
 		}
 	}
+	return nil
+}
+
+// CloseConnections closes every connection with a going-away frame and waits
+// for their handlers to finish
+func (s *WebSocketServer) CloseConnections(ctx context.Context) error {
+	for _, conn := range s.connections() {
+		conn.closeWithReason(websocket.CloseGoingAway, "server shutting down")
+		conn.conn.Close()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.handlers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.endHeldSessions()
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("connection handlers did not finish: %v", ctx.Err())
+	}
+}
+
+// connections returns a snapshot of every connected participant
+func (s *WebSocketServer) connections() []*Connection {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	conns := s.router.Lobby()
+	for _, session := range s.sessions {
+		if session.patientConn != nil {
+			conns = append(conns, session.patientConn)
+		}
+		if session.doctorConn != nil {
+			conns = append(conns, session.doctorConn)
+		}
+		conns = append(conns, session.observerConns()...)
+	}
+	return conns
 }
 
 func (s *WebSocketServer) Close() error {
 	// Cancel the context to stop the Kafka consumer
 	s.cancelFunc()
 
-	// Close the Kafka reader
+	var errs []error
+	// Close the Kafka reader, this also flushes pending offset commits
 	if err := s.reader.Close(); err != nil {
-		return fmt.Errorf("failed to close kafka reader: %v", err)
+		errs = append(errs, fmt.Errorf("failed to close kafka reader: %v", err))
+	}
+	if err := s.writer.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close kafka writer: %v", err))
+	}
+	if err := s.slaWriter.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close sla breach writer: %v", err))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("close errors: %v", errs)
 	}
 	return nil
 }
@@ -0,0 +1,339 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// FieldCipher encrypts column values for storage
+type FieldCipher interface {
+	Encrypt(ctx context.Context, field Field, plaintext string) (string, error)
+	// Decrypt returns value unchanged when it is not encrypted, and fails
+	// for a value encrypted for another field
+	Decrypt(ctx context.Context, field Field, value string) (string, error)
+}
+
+// Field is where an encrypted value is stored. Values are bound to it, so
+// one copied to another column or row does not decrypt.
+type Field struct {
+	Table  string
+	Column string
+	Row    pgtype.UUID
+}
+
+func ChatMessageContentField(id pgtype.UUID) Field {
+	return Field{Table: "chat_messages", Column: "content", Row: id}
+}
+
+func MedicalHistoryNotesField(id pgtype.UUID) Field {
+	return Field{Table: "medical_history", Column: "notes", Row: id}
+}
+
+// AIInteractionField locates a column of ai_interactions. Interactions are
+// written and reviewed by the draft message they produced, so that is their
+// row.
+func AIInteractionField(column string, chatMessageID pgtype.UUID) Field {
+	return Field{Table: "ai_interactions", Column: column, Row: chatMessageID}
+}
+
+// EncryptedQueries encrypts chat_messages.content, medical_history.notes and
+// the ai_response, modified_content and prompt_components of ai_interactions
+// on write and decrypts them on read. All other queries are passed through.
+// A nil cipher stores plaintext.
+type EncryptedQueries struct {
+	*Queries
+	cipher FieldCipher
+}
+
+func NewEncrypted(q *Queries, cipher FieldCipher) *EncryptedQueries {
+	return &EncryptedQueries{Queries: q, cipher: cipher}
+}
+
+func (q *EncryptedQueries) WithTx(tx pgx.Tx) *EncryptedQueries {
+	return &EncryptedQueries{Queries: q.Queries.WithTx(tx), cipher: q.cipher}
+}
+
+func (q *EncryptedQueries) encrypt(ctx context.Context, field Field, value *string) error {
+	if q.cipher == nil {
+		return nil
+	}
+	encrypted, err := q.cipher.Encrypt(ctx, field, *value)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt: %v", err)
+	}
+	*value = encrypted
+	return nil
+}
+
+func (q *EncryptedQueries) decrypt(ctx context.Context, field Field, value *string) error {
+	if q.cipher == nil {
+		return nil
+	}
+	decrypted, err := q.cipher.Decrypt(ctx, field, *value)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt: %v", err)
+	}
+	*value = decrypted
+	return nil
+}
+
+func (q *EncryptedQueries) encryptText(ctx context.Context, field Field, value *pgtype.Text) error {
+	if !value.Valid {
+		return nil
+	}
+	return q.encrypt(ctx, field, &value.String)
+}
+
+func (q *EncryptedQueries) decryptText(ctx context.Context, field Field, value *pgtype.Text) error {
+	if !value.Valid {
+		return nil
+	}
+	return q.decrypt(ctx, field, &value.String)
+}
+
+// encryptJSON stores a JSONB value as a JSON string holding the ciphertext
+func (q *EncryptedQueries) encryptJSON(ctx context.Context, field Field, value *[]byte) error {
+	if q.cipher == nil {
+		return nil
+	}
+	encrypted := string(*value)
+	if err := q.encrypt(ctx, field, &encrypted); err != nil {
+		return err
+	}
+	data, err := json.Marshal(encrypted)
+	if err != nil {
+		return fmt.Errorf("failed to encode encrypted value: %v", err)
+	}
+	*value = data
+	return nil
+}
+
+// decryptJSON reverses encryptJSON. Other JSON values were stored before
+// encryption was enabled and are kept.
+func (q *EncryptedQueries) decryptJSON(ctx context.Context, field Field, value *[]byte) error {
+	var encrypted string
+	if q.cipher == nil || json.Unmarshal(*value, &encrypted) != nil {
+		return nil
+	}
+	if err := q.decrypt(ctx, field, &encrypted); err != nil {
+		return err
+	}
+	*value = []byte(encrypted)
+	return nil
+}
+
+// CreateChatMessage generates the message ID if arg has none, the content is
+// bound to it
+func (q *EncryptedQueries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error) {
+	if !arg.ID.Valid {
+		arg.ID = pg.NewUUID()
+	}
+	content := arg.Content
+	if err := q.encrypt(ctx, ChatMessageContentField(arg.ID), &arg.Content); err != nil {
+		return ChatMessage{}, err
+	}
+	msg, err := q.Queries.CreateChatMessage(ctx, arg)
+	msg.Content = content
+	return msg, err
+}
+
+func (q *EncryptedQueries) CreateDraftMessage(ctx context.Context, arg CreateDraftMessageParams) error {
+	if err := q.encrypt(ctx, ChatMessageContentField(arg.ID), &arg.Content); err != nil {
+		return err
+	}
+	return q.Queries.CreateDraftMessage(ctx, arg)
+}
+
+func (q *EncryptedQueries) CreateTrackedMessage(ctx context.Context, arg CreateTrackedMessageParams) error {
+	if err := q.encrypt(ctx, ChatMessageContentField(arg.ID), &arg.Content); err != nil {
+		return err
+	}
+	return q.Queries.CreateTrackedMessage(ctx, arg)
+}
+
+func (q *EncryptedQueries) CreateAIInteraction(ctx context.Context, arg CreateAIInteractionParams) error {
+	if err := q.encrypt(ctx, AIInteractionField("ai_response", arg.ChatMessageID), &arg.AiResponse); err != nil {
+		return err
+	}
+	if err := q.encryptJSON(ctx, AIInteractionField("prompt_components", arg.ChatMessageID), &arg.PromptComponents); err != nil {
+		return err
+	}
+	return q.Queries.CreateAIInteraction(ctx, arg)
+}
+
+func (q *EncryptedQueries) UpdateAIInteractionReview(ctx context.Context, arg UpdateAIInteractionReviewParams) (int64, error) {
+	if err := q.encryptText(ctx, AIInteractionField("modified_content", arg.ChatMessageID), &arg.ModifiedContent); err != nil {
+		return 0, err
+	}
+	return q.Queries.UpdateAIInteractionReview(ctx, arg)
+}
+
+// decryptInteraction decrypts the columns of an ai_interactions row
+func (q *EncryptedQueries) decryptInteraction(ctx context.Context, chatMessageID pgtype.UUID, aiResponse *string, modifiedContent *pgtype.Text) error {
+	if err := q.decrypt(ctx, AIInteractionField("ai_response", chatMessageID), aiResponse); err != nil {
+		return err
+	}
+	return q.decryptText(ctx, AIInteractionField("modified_content", chatMessageID), modifiedContent)
+}
+
+func (q *EncryptedQueries) GetAITrainingData(ctx context.Context, arg GetAITrainingDataParams) ([]GetAITrainingDataRow, error) {
+	rows, err := q.Queries.GetAITrainingData(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		row := &rows[i]
+		if err := q.decryptInteraction(ctx, row.ChatMessageID, &row.AiResponse, &row.ModifiedContent); err != nil {
+			return nil, err
+		}
+		if err := q.decryptJSON(ctx, AIInteractionField("prompt_components", row.ChatMessageID), &row.PromptComponents); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (q *EncryptedQueries) ListAIInteractionsForAnalytics(ctx context.Context, arg ListAIInteractionsForAnalyticsParams) ([]ListAIInteractionsForAnalyticsRow, error) {
+	rows, err := q.Queries.ListAIInteractionsForAnalytics(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decryptInteraction(ctx, rows[i].ChatMessageID, &rows[i].AiResponse, &rows[i].ModifiedContent); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// AddMedicalHistory generates the entry ID if arg has none, the notes are
+// bound to it
+func (q *EncryptedQueries) AddMedicalHistory(ctx context.Context, arg AddMedicalHistoryParams) (AddMedicalHistoryRow, error) {
+	if !arg.ID.Valid {
+		arg.ID = pg.NewUUID()
+	}
+	notes := arg.Notes
+	if err := q.encryptText(ctx, MedicalHistoryNotesField(arg.ID), &arg.Notes); err != nil {
+		return AddMedicalHistoryRow{}, err
+	}
+	row, err := q.Queries.AddMedicalHistory(ctx, arg)
+	row.Notes = notes
+	return row, err
+}
+
+// CreateMedicalHistory leaves the entry ID to the database, so there is no
+// row to bind encrypted notes to. Use AddMedicalHistory.
+func (q *EncryptedQueries) CreateMedicalHistory(ctx context.Context, arg CreateMedicalHistoryParams) (MedicalHistory, error) {
+	if q.cipher != nil && arg.Notes.Valid {
+		return MedicalHistory{}, errors.New("CreateMedicalHistory cannot encrypt notes, use AddMedicalHistory")
+	}
+	return q.Queries.CreateMedicalHistory(ctx, arg)
+}
+
+func (q *EncryptedQueries) UpdateMedicalHistory(ctx context.Context, arg UpdateMedicalHistoryParams) (UpdateMedicalHistoryRow, error) {
+	if err := q.encryptText(ctx, MedicalHistoryNotesField(arg.ID), &arg.Notes); err != nil {
+		return UpdateMedicalHistoryRow{}, err
+	}
+	// Notes are kept when not updated, decrypt what is stored
+	row, err := q.Queries.UpdateMedicalHistory(ctx, arg)
+	if err != nil {
+		return row, err
+	}
+	return row, q.decryptText(ctx, MedicalHistoryNotesField(row.ID), &row.Notes)
+}
+
+func (q *EncryptedQueries) UpdateMedicalHistoryStatus(ctx context.Context, arg UpdateMedicalHistoryStatusParams) (MedicalHistory, error) {
+	if err := q.encryptText(ctx, MedicalHistoryNotesField(arg.ID), &arg.Notes); err != nil {
+		return MedicalHistory{}, err
+	}
+	row, err := q.Queries.UpdateMedicalHistoryStatus(ctx, arg)
+	if err != nil {
+		return row, err
+	}
+	return row, q.decryptText(ctx, MedicalHistoryNotesField(row.ID), &row.Notes)
+}
+
+func (q *EncryptedQueries) GetPatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) ([]GetPatientMedicalHistoryRow, error) {
+	rows, err := q.Queries.GetPatientMedicalHistory(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decryptText(ctx, MedicalHistoryNotesField(rows[i].ID), &rows[i].Notes); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (q *EncryptedQueries) GetActiveMedicalConditions(ctx context.Context, patientID pgtype.UUID) ([]MedicalHistory, error) {
+	rows, err := q.Queries.GetActiveMedicalConditions(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decryptText(ctx, MedicalHistoryNotesField(rows[i].ID), &rows[i].Notes); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (q *EncryptedQueries) ListMedicalHistory(ctx context.Context, arg ListMedicalHistoryParams) ([]ListMedicalHistoryRow, error) {
+	rows, err := q.Queries.ListMedicalHistory(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decryptText(ctx, MedicalHistoryNotesField(rows[i].ID), &rows[i].Notes); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (q *EncryptedQueries) ListAIInteractionsToDeidentify(ctx context.Context, arg ListAIInteractionsToDeidentifyParams) ([]ListAIInteractionsToDeidentifyRow, error) {
+	rows, err := q.Queries.ListAIInteractionsToDeidentify(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decryptInteraction(ctx, rows[i].ChatMessageID, &rows[i].AiResponse, &rows[i].ModifiedContent); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (q *EncryptedQueries) ListPatientChatMessages(ctx context.Context, patientID pgtype.UUID) ([]ListPatientChatMessagesRow, error) {
+	rows, err := q.Queries.ListPatientChatMessages(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decrypt(ctx, ChatMessageContentField(rows[i].ID), &rows[i].Content); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (q *EncryptedQueries) ListMedicalHistoryForErasure(ctx context.Context, patientID pgtype.UUID) ([]ListMedicalHistoryForErasureRow, error) {
+	rows, err := q.Queries.ListMedicalHistoryForErasure(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if err := q.decryptText(ctx, MedicalHistoryNotesField(rows[i].ID), &rows[i].Notes); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
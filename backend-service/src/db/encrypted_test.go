@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	pg "llm-qa-system/backend-service/utils"
+)
+
+// fieldCipher "encrypts" by tagging values with their field, and refuses
+// values tagged for another
+type fieldCipher struct{}
+
+func (fieldCipher) tag(field Field) string {
+	return "enc:" + field.Table + "." + field.Column + "." + pg.ToUUID(field.Row).String() + ":"
+}
+
+func (c fieldCipher) Encrypt(ctx context.Context, field Field, plaintext string) (string, error) {
+	return c.tag(field) + plaintext, nil
+}
+
+func (c fieldCipher) Decrypt(ctx context.Context, field Field, value string) (string, error) {
+	if !strings.HasPrefix(value, "enc:") {
+		return value, nil
+	}
+	plaintext, ok := strings.CutPrefix(value, c.tag(field))
+	if !ok {
+		return "", errors.New("wrong field")
+	}
+	return plaintext, nil
+}
+
+func TestPromptComponentsEncryption(t *testing.T) {
+	ctx := context.Background()
+	q := NewEncrypted(nil, fieldCipher{})
+	field := AIInteractionField("prompt_components", pg.NewUUID())
+	components := []byte(`{"question": "Is a fever of 39C dangerous?"}`)
+
+	value := append([]byte(nil), components...)
+	if err := q.encryptJSON(ctx, field, &value); err != nil {
+		t.Fatal(err)
+	}
+	var encrypted string
+	if err := json.Unmarshal(value, &encrypted); err != nil {
+		t.Fatalf("encryptJSON() = %s, want a JSON string: %v", value, err)
+	}
+	if !strings.HasPrefix(encrypted, "enc:") {
+		t.Fatalf("encryptJSON() = %s, want it encrypted", value)
+	}
+
+	tests := []struct {
+		name    string
+		field   Field
+		value   []byte
+		want    string
+		wantErr bool
+	}{
+		{"encrypted", field, value, string(components), false},
+		{"stored before encryption", field, components, string(components), false},
+		{"other row", AIInteractionField("prompt_components", pg.NewUUID()), value, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := append([]byte(nil), tt.value...)
+			err := q.decryptJSON(ctx, tt.field, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decryptJSON() error = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Fatalf("decryptJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlaintextWithoutCipher(t *testing.T) {
+	q := NewEncrypted(nil, nil)
+	value := []byte(`{"question": "q"}`)
+	if err := q.encryptJSON(context.Background(), AIInteractionField("prompt_components", pg.NewUUID()), &value); err != nil || string(value) != `{"question": "q"}` {
+		t.Fatalf("encryptJSON() without a cipher = %s, %v, want it unchanged", value, err)
+	}
+}
@@ -22,6 +22,20 @@ type Answer struct {
 	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
 }
 
+type AuditEvent struct {
+	Seq        int64              `json:"seq"`
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	Action     string             `json:"action"`
+	ActorRole  string             `json:"actor_role"`
+	ActorID    string             `json:"actor_id"`
+	PatientID  pgtype.UUID        `json:"patient_id"`
+	SessionID  string             `json:"session_id"`
+	Resource   string             `json:"resource"`
+	Details    []byte             `json:"details"`
+	PrevHash   []byte             `json:"prev_hash"`
+	Hash       []byte             `json:"hash"`
+}
+
 type BiometricDatum struct {
 	ID         pgtype.UUID        `json:"id"`
 	PatientID  pgtype.UUID        `json:"patient_id"`
@@ -32,6 +46,25 @@ type BiometricDatum struct {
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
 
+type ChatMessage struct {
+	ID              pgtype.UUID        `json:"id"`
+	ChatSessionID   pgtype.UUID        `json:"chat_session_id"`
+	SenderID        pgtype.UUID        `json:"sender_id"`
+	Content         string             `json:"content"`
+	MessageType     string             `json:"message_type"`
+	ParentMessageID pgtype.UUID        `json:"parent_message_id"`
+	Metadata        []byte             `json:"metadata"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+type ChatSession struct {
+	ID        pgtype.UUID        `json:"id"`
+	PatientID pgtype.UUID        `json:"patient_id"`
+	Status    string             `json:"status"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ClosedAt  pgtype.Timestamptz `json:"closed_at"`
+}
+
 type Doctor struct {
 	ID         pgtype.UUID        `json:"id"`
 	Name       string             `json:"name"`
@@ -40,6 +73,13 @@ type Doctor struct {
 	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
 
+type EncryptionDataKey struct {
+	ID         pgtype.UUID        `json:"id"`
+	KeyID      string             `json:"key_id"`
+	WrappedKey []byte             `json:"wrapped_key"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
 type MedicalHistory struct {
 	ID            pgtype.UUID        `json:"id"`
 	PatientID     pgtype.UUID        `json:"patient_id"`
@@ -50,6 +90,15 @@ type MedicalHistory struct {
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
+type MessageDelivery struct {
+	MessageID     pgtype.UUID        `json:"message_id"`
+	RecipientRole string             `json:"recipient_role"`
+	Status        string             `json:"status"`
+	QueuedAt      pgtype.Timestamptz `json:"queued_at"`
+	DeliveredAt   pgtype.Timestamptz `json:"delivered_at"`
+	ReadAt        pgtype.Timestamptz `json:"read_at"`
+}
+
 type Patient struct {
 	ID        pgtype.UUID        `json:"id"`
 	Name      string             `json:"name"`
@@ -71,3 +120,53 @@ type Question struct {
 	AnsweredAt   pgtype.Timestamptz `json:"answered_at"`
 	AnsweredBy   pgtype.UUID        `json:"answered_by"`
 }
+
+type RefBiometricType struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	UnitType    string             `json:"unit_type"`
+	Description pgtype.Text        `json:"description"`
+	Active      pgtype.Bool        `json:"active"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type RefDepartment struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description pgtype.Text        `json:"description"`
+	Active      pgtype.Bool        `json:"active"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type RefGender struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description pgtype.Text        `json:"description"`
+	Active      pgtype.Bool        `json:"active"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type RefMedicalConditionStatus struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description pgtype.Text        `json:"description"`
+	Active      pgtype.Bool        `json:"active"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type RefPromptTemplate struct {
+	Version          string             `json:"version"`
+	Template         string             `json:"template"`
+	Description      pgtype.Text        `json:"description"`
+	IsActive         pgtype.Bool        `json:"is_active"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ExperimentWeight int32              `json:"experiment_weight"`
+}
+
+type User struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
@@ -11,30 +11,150 @@ import (
 )
 
 type Querier interface {
+	// Activating a version deactivates the others
+	ActivatePromptTemplate(ctx context.Context, version string) error
+	// Medical History
+	AddMedicalHistory(ctx context.Context, arg AddMedicalHistoryParams) (AddMedicalHistoryRow, error)
+	AnonymizeUser(ctx context.Context, id pgtype.UUID) error
+	ClearChatMessageContent(ctx context.Context, id pgtype.UUID) error
+	ClearPromptExperiment(ctx context.Context) error
+	// AI Interactions
+	CreateAIInteraction(ctx context.Context, arg CreateAIInteractionParams) error
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (int64, error)
 	// Biometric Data Operations
 	CreateBiometricData(ctx context.Context, arg CreateBiometricDataParams) (BiometricDatum, error)
+	// Chat Messages
+	CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error)
+	// Chat Session Management
+	CreateChatSession(ctx context.Context, patientID pgtype.UUID) (ChatSession, error)
+	CreateDataKey(ctx context.Context, arg CreateDataKeyParams) (pgtype.UUID, error)
+	// Doctor related queries
+	// Creating a deleted doctor restores them
+	CreateDoctor(ctx context.Context, arg CreateDoctorParams) (CreateDoctorRow, error)
+	// Drafts are stored under the message ID they were published with
+	CreateDraftMessage(ctx context.Context, arg CreateDraftMessageParams) error
 	// Medical History Operations
 	CreateMedicalHistory(ctx context.Context, arg CreateMedicalHistoryParams) (MedicalHistory, error)
+	// Patient related queries
+	// Creating a deleted patient restores them
+	CreatePatient(ctx context.Context, arg CreatePatientParams) (CreatePatientRow, error)
+	// Prompt Template Management
+	CreatePromptTemplate(ctx context.Context, arg CreatePromptTemplateParams) (RefPromptTemplate, error)
 	// Patient operations
 	CreateQuestion(ctx context.Context, arg CreateQuestionParams) (Question, error)
+	// Delivery tracking
+	// Messages between patient and doctor are stored under the ID their
+	// recipient acknowledges, with a queued delivery
+	CreateTrackedMessage(ctx context.Context, arg CreateTrackedMessageParams) error
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeidentifyAIInteraction(ctx context.Context, arg DeidentifyAIInteractionParams) error
+	// Readings measured before the cutoff
+	DeleteBiometricsBefore(ctx context.Context, arg DeleteBiometricsBeforeParams) (int64, error)
+	DeletePatientBiometrics(ctx context.Context, patientID pgtype.UUID) (int64, error)
+	DeletePatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) (int64, error)
+	DeleteSessionMessages(ctx context.Context, sessionIds []pgtype.UUID) (int64, error)
+	// Ages over 89 are identifying and are kept as 90
+	ErasePatient(ctx context.Context, userID pgtype.UUID) error
+	// Per template version, drafts of the LLM service's default prompt under ""
+	GetAIInteractionStatsByTemplate(ctx context.Context, arg GetAIInteractionStatsByTemplateParams) ([]GetAIInteractionStatsByTemplateRow, error)
+	// Training Data Collection
+	GetAITrainingData(ctx context.Context, arg GetAITrainingDataParams) ([]GetAITrainingDataRow, error)
 	GetActiveMedicalConditions(ctx context.Context, patientID pgtype.UUID) ([]MedicalHistory, error)
+	GetActivePromptTemplate(ctx context.Context) (GetActivePromptTemplateRow, error)
 	GetAnswerHistory(ctx context.Context, arg GetAnswerHistoryParams) ([]GetAnswerHistoryRow, error)
 	GetAnswerHistoryCount(ctx context.Context, arg GetAnswerHistoryCountParams) (int64, error)
+	GetChatSession(ctx context.Context, id pgtype.UUID) (ChatSession, error)
+	GetDataKey(ctx context.Context, id pgtype.UUID) (EncryptionDataKey, error)
+	GetDoctorByUserID(ctx context.Context, id pgtype.UUID) (GetDoctorByUserIDRow, error)
+	// Draft outcomes, confidence, review latency and the edit distances kept
+	// at de-identification, per UTC bucket of date_trunc and group ('doctor',
+	// 'department' or 'prompt_version'). An empty bucket or group puts every
+	// draft in one.
+	GetDraftQualityStats(ctx context.Context, arg GetDraftQualityStatsParams) ([]GetDraftQualityStatsRow, error)
+	GetLastAuditEvent(ctx context.Context) (GetLastAuditEventRow, error)
+	GetLatestBiometrics(ctx context.Context, patientID pgtype.UUID) ([]GetLatestBiometricsRow, error)
 	GetLatestBiometricsByType(ctx context.Context, patientID pgtype.UUID) ([]BiometricDatum, error)
+	// Newest data key wrapped by a key encryption key
+	GetLatestDataKey(ctx context.Context, keyID string) (EncryptionDataKey, error)
 	GetPatientBiometricData(ctx context.Context, arg GetPatientBiometricDataParams) ([]BiometricDatum, error)
-	GetPatientMedicalHistory(ctx context.Context, arg GetPatientMedicalHistoryParams) ([]MedicalHistory, error)
+	GetPatientByUserID(ctx context.Context, id pgtype.UUID) (GetPatientByUserIDRow, error)
+	// Patients are locked until the erasure commits, deleted ones included
+	GetPatientForErasure(ctx context.Context, id pgtype.UUID) (GetPatientForErasureRow, error)
+	GetPatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) ([]GetPatientMedicalHistoryRow, error)
 	// Patient Context Operations
 	GetPatientWithContext(ctx context.Context, id pgtype.UUID) (GetPatientWithContextRow, error)
 	// Doctor operations
 	GetPendingReviews(ctx context.Context, arg GetPendingReviewsParams) ([]GetPendingReviewsRow, error)
 	GetPendingReviewsCount(ctx context.Context, arg GetPendingReviewsCountParams) (int64, error)
+	GetPromptTemplate(ctx context.Context, version string) (RefPromptTemplate, error)
 	GetQuestionStatus(ctx context.Context, arg GetQuestionStatusParams) (GetQuestionStatusRow, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	IngestBiometricReading(ctx context.Context, arg IngestBiometricReadingParams) (int64, error)
+	ListAIInteractionModifiedContent(ctx context.Context, arg ListAIInteractionModifiedContentParams) ([]ListAIInteractionModifiedContentRow, error)
+	ListAIInteractionPromptComponents(ctx context.Context, arg ListAIInteractionPromptComponentsParams) ([]ListAIInteractionPromptComponentsRow, error)
+	ListAIInteractionResponses(ctx context.Context, arg ListAIInteractionResponsesParams) ([]ListAIInteractionResponsesRow, error)
+	// A random sample of up to sample_size of the accepted and modified drafts
+	// of each group that still have their texts, bucketed and grouped as in
+	// GetDraftQualityStats, to estimate their edit distance from
+	ListAIInteractionsForAnalytics(ctx context.Context, arg ListAIInteractionsForAnalyticsParams) ([]ListAIInteractionsForAnalyticsRow, error)
+	// AI interactions still holding draft text, created before a cutoff or
+	// belonging to the given sessions
+	ListAIInteractionsToDeidentify(ctx context.Context, arg ListAIInteractionsToDeidentifyParams) ([]ListAIInteractionsToDeidentifyRow, error)
+	// Reference Data queries
+	ListActiveBiometricTypes(ctx context.Context) ([]RefBiometricType, error)
+	ListActiveConditionStatuses(ctx context.Context) ([]RefMedicalConditionStatus, error)
+	ListActiveDepartments(ctx context.Context) ([]RefDepartment, error)
+	ListActiveGenders(ctx context.Context) ([]RefGender, error)
+	// Events of a patient or an actor, oldest first
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	// Events of the chain after seq, for verification
+	ListAuditEventsAfter(ctx context.Context, arg ListAuditEventsAfterParams) ([]AuditEvent, error)
+	// Re-encryption batches, in ID order. Updates only apply if the value was
+	// not changed since it was read.
+	ListChatMessageContent(ctx context.Context, arg ListChatMessageContentParams) ([]ListChatMessageContentRow, error)
+	ListDoctors(ctx context.Context, arg ListDoctorsParams) ([]ListDoctorsRow, error)
+	// Sessions with messages but none since the cutoff
+	ListInactiveChatSessions(ctx context.Context, arg ListInactiveChatSessionsParams) ([]pgtype.UUID, error)
+	ListMedicalHistory(ctx context.Context, arg ListMedicalHistoryParams) ([]ListMedicalHistoryRow, error)
+	ListMedicalHistoryForErasure(ctx context.Context, patientID pgtype.UUID) ([]ListMedicalHistoryForErasureRow, error)
+	ListMedicalHistoryNotes(ctx context.Context, arg ListMedicalHistoryNotesParams) ([]ListMedicalHistoryNotesRow, error)
+	ListPatientBiometrics(ctx context.Context, patientID pgtype.UUID) ([]ListPatientBiometricsRow, error)
+	ListPatientChatMessages(ctx context.Context, patientID pgtype.UUID) ([]ListPatientChatMessagesRow, error)
+	ListPatientChatSessions(ctx context.Context, patientID pgtype.UUID) ([]ListPatientChatSessionsRow, error)
+	ListPatients(ctx context.Context, arg ListPatientsParams) ([]ListPatientsRow, error)
+	ListPromptExperimentArms(ctx context.Context) ([]ListPromptExperimentArmsRow, error)
+	ListPromptTemplates(ctx context.Context) ([]RefPromptTemplate, error)
+	ListRecentBiometrics(ctx context.Context, arg ListRecentBiometricsParams) ([]ListRecentBiometricsRow, error)
+	// Serializes appends to the audit chain until the transaction ends
+	LockAuditLog(ctx context.Context) error
+	MarkMessageDelivered(ctx context.Context, messageID pgtype.UUID) error
+	// Reading a message implies it was delivered
+	MarkMessageRead(ctx context.Context, messageID pgtype.UUID) error
+	RecordAIInteractionSLABreach(ctx context.Context, arg RecordAIInteractionSLABreachParams) (int64, error)
 	SaveAIDraftAnswer(ctx context.Context, arg SaveAIDraftAnswerParams) (Answer, error)
+	SetPromptExperimentWeight(ctx context.Context, arg SetPromptExperimentWeightParams) (int64, error)
+	SoftDeleteDoctor(ctx context.Context, userID pgtype.UUID) (int64, error)
+	SoftDeleteMedicalHistory(ctx context.Context, id pgtype.UUID) (int64, error)
+	SoftDeletePatient(ctx context.Context, userID pgtype.UUID) (int64, error)
+	SoftDeletePatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) error
 	SubmitReview(ctx context.Context, arg SubmitReviewParams) (Answer, error)
+	UpdateAIInteractionModifiedContent(ctx context.Context, arg UpdateAIInteractionModifiedContentParams) (int64, error)
+	UpdateAIInteractionPromptComponents(ctx context.Context, arg UpdateAIInteractionPromptComponentsParams) (int64, error)
+	UpdateAIInteractionResponse(ctx context.Context, arg UpdateAIInteractionResponseParams) (int64, error)
+	// Reviews by users who are not doctors are stored without a reviewer. Only
+	// drafts of the reviewer's chat session are updated.
+	UpdateAIInteractionReview(ctx context.Context, arg UpdateAIInteractionReviewParams) (int64, error)
+	UpdateChatMessageContent(ctx context.Context, arg UpdateChatMessageContentParams) (int64, error)
+	UpdateChatSessionStatus(ctx context.Context, arg UpdateChatSessionStatusParams) error
+	UpdateDoctor(ctx context.Context, arg UpdateDoctorParams) (UpdateDoctorRow, error)
+	UpdateMedicalHistory(ctx context.Context, arg UpdateMedicalHistoryParams) (UpdateMedicalHistoryRow, error)
+	UpdateMedicalHistoryNotes(ctx context.Context, arg UpdateMedicalHistoryNotesParams) (int64, error)
 	UpdateMedicalHistoryStatus(ctx context.Context, arg UpdateMedicalHistoryStatusParams) (MedicalHistory, error)
+	UpdatePatient(ctx context.Context, arg UpdatePatientParams) (UpdatePatientRow, error)
 	// Patient Demographics Update
 	UpdatePatientDemographics(ctx context.Context, arg UpdatePatientDemographicsParams) (Patient, error)
 	UpdateQuestionStatus(ctx context.Context, arg UpdateQuestionStatusParams) error
+	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -11,6 +11,188 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const activatePromptTemplate = `-- name: ActivatePromptTemplate :exec
+UPDATE ref_prompt_templates
+SET 
+    is_active = (version = $1),
+    updated_at = CURRENT_TIMESTAMP
+WHERE is_active = true
+OR version = $1
+`
+
+// Activating a version deactivates the others
+func (q *Queries) ActivatePromptTemplate(ctx context.Context, version string) error {
+	_, err := q.db.Exec(ctx, activatePromptTemplate, version)
+	return err
+}
+
+const addMedicalHistory = `-- name: AddMedicalHistory :one
+INSERT INTO medical_history (
+    id,
+    patient_id,
+    condition,
+    diagnosed_date,
+    status_id,
+    notes
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, patient_id, condition, diagnosed_date, status_id, notes, created_at
+`
+
+type AddMedicalHistoryParams struct {
+	ID            pgtype.UUID        `json:"id"`
+	PatientID     pgtype.UUID        `json:"patient_id"`
+	Condition     string             `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      string             `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+}
+
+type AddMedicalHistoryRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	PatientID     pgtype.UUID        `json:"patient_id"`
+	Condition     string             `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      string             `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+// Medical History
+func (q *Queries) AddMedicalHistory(ctx context.Context, arg AddMedicalHistoryParams) (AddMedicalHistoryRow, error) {
+	row := q.db.QueryRow(ctx, addMedicalHistory,
+		arg.ID,
+		arg.PatientID,
+		arg.Condition,
+		arg.DiagnosedDate,
+		arg.StatusID,
+		arg.Notes,
+	)
+	var i AddMedicalHistoryRow
+	err := row.Scan(
+		&i.ID,
+		&i.PatientID,
+		&i.Condition,
+		&i.DiagnosedDate,
+		&i.StatusID,
+		&i.Notes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const anonymizeUser = `-- name: AnonymizeUser :exec
+UPDATE users
+SET 
+    name = 'Erased patient',
+    email = 'erased-' || id || '@erased.invalid'
+WHERE id = $1
+`
+
+func (q *Queries) AnonymizeUser(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeUser, id)
+	return err
+}
+
+const clearChatMessageContent = `-- name: ClearChatMessageContent :exec
+UPDATE chat_messages
+SET content = '', metadata = NULL
+WHERE id = $1
+`
+
+func (q *Queries) ClearChatMessageContent(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, clearChatMessageContent, id)
+	return err
+}
+
+const clearPromptExperiment = `-- name: ClearPromptExperiment :exec
+UPDATE ref_prompt_templates
+SET 
+    experiment_weight = 0,
+    updated_at = CURRENT_TIMESTAMP
+WHERE experiment_weight > 0
+`
+
+func (q *Queries) ClearPromptExperiment(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, clearPromptExperiment)
+	return err
+}
+
+const createAIInteraction = `-- name: CreateAIInteraction :exec
+INSERT INTO ai_interactions (
+    chat_message_id,
+    prompt_template_version,
+    prompt_components,
+    ai_response,
+    confidence_score,
+    references
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+type CreateAIInteractionParams struct {
+	ChatMessageID         pgtype.UUID   `json:"chat_message_id"`
+	PromptTemplateVersion pgtype.Text   `json:"prompt_template_version"`
+	PromptComponents      []byte        `json:"prompt_components"`
+	AiResponse            string        `json:"ai_response"`
+	ConfidenceScore       pgtype.Float8 `json:"confidence_score"`
+	References            []string      `json:"references"`
+}
+
+// AI Interactions
+func (q *Queries) CreateAIInteraction(ctx context.Context, arg CreateAIInteractionParams) error {
+	_, err := q.db.Exec(ctx, createAIInteraction,
+		arg.ChatMessageID,
+		arg.PromptTemplateVersion,
+		arg.PromptComponents,
+		arg.AiResponse,
+		arg.ConfidenceScore,
+		arg.References,
+	)
+	return err
+}
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    occurred_at, action, actor_role, actor_id, patient_id, session_id, resource, details, prev_hash, hash
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+RETURNING seq
+`
+
+type CreateAuditEventParams struct {
+	OccurredAt pgtype.Timestamptz `json:"occurred_at"`
+	Action     string             `json:"action"`
+	ActorRole  string             `json:"actor_role"`
+	ActorID    string             `json:"actor_id"`
+	PatientID  pgtype.UUID        `json:"patient_id"`
+	SessionID  string             `json:"session_id"`
+	Resource   string             `json:"resource"`
+	Details    []byte             `json:"details"`
+	PrevHash   []byte             `json:"prev_hash"`
+	Hash       []byte             `json:"hash"`
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createAuditEvent,
+		arg.OccurredAt,
+		arg.Action,
+		arg.ActorRole,
+		arg.ActorID,
+		arg.PatientID,
+		arg.SessionID,
+		arg.Resource,
+		arg.Details,
+		arg.PrevHash,
+		arg.Hash,
+	)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
 const createBiometricData = `-- name: CreateBiometricData :one
 INSERT INTO biometric_data (
     patient_id,
@@ -53,6 +235,182 @@ func (q *Queries) CreateBiometricData(ctx context.Context, arg CreateBiometricDa
 	return i, err
 }
 
+const createChatMessage = `-- name: CreateChatMessage :one
+INSERT INTO chat_messages (
+    id,
+    chat_session_id,
+    sender_id,
+    content,
+    message_type,
+    parent_message_id,
+    metadata
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, chat_session_id, sender_id, content, message_type, parent_message_id, metadata, created_at
+`
+
+type CreateChatMessageParams struct {
+	ID              pgtype.UUID `json:"id"`
+	ChatSessionID   pgtype.UUID `json:"chat_session_id"`
+	SenderID        pgtype.UUID `json:"sender_id"`
+	Content         string      `json:"content"`
+	MessageType     string      `json:"message_type"`
+	ParentMessageID pgtype.UUID `json:"parent_message_id"`
+	Metadata        []byte      `json:"metadata"`
+}
+
+// Chat Messages
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error) {
+	row := q.db.QueryRow(ctx, createChatMessage,
+		arg.ID,
+		arg.ChatSessionID,
+		arg.SenderID,
+		arg.Content,
+		arg.MessageType,
+		arg.ParentMessageID,
+		arg.Metadata,
+	)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.ChatSessionID,
+		&i.SenderID,
+		&i.Content,
+		&i.MessageType,
+		&i.ParentMessageID,
+		&i.Metadata,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createChatSession = `-- name: CreateChatSession :one
+INSERT INTO chat_sessions (
+    patient_id,
+    status
+) VALUES (
+    $1,
+    'CHAT_SESSION_STATUS_OPEN'
+) RETURNING id, patient_id, status, created_at, closed_at
+`
+
+// Chat Session Management
+func (q *Queries) CreateChatSession(ctx context.Context, patientID pgtype.UUID) (ChatSession, error) {
+	row := q.db.QueryRow(ctx, createChatSession, patientID)
+	var i ChatSession
+	err := row.Scan(
+		&i.ID,
+		&i.PatientID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const createDataKey = `-- name: CreateDataKey :one
+INSERT INTO encryption_data_keys (key_id, wrapped_key)
+VALUES ($1, $2)
+RETURNING id
+`
+
+type CreateDataKeyParams struct {
+	KeyID      string `json:"key_id"`
+	WrappedKey []byte `json:"wrapped_key"`
+}
+
+func (q *Queries) CreateDataKey(ctx context.Context, arg CreateDataKeyParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, createDataKey, arg.KeyID, arg.WrappedKey)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createDoctor = `-- name: CreateDoctor :one
+INSERT INTO doctors (
+    user_id,
+    department_id,
+    specialization,
+    years_of_experience
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (user_id) DO UPDATE
+SET 
+    department_id = EXCLUDED.department_id,
+    specialization = EXCLUDED.specialization,
+    years_of_experience = EXCLUDED.years_of_experience,
+    deleted_at = NULL
+WHERE doctors.deleted_at IS NOT NULL
+RETURNING user_id, department_id, specialization, years_of_experience, created_at
+`
+
+type CreateDoctorParams struct {
+	UserID            pgtype.UUID `json:"user_id"`
+	DepartmentID      pgtype.Text `json:"department_id"`
+	Specialization    []string    `json:"specialization"`
+	YearsOfExperience pgtype.Int4 `json:"years_of_experience"`
+}
+
+type CreateDoctorRow struct {
+	UserID            pgtype.UUID        `json:"user_id"`
+	DepartmentID      pgtype.Text        `json:"department_id"`
+	Specialization    []string           `json:"specialization"`
+	YearsOfExperience pgtype.Int4        `json:"years_of_experience"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+// Doctor related queries
+// Creating a deleted doctor restores them
+func (q *Queries) CreateDoctor(ctx context.Context, arg CreateDoctorParams) (CreateDoctorRow, error) {
+	row := q.db.QueryRow(ctx, createDoctor,
+		arg.UserID,
+		arg.DepartmentID,
+		arg.Specialization,
+		arg.YearsOfExperience,
+	)
+	var i CreateDoctorRow
+	err := row.Scan(
+		&i.UserID,
+		&i.DepartmentID,
+		&i.Specialization,
+		&i.YearsOfExperience,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createDraftMessage = `-- name: CreateDraftMessage :exec
+INSERT INTO chat_messages (
+    id,
+    chat_session_id,
+    sender_id,
+    content,
+    message_type
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+`
+
+type CreateDraftMessageParams struct {
+	ID            pgtype.UUID `json:"id"`
+	ChatSessionID pgtype.UUID `json:"chat_session_id"`
+	SenderID      pgtype.UUID `json:"sender_id"`
+	Content       string      `json:"content"`
+	MessageType   string      `json:"message_type"`
+}
+
+// Drafts are stored under the message ID they were published with
+func (q *Queries) CreateDraftMessage(ctx context.Context, arg CreateDraftMessageParams) error {
+	_, err := q.db.Exec(ctx, createDraftMessage,
+		arg.ID,
+		arg.ChatSessionID,
+		arg.SenderID,
+		arg.Content,
+		arg.MessageType,
+	)
+	return err
+}
+
 const createMedicalHistory = `-- name: CreateMedicalHistory :one
 INSERT INTO medical_history (
     patient_id,
@@ -95,6 +453,82 @@ func (q *Queries) CreateMedicalHistory(ctx context.Context, arg CreateMedicalHis
 	return i, err
 }
 
+const createPatient = `-- name: CreatePatient :one
+INSERT INTO patients (
+    user_id,
+    age,
+    gender
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (user_id) DO UPDATE
+SET 
+    age = EXCLUDED.age,
+    gender = EXCLUDED.gender,
+    deleted_at = NULL
+WHERE patients.deleted_at IS NOT NULL
+RETURNING user_id, age, gender, created_at
+`
+
+type CreatePatientParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	Age    int32       `json:"age"`
+	Gender string      `json:"gender"`
+}
+
+type CreatePatientRow struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	Age       int32              `json:"age"`
+	Gender    string             `json:"gender"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+// Patient related queries
+// Creating a deleted patient restores them
+func (q *Queries) CreatePatient(ctx context.Context, arg CreatePatientParams) (CreatePatientRow, error) {
+	row := q.db.QueryRow(ctx, createPatient, arg.UserID, arg.Age, arg.Gender)
+	var i CreatePatientRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Age,
+		&i.Gender,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPromptTemplate = `-- name: CreatePromptTemplate :one
+INSERT INTO ref_prompt_templates (
+    version,
+    template,
+    description,
+    is_active
+) VALUES (
+    $1, $2, $3, false
+) RETURNING version, template, description, is_active, created_at, updated_at, experiment_weight
+`
+
+type CreatePromptTemplateParams struct {
+	Version     string      `json:"version"`
+	Template    string      `json:"template"`
+	Description pgtype.Text `json:"description"`
+}
+
+// Prompt Template Management
+func (q *Queries) CreatePromptTemplate(ctx context.Context, arg CreatePromptTemplateParams) (RefPromptTemplate, error) {
+	row := q.db.QueryRow(ctx, createPromptTemplate, arg.Version, arg.Template, arg.Description)
+	var i RefPromptTemplate
+	err := row.Scan(
+		&i.Version,
+		&i.Template,
+		&i.Description,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExperimentWeight,
+	)
+	return i, err
+}
+
 const createQuestion = `-- name: CreateQuestion :one
 INSERT INTO questions (
     patient_id,
@@ -141,39 +575,384 @@ func (q *Queries) CreateQuestion(ctx context.Context, arg CreateQuestionParams)
 	return i, err
 }
 
-const getActiveMedicalConditions = `-- name: GetActiveMedicalConditions :many
-SELECT id, patient_id, condition, diagnosed_date, status, notes, created_at FROM medical_history
+const createTrackedMessage = `-- name: CreateTrackedMessage :exec
+WITH message AS (
+    INSERT INTO chat_messages (id, chat_session_id, sender_id, content, message_type)
+    VALUES ($1, $2, $3, $4, $5)
+    RETURNING id
+)
+INSERT INTO message_deliveries (message_id, recipient_role, status)
+SELECT id, $6, 'DELIVERY_STATUS_QUEUED' FROM message
+`
+
+type CreateTrackedMessageParams struct {
+	ID            pgtype.UUID `json:"id"`
+	ChatSessionID pgtype.UUID `json:"chat_session_id"`
+	SenderID      pgtype.UUID `json:"sender_id"`
+	Content       string      `json:"content"`
+	MessageType   string      `json:"message_type"`
+	RecipientRole string      `json:"recipient_role"`
+}
+
+// Delivery tracking
+// Messages between patient and doctor are stored under the ID their
+// recipient acknowledges, with a queued delivery
+func (q *Queries) CreateTrackedMessage(ctx context.Context, arg CreateTrackedMessageParams) error {
+	_, err := q.db.Exec(ctx, createTrackedMessage,
+		arg.ID,
+		arg.ChatSessionID,
+		arg.SenderID,
+		arg.Content,
+		arg.MessageType,
+		arg.RecipientRole,
+	)
+	return err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (
+    email,
+    name
+) VALUES (
+    $1, $2
+) RETURNING id, email, name, created_at
+`
+
+type CreateUserParams struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.Name)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deidentifyAIInteraction = `-- name: DeidentifyAIInteraction :exec
+UPDATE ai_interactions
+SET 
+    chat_message_id = NULL,
+    prompt_components = '{}',
+    ai_response = '',
+    review_comment = NULL,
+    modified_content = NULL,
+    edit_distance = $1,
+    edit_length = $2,
+    deidentified_at = CURRENT_TIMESTAMP
+WHERE id = $3
+`
+
+type DeidentifyAIInteractionParams struct {
+	EditDistance pgtype.Int4 `json:"edit_distance"`
+	EditLength   pgtype.Int4 `json:"edit_length"`
+	ID           pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) DeidentifyAIInteraction(ctx context.Context, arg DeidentifyAIInteractionParams) error {
+	_, err := q.db.Exec(ctx, deidentifyAIInteraction, arg.EditDistance, arg.EditLength, arg.ID)
+	return err
+}
+
+const deleteBiometricsBefore = `-- name: DeleteBiometricsBefore :execrows
+DELETE FROM biometric_data
+WHERE id IN (
+    SELECT id FROM biometric_data
+    WHERE measured_at < $1
+    LIMIT $2
+)
+`
+
+type DeleteBiometricsBeforeParams struct {
+	Cutoff pgtype.Timestamptz `json:"cutoff"`
+	Limit  int32              `json:"limit"`
+}
+
+// Readings measured before the cutoff
+func (q *Queries) DeleteBiometricsBefore(ctx context.Context, arg DeleteBiometricsBeforeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBiometricsBefore, arg.Cutoff, arg.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePatientBiometrics = `-- name: DeletePatientBiometrics :execrows
+DELETE FROM biometric_data
 WHERE patient_id = $1
-    AND status IN ('ACTIVE', 'CHRONIC')
-ORDER BY diagnosed_date DESC
 `
 
-func (q *Queries) GetActiveMedicalConditions(ctx context.Context, patientID pgtype.UUID) ([]MedicalHistory, error) {
-	rows, err := q.db.Query(ctx, getActiveMedicalConditions, patientID)
+func (q *Queries) DeletePatientBiometrics(ctx context.Context, patientID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePatientBiometrics, patientID)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	defer rows.Close()
-	items := []MedicalHistory{}
-	for rows.Next() {
-		var i MedicalHistory
-		if err := rows.Scan(
-			&i.ID,
-			&i.PatientID,
-			&i.Condition,
-			&i.DiagnosedDate,
-			&i.Status,
-			&i.Notes,
-			&i.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+	return result.RowsAffected(), nil
+}
+
+const deletePatientMedicalHistory = `-- name: DeletePatientMedicalHistory :execrows
+DELETE FROM medical_history
+WHERE patient_id = $1
+`
+
+func (q *Queries) DeletePatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePatientMedicalHistory, patientID)
+	if err != nil {
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const deleteSessionMessages = `-- name: DeleteSessionMessages :execrows
+DELETE FROM chat_messages
+WHERE chat_session_id = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteSessionMessages(ctx context.Context, sessionIds []pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSessionMessages, sessionIds)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
+}
+
+const erasePatient = `-- name: ErasePatient :exec
+UPDATE patients
+SET 
+    age = LEAST(age, 90),
+    deleted_at = COALESCE(deleted_at, CURRENT_TIMESTAMP),
+    erased_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+`
+
+// Ages over 89 are identifying and are kept as 90
+func (q *Queries) ErasePatient(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, erasePatient, userID)
+	return err
+}
+
+const getAIInteractionStatsByTemplate = `-- name: GetAIInteractionStatsByTemplate :many
+SELECT 
+    COALESCE(prompt_template_version, '')::text as prompt_template_version,
+    COUNT(*) as total_interactions,
+    COUNT(CASE WHEN review_status = 'approved' THEN 1 END) as approved_count,
+    COUNT(CASE WHEN review_status = 'rejected' THEN 1 END) as rejected_count,
+    COUNT(CASE WHEN review_status = 'modified' THEN 1 END) as modified_count,
+    COUNT(CASE WHEN review_status IS NULL THEN 1 END) as pending_count,
+    COALESCE(AVG(confidence_score), 0)::float8 as avg_confidence_score
+FROM ai_interactions
+WHERE created_at BETWEEN $1 AND $2
+GROUP BY 1
+ORDER BY 1
+`
+
+type GetAIInteractionStatsByTemplateParams struct {
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+}
+
+type GetAIInteractionStatsByTemplateRow struct {
+	PromptTemplateVersion string  `json:"prompt_template_version"`
+	TotalInteractions     int64   `json:"total_interactions"`
+	ApprovedCount         int64   `json:"approved_count"`
+	RejectedCount         int64   `json:"rejected_count"`
+	ModifiedCount         int64   `json:"modified_count"`
+	PendingCount          int64   `json:"pending_count"`
+	AvgConfidenceScore    float64 `json:"avg_confidence_score"`
+}
+
+// Per template version, drafts of the LLM service's default prompt under ""
+func (q *Queries) GetAIInteractionStatsByTemplate(ctx context.Context, arg GetAIInteractionStatsByTemplateParams) ([]GetAIInteractionStatsByTemplateRow, error) {
+	rows, err := q.db.Query(ctx, getAIInteractionStatsByTemplate, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAIInteractionStatsByTemplateRow{}
+	for rows.Next() {
+		var i GetAIInteractionStatsByTemplateRow
+		if err := rows.Scan(
+			&i.PromptTemplateVersion,
+			&i.TotalInteractions,
+			&i.ApprovedCount,
+			&i.RejectedCount,
+			&i.ModifiedCount,
+			&i.PendingCount,
+			&i.AvgConfidenceScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAITrainingData = `-- name: GetAITrainingData :many
+SELECT 
+    ai.id,
+    ai.chat_message_id,
+    ai.prompt_template_version,
+    pt.template as prompt_template,
+    ai.prompt_components,
+    ai.ai_response,
+    ai.confidence_score,
+    ai.review_status,
+    ai.modified_content,
+    ai.review_comment,
+    ai.created_at,
+    ai.reviewed_at,
+    d.user_id as reviewer_id,
+    u.name as reviewer_name,
+    d.department_id,
+    pu.name as patient_name,
+    pu.email as patient_email
+FROM ai_interactions ai
+JOIN chat_messages cm ON cm.id = ai.chat_message_id
+JOIN chat_sessions cs ON cs.id = cm.chat_session_id
+JOIN users pu ON pu.id = cs.patient_id
+LEFT JOIN ref_prompt_templates pt ON pt.version = ai.prompt_template_version
+LEFT JOIN doctors d ON d.user_id = ai.reviewed_by
+LEFT JOIN users u ON u.id = d.user_id
+WHERE ai.created_at BETWEEN $1 AND $2
+AND ai.review_status IS NOT NULL
+AND ($3::text IS NULL OR ai.review_status = $3)
+AND ($4::text IS NULL OR d.department_id = $4)
+ORDER BY ai.created_at DESC
+`
+
+type GetAITrainingDataParams struct {
+	StartTime    pgtype.Timestamptz `json:"start_time"`
+	EndTime      pgtype.Timestamptz `json:"end_time"`
+	ReviewStatus pgtype.Text        `json:"review_status"`
+	DepartmentID pgtype.Text        `json:"department_id"`
+}
+
+type GetAITrainingDataRow struct {
+	ID                    pgtype.UUID        `json:"id"`
+	ChatMessageID         pgtype.UUID        `json:"chat_message_id"`
+	PromptTemplateVersion pgtype.Text        `json:"prompt_template_version"`
+	PromptTemplate        pgtype.Text        `json:"prompt_template"`
+	PromptComponents      []byte             `json:"prompt_components"`
+	AiResponse            string             `json:"ai_response"`
+	ConfidenceScore       pgtype.Float8      `json:"confidence_score"`
+	ReviewStatus          pgtype.Text        `json:"review_status"`
+	ModifiedContent       pgtype.Text        `json:"modified_content"`
+	ReviewComment         pgtype.Text        `json:"review_comment"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	ReviewedAt            pgtype.Timestamptz `json:"reviewed_at"`
+	ReviewerID            pgtype.UUID        `json:"reviewer_id"`
+	ReviewerName          pgtype.Text        `json:"reviewer_name"`
+	DepartmentID          pgtype.Text        `json:"department_id"`
+	PatientName           string             `json:"patient_name"`
+	PatientEmail          string             `json:"patient_email"`
+}
+
+// Training Data Collection
+func (q *Queries) GetAITrainingData(ctx context.Context, arg GetAITrainingDataParams) ([]GetAITrainingDataRow, error) {
+	rows, err := q.db.Query(ctx, getAITrainingData,
+		arg.StartTime,
+		arg.EndTime,
+		arg.ReviewStatus,
+		arg.DepartmentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetAITrainingDataRow{}
+	for rows.Next() {
+		var i GetAITrainingDataRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChatMessageID,
+			&i.PromptTemplateVersion,
+			&i.PromptTemplate,
+			&i.PromptComponents,
+			&i.AiResponse,
+			&i.ConfidenceScore,
+			&i.ReviewStatus,
+			&i.ModifiedContent,
+			&i.ReviewComment,
+			&i.CreatedAt,
+			&i.ReviewedAt,
+			&i.ReviewerID,
+			&i.ReviewerName,
+			&i.DepartmentID,
+			&i.PatientName,
+			&i.PatientEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActiveMedicalConditions = `-- name: GetActiveMedicalConditions :many
+SELECT id, patient_id, condition, diagnosed_date, status, notes, created_at FROM medical_history
+WHERE patient_id = $1
+    AND status IN ('ACTIVE', 'CHRONIC')
+ORDER BY diagnosed_date DESC
+`
+
+func (q *Queries) GetActiveMedicalConditions(ctx context.Context, patientID pgtype.UUID) ([]MedicalHistory, error) {
+	rows, err := q.db.Query(ctx, getActiveMedicalConditions, patientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MedicalHistory{}
+	for rows.Next() {
+		var i MedicalHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.PatientID,
+			&i.Condition,
+			&i.DiagnosedDate,
+			&i.Status,
+			&i.Notes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getActivePromptTemplate = `-- name: GetActivePromptTemplate :one
+SELECT version, template 
+FROM ref_prompt_templates 
+WHERE is_active = true 
+ORDER BY created_at DESC 
+LIMIT 1
+`
+
+type GetActivePromptTemplateRow struct {
+	Version  string `json:"version"`
+	Template string `json:"template"`
+}
+
+func (q *Queries) GetActivePromptTemplate(ctx context.Context) (GetActivePromptTemplateRow, error) {
+	row := q.db.QueryRow(ctx, getActivePromptTemplate)
+	var i GetActivePromptTemplateRow
+	err := row.Scan(&i.Version, &i.Template)
+	return i, err
 }
 
 const getAnswerHistory = `-- name: GetAnswerHistory :many
@@ -284,6 +1063,265 @@ func (q *Queries) GetAnswerHistoryCount(ctx context.Context, arg GetAnswerHistor
 	return count, err
 }
 
+const getChatSession = `-- name: GetChatSession :one
+SELECT id, patient_id, status, created_at, closed_at FROM chat_sessions 
+WHERE id = $1
+`
+
+func (q *Queries) GetChatSession(ctx context.Context, id pgtype.UUID) (ChatSession, error) {
+	row := q.db.QueryRow(ctx, getChatSession, id)
+	var i ChatSession
+	err := row.Scan(
+		&i.ID,
+		&i.PatientID,
+		&i.Status,
+		&i.CreatedAt,
+		&i.ClosedAt,
+	)
+	return i, err
+}
+
+const getDataKey = `-- name: GetDataKey :one
+SELECT id, key_id, wrapped_key, created_at FROM encryption_data_keys
+WHERE id = $1
+`
+
+func (q *Queries) GetDataKey(ctx context.Context, id pgtype.UUID) (EncryptionDataKey, error) {
+	row := q.db.QueryRow(ctx, getDataKey, id)
+	var i EncryptionDataKey
+	err := row.Scan(
+		&i.ID,
+		&i.KeyID,
+		&i.WrappedKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDoctorByUserID = `-- name: GetDoctorByUserID :one
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    d.department_id,
+    dept.name as department_name,
+    d.specialization,
+    d.years_of_experience,
+    d.created_at
+FROM users u
+JOIN doctors d ON d.user_id = u.id
+JOIN ref_departments dept ON dept.id = d.department_id
+WHERE u.id = $1
+AND d.deleted_at IS NULL
+`
+
+type GetDoctorByUserIDRow struct {
+	ID                pgtype.UUID        `json:"id"`
+	Email             string             `json:"email"`
+	Name              string             `json:"name"`
+	DepartmentID      pgtype.Text        `json:"department_id"`
+	DepartmentName    string             `json:"department_name"`
+	Specialization    []string           `json:"specialization"`
+	YearsOfExperience pgtype.Int4        `json:"years_of_experience"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetDoctorByUserID(ctx context.Context, id pgtype.UUID) (GetDoctorByUserIDRow, error) {
+	row := q.db.QueryRow(ctx, getDoctorByUserID, id)
+	var i GetDoctorByUserIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.DepartmentID,
+		&i.DepartmentName,
+		&i.Specialization,
+		&i.YearsOfExperience,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDraftQualityStats = `-- name: GetDraftQualityStats :many
+SELECT
+    (CASE WHEN $1::text = '' THEN NULL
+     ELSE date_trunc($1::text, ai.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' END)::timestamptz as bucket_start,
+    (CASE $2::text
+        WHEN 'doctor' THEN COALESCE(ai.reviewed_by::text, '')
+        WHEN 'department' THEN COALESCE(d.department_id, '')
+        WHEN 'prompt_version' THEN COALESCE(ai.prompt_template_version, '')
+        ELSE '' END)::text as group_id,
+    (CASE $2::text
+        WHEN 'doctor' THEN COALESCE(u.name, '')
+        WHEN 'department' THEN COALESCE(rd.name, '')
+        ELSE '' END)::text as group_name,
+    COUNT(*) as total,
+    COUNT(*) FILTER (WHERE ai.review_status IS NULL OR ai.review_status NOT IN ('approved', 'modified', 'rejected')) as pending,
+    COUNT(*) FILTER (WHERE ai.review_status = 'approved') as accepted,
+    COUNT(*) FILTER (WHERE ai.review_status = 'modified') as modified,
+    COUNT(*) FILTER (WHERE ai.review_status = 'rejected') as rejected,
+    COALESCE(AVG(ai.confidence_score), 0)::float8 as mean_confidence,
+    COALESCE(AVG(EXTRACT(EPOCH FROM ai.reviewed_at - ai.created_at)::float8)
+        FILTER (WHERE ai.review_status IN ('approved', 'modified', 'rejected') AND ai.reviewed_at IS NOT NULL), 0)::float8 as mean_review_latency_seconds,
+    COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ai.reviewed_at - ai.created_at)::float8)
+        FILTER (WHERE ai.review_status IN ('approved', 'modified', 'rejected') AND ai.reviewed_at IS NOT NULL), 0)::float8 as median_review_latency_seconds,
+    COALESCE(SUM(ai.edit_distance)
+        FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NOT NULL), 0)::float8 as edit_distance_sum,
+    COALESCE(SUM(ai.edit_distance::float8 / NULLIF(ai.edit_length, 0))
+        FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NOT NULL), 0)::float8 as normalized_edit_distance_sum,
+    COUNT(ai.edit_distance) FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NOT NULL) as edit_distance_count,
+    COUNT(*) FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NULL) as unscored_count
+FROM ai_interactions ai
+LEFT JOIN doctors d ON d.user_id = ai.reviewed_by
+LEFT JOIN users u ON u.id = d.user_id
+LEFT JOIN ref_departments rd ON rd.id = d.department_id
+WHERE ai.created_at >= $3
+AND ai.created_at < $4
+GROUP BY 1, 2, 3
+ORDER BY 1, 2
+`
+
+type GetDraftQualityStatsParams struct {
+	Bucket    string             `json:"bucket"`
+	GroupBy   string             `json:"group_by"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+}
+
+type GetDraftQualityStatsRow struct {
+	BucketStart                pgtype.Timestamptz `json:"bucket_start"`
+	GroupID                    string             `json:"group_id"`
+	GroupName                  string             `json:"group_name"`
+	Total                      int64              `json:"total"`
+	Pending                    int64              `json:"pending"`
+	Accepted                   int64              `json:"accepted"`
+	Modified                   int64              `json:"modified"`
+	Rejected                   int64              `json:"rejected"`
+	MeanConfidence             float64            `json:"mean_confidence"`
+	MeanReviewLatencySeconds   float64            `json:"mean_review_latency_seconds"`
+	MedianReviewLatencySeconds float64            `json:"median_review_latency_seconds"`
+	EditDistanceSum            float64            `json:"edit_distance_sum"`
+	NormalizedEditDistanceSum  float64            `json:"normalized_edit_distance_sum"`
+	EditDistanceCount          int64              `json:"edit_distance_count"`
+	UnscoredCount              int64              `json:"unscored_count"`
+}
+
+// Draft outcomes, confidence, review latency and the edit distances kept
+// at de-identification, per UTC bucket of date_trunc and group ('doctor',
+// 'department' or 'prompt_version'). An empty bucket or group puts every
+// draft in one.
+func (q *Queries) GetDraftQualityStats(ctx context.Context, arg GetDraftQualityStatsParams) ([]GetDraftQualityStatsRow, error) {
+	rows, err := q.db.Query(ctx, getDraftQualityStats,
+		arg.Bucket,
+		arg.GroupBy,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDraftQualityStatsRow{}
+	for rows.Next() {
+		var i GetDraftQualityStatsRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.GroupID,
+			&i.GroupName,
+			&i.Total,
+			&i.Pending,
+			&i.Accepted,
+			&i.Modified,
+			&i.Rejected,
+			&i.MeanConfidence,
+			&i.MeanReviewLatencySeconds,
+			&i.MedianReviewLatencySeconds,
+			&i.EditDistanceSum,
+			&i.NormalizedEditDistanceSum,
+			&i.EditDistanceCount,
+			&i.UnscoredCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLastAuditEvent = `-- name: GetLastAuditEvent :one
+SELECT seq, hash FROM audit_events
+ORDER BY seq DESC
+LIMIT 1
+`
+
+type GetLastAuditEventRow struct {
+	Seq  int64  `json:"seq"`
+	Hash []byte `json:"hash"`
+}
+
+func (q *Queries) GetLastAuditEvent(ctx context.Context) (GetLastAuditEventRow, error) {
+	row := q.db.QueryRow(ctx, getLastAuditEvent)
+	var i GetLastAuditEventRow
+	err := row.Scan(&i.Seq, &i.Hash)
+	return i, err
+}
+
+const getLatestBiometrics = `-- name: GetLatestBiometrics :many
+SELECT 
+    bd.type_id,
+    rt.name as type_name,
+    rt.unit_type,
+    bd.value,
+    bd.secondary_value,
+    bd.measured_at
+FROM (
+    SELECT DISTINCT ON (type_id) *
+    FROM biometric_data
+    WHERE patient_id = $1
+    ORDER BY type_id, measured_at DESC
+) bd
+JOIN ref_biometric_types rt ON rt.id = bd.type_id
+`
+
+type GetLatestBiometricsRow struct {
+	TypeID         string             `json:"type_id"`
+	TypeName       string             `json:"type_name"`
+	UnitType       string             `json:"unit_type"`
+	Value          pgtype.Numeric     `json:"value"`
+	SecondaryValue pgtype.Numeric     `json:"secondary_value"`
+	MeasuredAt     pgtype.Timestamptz `json:"measured_at"`
+}
+
+func (q *Queries) GetLatestBiometrics(ctx context.Context, patientID pgtype.UUID) ([]GetLatestBiometricsRow, error) {
+	rows, err := q.db.Query(ctx, getLatestBiometrics, patientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetLatestBiometricsRow{}
+	for rows.Next() {
+		var i GetLatestBiometricsRow
+		if err := rows.Scan(
+			&i.TypeID,
+			&i.TypeName,
+			&i.UnitType,
+			&i.Value,
+			&i.SecondaryValue,
+			&i.MeasuredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getLatestBiometricsByType = `-- name: GetLatestBiometricsByType :many
 SELECT DISTINCT ON (type) id, patient_id, type, value, unit, measured_at, created_at
 FROM biometric_data
@@ -319,6 +1357,26 @@ func (q *Queries) GetLatestBiometricsByType(ctx context.Context, patientID pgtyp
 	return items, nil
 }
 
+const getLatestDataKey = `-- name: GetLatestDataKey :one
+SELECT id, key_id, wrapped_key, created_at FROM encryption_data_keys
+WHERE key_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+// Newest data key wrapped by a key encryption key
+func (q *Queries) GetLatestDataKey(ctx context.Context, keyID string) (EncryptionDataKey, error) {
+	row := q.db.QueryRow(ctx, getLatestDataKey, keyID)
+	var i EncryptionDataKey
+	err := row.Scan(
+		&i.ID,
+		&i.KeyID,
+		&i.WrappedKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getPatientBiometricData = `-- name: GetPatientBiometricData :many
 SELECT id, patient_id, type, value, unit, measured_at, created_at FROM biometric_data
 WHERE patient_id = $1
@@ -373,41 +1431,120 @@ func (q *Queries) GetPatientBiometricData(ctx context.Context, arg GetPatientBio
 	return items, nil
 }
 
+const getPatientByUserID = `-- name: GetPatientByUserID :one
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    p.age,
+    p.gender,
+    p.created_at
+FROM users u
+JOIN patients p ON p.user_id = u.id
+WHERE u.id = $1
+AND p.deleted_at IS NULL
+`
+
+type GetPatientByUserIDRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      string             `json:"name"`
+	Age       int32              `json:"age"`
+	Gender    string             `json:"gender"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetPatientByUserID(ctx context.Context, id pgtype.UUID) (GetPatientByUserIDRow, error) {
+	row := q.db.QueryRow(ctx, getPatientByUserID, id)
+	var i GetPatientByUserIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.Age,
+		&i.Gender,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPatientForErasure = `-- name: GetPatientForErasure :one
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    p.age,
+    p.gender,
+    p.created_at,
+    p.deleted_at,
+    p.erased_at
+FROM users u
+JOIN patients p ON p.user_id = u.id
+WHERE u.id = $1
+FOR UPDATE OF p
+`
+
+type GetPatientForErasureRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      string             `json:"name"`
+	Age       int32              `json:"age"`
+	Gender    string             `json:"gender"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	ErasedAt  pgtype.Timestamptz `json:"erased_at"`
+}
+
+// Patients are locked until the erasure commits, deleted ones included
+func (q *Queries) GetPatientForErasure(ctx context.Context, id pgtype.UUID) (GetPatientForErasureRow, error) {
+	row := q.db.QueryRow(ctx, getPatientForErasure, id)
+	var i GetPatientForErasureRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.Age,
+		&i.Gender,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.ErasedAt,
+	)
+	return i, err
+}
+
 const getPatientMedicalHistory = `-- name: GetPatientMedicalHistory :many
-SELECT id, patient_id, condition, diagnosed_date, status, notes, created_at FROM medical_history
-WHERE patient_id = $1
-    AND ($2::varchar IS NULL OR status = $2)
+SELECT id, patient_id, condition, diagnosed_date, status_id, notes, created_at
+FROM medical_history 
+WHERE patient_id = $1 
+AND deleted_at IS NULL
 ORDER BY diagnosed_date DESC
-LIMIT $3 OFFSET $4
 `
 
-type GetPatientMedicalHistoryParams struct {
-	PatientID pgtype.UUID `json:"patient_id"`
-	Column2   string      `json:"column_2"`
-	Limit     int32       `json:"limit"`
-	Offset    int32       `json:"offset"`
+type GetPatientMedicalHistoryRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	PatientID     pgtype.UUID        `json:"patient_id"`
+	Condition     string             `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      string             `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
-func (q *Queries) GetPatientMedicalHistory(ctx context.Context, arg GetPatientMedicalHistoryParams) ([]MedicalHistory, error) {
-	rows, err := q.db.Query(ctx, getPatientMedicalHistory,
-		arg.PatientID,
-		arg.Column2,
-		arg.Limit,
-		arg.Offset,
-	)
+func (q *Queries) GetPatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) ([]GetPatientMedicalHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getPatientMedicalHistory, patientID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []MedicalHistory{}
+	items := []GetPatientMedicalHistoryRow{}
 	for rows.Next() {
-		var i MedicalHistory
+		var i GetPatientMedicalHistoryRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.PatientID,
 			&i.Condition,
 			&i.DiagnosedDate,
-			&i.Status,
+			&i.StatusID,
 			&i.Notes,
 			&i.CreatedAt,
 		); err != nil {
@@ -568,76 +1705,1288 @@ WHERE status = 'STATUS_PENDING_REVIEW'
     AND ($5::timestamptz IS NULL OR created_at <= $5)
 `
 
-type GetPendingReviewsCountParams struct {
-	Column1 interface{}        `json:"column_1"`
-	Column2 interface{}        `json:"column_2"`
-	Column3 int32              `json:"column_3"`
-	Column4 pgtype.Timestamptz `json:"column_4"`
-	Column5 pgtype.Timestamptz `json:"column_5"`
+type GetPendingReviewsCountParams struct {
+	Column1 interface{}        `json:"column_1"`
+	Column2 interface{}        `json:"column_2"`
+	Column3 int32              `json:"column_3"`
+	Column4 pgtype.Timestamptz `json:"column_4"`
+	Column5 pgtype.Timestamptz `json:"column_5"`
+}
+
+func (q *Queries) GetPendingReviewsCount(ctx context.Context, arg GetPendingReviewsCountParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getPendingReviewsCount,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getPromptTemplate = `-- name: GetPromptTemplate :one
+SELECT version, template, description, is_active, created_at, updated_at, experiment_weight FROM ref_prompt_templates
+WHERE version = $1
+`
+
+func (q *Queries) GetPromptTemplate(ctx context.Context, version string) (RefPromptTemplate, error) {
+	row := q.db.QueryRow(ctx, getPromptTemplate, version)
+	var i RefPromptTemplate
+	err := row.Scan(
+		&i.Version,
+		&i.Template,
+		&i.Description,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ExperimentWeight,
+	)
+	return i, err
+}
+
+const getQuestionStatus = `-- name: GetQuestionStatus :one
+SELECT 
+    q.id, q.patient_id, q.question_text, q.question_type, q.department, q.urgency_level, q.status, q.created_at, q.answered_at, q.answered_by,
+    a.answer_text,
+    a.doctor_id as answered_by
+FROM questions q
+LEFT JOIN answers a ON q.id = a.question_id
+WHERE q.id = $1 AND q.patient_id = $2
+LIMIT 1
+`
+
+type GetQuestionStatusParams struct {
+	ID        pgtype.UUID `json:"id"`
+	PatientID pgtype.UUID `json:"patient_id"`
+}
+
+type GetQuestionStatusRow struct {
+	ID           pgtype.UUID        `json:"id"`
+	PatientID    pgtype.UUID        `json:"patient_id"`
+	QuestionText string             `json:"question_text"`
+	QuestionType string             `json:"question_type"`
+	Department   string             `json:"department"`
+	UrgencyLevel pgtype.Int4        `json:"urgency_level"`
+	Status       string             `json:"status"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	AnsweredAt   pgtype.Timestamptz `json:"answered_at"`
+	AnsweredBy   pgtype.UUID        `json:"answered_by"`
+	AnswerText   pgtype.Text        `json:"answer_text"`
+	AnsweredBy_2 pgtype.UUID        `json:"answered_by_2"`
+}
+
+func (q *Queries) GetQuestionStatus(ctx context.Context, arg GetQuestionStatusParams) (GetQuestionStatusRow, error) {
+	row := q.db.QueryRow(ctx, getQuestionStatus, arg.ID, arg.PatientID)
+	var i GetQuestionStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.PatientID,
+		&i.QuestionText,
+		&i.QuestionType,
+		&i.Department,
+		&i.UrgencyLevel,
+		&i.Status,
+		&i.CreatedAt,
+		&i.AnsweredAt,
+		&i.AnsweredBy,
+		&i.AnswerText,
+		&i.AnsweredBy_2,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, name, created_at FROM users WHERE email = $1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const ingestBiometricReading = `-- name: IngestBiometricReading :execrows
+INSERT INTO biometric_data (
+    patient_id,
+    type_id,
+    value,
+    secondary_value,
+    measured_at,
+    source
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (patient_id, type_id, measured_at) DO NOTHING
+`
+
+type IngestBiometricReadingParams struct {
+	PatientID      pgtype.UUID        `json:"patient_id"`
+	TypeID         string             `json:"type_id"`
+	Value          pgtype.Numeric     `json:"value"`
+	SecondaryValue pgtype.Numeric     `json:"secondary_value"`
+	MeasuredAt     pgtype.Timestamptz `json:"measured_at"`
+	Source         pgtype.Text        `json:"source"`
+}
+
+func (q *Queries) IngestBiometricReading(ctx context.Context, arg IngestBiometricReadingParams) (int64, error) {
+	result, err := q.db.Exec(ctx, ingestBiometricReading,
+		arg.PatientID,
+		arg.TypeID,
+		arg.Value,
+		arg.SecondaryValue,
+		arg.MeasuredAt,
+		arg.Source,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listAIInteractionModifiedContent = `-- name: ListAIInteractionModifiedContent :many
+SELECT id, chat_message_id, modified_content FROM ai_interactions
+WHERE id > $1 AND modified_content IS NOT NULL
+ORDER BY id
+LIMIT $2
+`
+
+type ListAIInteractionModifiedContentParams struct {
+	ID    pgtype.UUID `json:"id"`
+	Limit int32       `json:"limit"`
+}
+
+type ListAIInteractionModifiedContentRow struct {
+	ID              pgtype.UUID `json:"id"`
+	ChatMessageID   pgtype.UUID `json:"chat_message_id"`
+	ModifiedContent pgtype.Text `json:"modified_content"`
+}
+
+func (q *Queries) ListAIInteractionModifiedContent(ctx context.Context, arg ListAIInteractionModifiedContentParams) ([]ListAIInteractionModifiedContentRow, error) {
+	rows, err := q.db.Query(ctx, listAIInteractionModifiedContent, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAIInteractionModifiedContentRow{}
+	for rows.Next() {
+		var i ListAIInteractionModifiedContentRow
+		if err := rows.Scan(&i.ID, &i.ChatMessageID, &i.ModifiedContent); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAIInteractionPromptComponents = `-- name: ListAIInteractionPromptComponents :many
+SELECT id, chat_message_id, prompt_components FROM ai_interactions
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListAIInteractionPromptComponentsParams struct {
+	ID    pgtype.UUID `json:"id"`
+	Limit int32       `json:"limit"`
+}
+
+type ListAIInteractionPromptComponentsRow struct {
+	ID               pgtype.UUID `json:"id"`
+	ChatMessageID    pgtype.UUID `json:"chat_message_id"`
+	PromptComponents []byte      `json:"prompt_components"`
+}
+
+func (q *Queries) ListAIInteractionPromptComponents(ctx context.Context, arg ListAIInteractionPromptComponentsParams) ([]ListAIInteractionPromptComponentsRow, error) {
+	rows, err := q.db.Query(ctx, listAIInteractionPromptComponents, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAIInteractionPromptComponentsRow{}
+	for rows.Next() {
+		var i ListAIInteractionPromptComponentsRow
+		if err := rows.Scan(&i.ID, &i.ChatMessageID, &i.PromptComponents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAIInteractionResponses = `-- name: ListAIInteractionResponses :many
+SELECT id, chat_message_id, ai_response FROM ai_interactions
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListAIInteractionResponsesParams struct {
+	ID    pgtype.UUID `json:"id"`
+	Limit int32       `json:"limit"`
+}
+
+type ListAIInteractionResponsesRow struct {
+	ID            pgtype.UUID `json:"id"`
+	ChatMessageID pgtype.UUID `json:"chat_message_id"`
+	AiResponse    string      `json:"ai_response"`
+}
+
+func (q *Queries) ListAIInteractionResponses(ctx context.Context, arg ListAIInteractionResponsesParams) ([]ListAIInteractionResponsesRow, error) {
+	rows, err := q.db.Query(ctx, listAIInteractionResponses, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAIInteractionResponsesRow{}
+	for rows.Next() {
+		var i ListAIInteractionResponsesRow
+		if err := rows.Scan(&i.ID, &i.ChatMessageID, &i.AiResponse); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAIInteractionsForAnalytics = `-- name: ListAIInteractionsForAnalytics :many
+SELECT bucket_start, group_id, chat_message_id, ai_response, review_status, modified_content
+FROM (
+    SELECT drafts.*,
+        row_number() OVER (PARTITION BY bucket_start, group_id ORDER BY random()) as sample_rank
+    FROM (
+        SELECT
+            (CASE WHEN $1::text = '' THEN NULL
+             ELSE date_trunc($1::text, ai.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' END)::timestamptz as bucket_start,
+            (CASE $2::text
+                WHEN 'doctor' THEN COALESCE(ai.reviewed_by::text, '')
+                WHEN 'department' THEN COALESCE(d.department_id, '')
+                WHEN 'prompt_version' THEN COALESCE(ai.prompt_template_version, '')
+                ELSE '' END)::text as group_id,
+            ai.chat_message_id,
+            ai.ai_response,
+            ai.review_status,
+            ai.modified_content
+        FROM ai_interactions ai
+        LEFT JOIN doctors d ON d.user_id = ai.reviewed_by
+        WHERE ai.created_at >= $3
+        AND ai.created_at < $4
+        AND ai.review_status IN ('approved', 'modified')
+        AND ai.deidentified_at IS NULL
+    ) drafts
+) sampled
+WHERE sample_rank <= $5
+`
+
+type ListAIInteractionsForAnalyticsParams struct {
+	Bucket     string             `json:"bucket"`
+	GroupBy    string             `json:"group_by"`
+	StartTime  pgtype.Timestamptz `json:"start_time"`
+	EndTime    pgtype.Timestamptz `json:"end_time"`
+	SampleSize int32              `json:"sample_size"`
+}
+
+type ListAIInteractionsForAnalyticsRow struct {
+	BucketStart     pgtype.Timestamptz `json:"bucket_start"`
+	GroupID         string             `json:"group_id"`
+	ChatMessageID   pgtype.UUID        `json:"chat_message_id"`
+	AiResponse      string             `json:"ai_response"`
+	ReviewStatus    pgtype.Text        `json:"review_status"`
+	ModifiedContent pgtype.Text        `json:"modified_content"`
+}
+
+// A random sample of up to sample_size of the accepted and modified drafts
+// of each group that still have their texts, bucketed and grouped as in
+// GetDraftQualityStats, to estimate their edit distance from
+func (q *Queries) ListAIInteractionsForAnalytics(ctx context.Context, arg ListAIInteractionsForAnalyticsParams) ([]ListAIInteractionsForAnalyticsRow, error) {
+	rows, err := q.db.Query(ctx, listAIInteractionsForAnalytics,
+		arg.Bucket,
+		arg.GroupBy,
+		arg.StartTime,
+		arg.EndTime,
+		arg.SampleSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAIInteractionsForAnalyticsRow{}
+	for rows.Next() {
+		var i ListAIInteractionsForAnalyticsRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.GroupID,
+			&i.ChatMessageID,
+			&i.AiResponse,
+			&i.ReviewStatus,
+			&i.ModifiedContent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAIInteractionsToDeidentify = `-- name: ListAIInteractionsToDeidentify :many
+SELECT ai.id, ai.chat_message_id, ai.ai_response, ai.review_status, ai.modified_content
+FROM ai_interactions ai
+LEFT JOIN chat_messages cm ON cm.id = ai.chat_message_id
+WHERE ai.deidentified_at IS NULL
+AND ($1::timestamptz IS NULL OR ai.created_at < $1)
+AND ($2::uuid[] IS NULL OR cm.chat_session_id = ANY($2::uuid[]))
+ORDER BY ai.created_at
+LIMIT $3
+`
+
+type ListAIInteractionsToDeidentifyParams struct {
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	SessionIds    []pgtype.UUID      `json:"session_ids"`
+	Limit         int32              `json:"limit"`
+}
+
+type ListAIInteractionsToDeidentifyRow struct {
+	ID              pgtype.UUID `json:"id"`
+	ChatMessageID   pgtype.UUID `json:"chat_message_id"`
+	AiResponse      string      `json:"ai_response"`
+	ReviewStatus    pgtype.Text `json:"review_status"`
+	ModifiedContent pgtype.Text `json:"modified_content"`
+}
+
+// AI interactions still holding draft text, created before a cutoff or
+// belonging to the given sessions
+func (q *Queries) ListAIInteractionsToDeidentify(ctx context.Context, arg ListAIInteractionsToDeidentifyParams) ([]ListAIInteractionsToDeidentifyRow, error) {
+	rows, err := q.db.Query(ctx, listAIInteractionsToDeidentify, arg.CreatedBefore, arg.SessionIds, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAIInteractionsToDeidentifyRow{}
+	for rows.Next() {
+		var i ListAIInteractionsToDeidentifyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChatMessageID,
+			&i.AiResponse,
+			&i.ReviewStatus,
+			&i.ModifiedContent,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveBiometricTypes = `-- name: ListActiveBiometricTypes :many
+SELECT id, name, unit_type, description, active, created_at FROM ref_biometric_types 
+WHERE active = true 
+ORDER BY name
+`
+
+// Reference Data queries
+func (q *Queries) ListActiveBiometricTypes(ctx context.Context) ([]RefBiometricType, error) {
+	rows, err := q.db.Query(ctx, listActiveBiometricTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RefBiometricType{}
+	for rows.Next() {
+		var i RefBiometricType
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.UnitType,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveConditionStatuses = `-- name: ListActiveConditionStatuses :many
+SELECT id, name, description, active, created_at FROM ref_medical_condition_status 
+WHERE active = true 
+ORDER BY name
+`
+
+func (q *Queries) ListActiveConditionStatuses(ctx context.Context) ([]RefMedicalConditionStatus, error) {
+	rows, err := q.db.Query(ctx, listActiveConditionStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RefMedicalConditionStatus{}
+	for rows.Next() {
+		var i RefMedicalConditionStatus
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveDepartments = `-- name: ListActiveDepartments :many
+SELECT id, name, description, active, created_at FROM ref_departments 
+WHERE active = true 
+ORDER BY name
+`
+
+func (q *Queries) ListActiveDepartments(ctx context.Context) ([]RefDepartment, error) {
+	rows, err := q.db.Query(ctx, listActiveDepartments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RefDepartment{}
+	for rows.Next() {
+		var i RefDepartment
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveGenders = `-- name: ListActiveGenders :many
+SELECT id, name, description, active, created_at FROM ref_gender 
+WHERE active = true 
+ORDER BY name
+`
+
+func (q *Queries) ListActiveGenders(ctx context.Context) ([]RefGender, error) {
+	rows, err := q.db.Query(ctx, listActiveGenders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RefGender{}
+	for rows.Next() {
+		var i RefGender
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT seq, occurred_at, action, actor_role, actor_id, patient_id, session_id, resource, details, prev_hash, hash FROM audit_events
+WHERE ($1::uuid IS NULL OR patient_id = $1)
+AND ($2::text IS NULL OR actor_id = $2)
+AND occurred_at >= $3
+AND occurred_at < $4
+ORDER BY seq
+LIMIT $5 OFFSET $6
+`
+
+type ListAuditEventsParams struct {
+	PatientID pgtype.UUID        `json:"patient_id"`
+	ActorID   pgtype.Text        `json:"actor_id"`
+	StartTime pgtype.Timestamptz `json:"start_time"`
+	EndTime   pgtype.Timestamptz `json:"end_time"`
+	Limit     int32              `json:"limit"`
+	Offset    int32              `json:"offset"`
+}
+
+// Events of a patient or an actor, oldest first
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.PatientID,
+		arg.ActorID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.Seq,
+			&i.OccurredAt,
+			&i.Action,
+			&i.ActorRole,
+			&i.ActorID,
+			&i.PatientID,
+			&i.SessionID,
+			&i.Resource,
+			&i.Details,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEventsAfter = `-- name: ListAuditEventsAfter :many
+SELECT seq, occurred_at, action, actor_role, actor_id, patient_id, session_id, resource, details, prev_hash, hash FROM audit_events
+WHERE seq > $1
+ORDER BY seq
+LIMIT $2
+`
+
+type ListAuditEventsAfterParams struct {
+	Seq   int64 `json:"seq"`
+	Limit int32 `json:"limit"`
+}
+
+// Events of the chain after seq, for verification
+func (q *Queries) ListAuditEventsAfter(ctx context.Context, arg ListAuditEventsAfterParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEventsAfter, arg.Seq, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.Seq,
+			&i.OccurredAt,
+			&i.Action,
+			&i.ActorRole,
+			&i.ActorID,
+			&i.PatientID,
+			&i.SessionID,
+			&i.Resource,
+			&i.Details,
+			&i.PrevHash,
+			&i.Hash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChatMessageContent = `-- name: ListChatMessageContent :many
+SELECT id, content FROM chat_messages
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListChatMessageContentParams struct {
+	ID    pgtype.UUID `json:"id"`
+	Limit int32       `json:"limit"`
+}
+
+type ListChatMessageContentRow struct {
+	ID      pgtype.UUID `json:"id"`
+	Content string      `json:"content"`
+}
+
+// Re-encryption batches, in ID order. Updates only apply if the value was
+// not changed since it was read.
+func (q *Queries) ListChatMessageContent(ctx context.Context, arg ListChatMessageContentParams) ([]ListChatMessageContentRow, error) {
+	rows, err := q.db.Query(ctx, listChatMessageContent, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListChatMessageContentRow{}
+	for rows.Next() {
+		var i ListChatMessageContentRow
+		if err := rows.Scan(&i.ID, &i.Content); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDoctors = `-- name: ListDoctors :many
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    d.department_id,
+    dept.name as department_name,
+    d.specialization,
+    d.years_of_experience,
+    d.created_at
+FROM users u
+JOIN doctors d ON d.user_id = u.id
+JOIN ref_departments dept ON dept.id = d.department_id
+WHERE d.deleted_at IS NULL
+AND ($1::varchar IS NULL OR d.department_id = $1)
+ORDER BY d.created_at, u.id
+LIMIT $2 OFFSET $3
+`
+
+type ListDoctorsParams struct {
+	DepartmentID pgtype.Text `json:"department_id"`
+	Limit        int32       `json:"limit"`
+	Offset       int32       `json:"offset"`
+}
+
+type ListDoctorsRow struct {
+	ID                pgtype.UUID        `json:"id"`
+	Email             string             `json:"email"`
+	Name              string             `json:"name"`
+	DepartmentID      pgtype.Text        `json:"department_id"`
+	DepartmentName    string             `json:"department_name"`
+	Specialization    []string           `json:"specialization"`
+	YearsOfExperience pgtype.Int4        `json:"years_of_experience"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListDoctors(ctx context.Context, arg ListDoctorsParams) ([]ListDoctorsRow, error) {
+	rows, err := q.db.Query(ctx, listDoctors, arg.DepartmentID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDoctorsRow{}
+	for rows.Next() {
+		var i ListDoctorsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.DepartmentID,
+			&i.DepartmentName,
+			&i.Specialization,
+			&i.YearsOfExperience,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInactiveChatSessions = `-- name: ListInactiveChatSessions :many
+SELECT cs.id FROM chat_sessions cs
+WHERE cs.created_at < $1
+AND EXISTS (SELECT 1 FROM chat_messages cm WHERE cm.chat_session_id = cs.id)
+AND NOT EXISTS (
+    SELECT 1 FROM chat_messages cm
+    WHERE cm.chat_session_id = cs.id AND cm.created_at >= $1
+)
+LIMIT $2
+`
+
+type ListInactiveChatSessionsParams struct {
+	Cutoff pgtype.Timestamptz `json:"cutoff"`
+	Limit  int32              `json:"limit"`
+}
+
+// Sessions with messages but none since the cutoff
+func (q *Queries) ListInactiveChatSessions(ctx context.Context, arg ListInactiveChatSessionsParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listInactiveChatSessions, arg.Cutoff, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []pgtype.UUID{}
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMedicalHistory = `-- name: ListMedicalHistory :many
+SELECT id, patient_id, condition, diagnosed_date, status_id, notes, created_at
+FROM medical_history
+WHERE patient_id = $1
+AND deleted_at IS NULL
+AND ($2::varchar IS NULL OR status_id = $2)
+ORDER BY diagnosed_date DESC NULLS LAST, created_at DESC, id
+LIMIT $3 OFFSET $4
+`
+
+type ListMedicalHistoryParams struct {
+	PatientID pgtype.UUID `json:"patient_id"`
+	StatusID  pgtype.Text `json:"status_id"`
+	Limit     int32       `json:"limit"`
+	Offset    int32       `json:"offset"`
+}
+
+type ListMedicalHistoryRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	PatientID     pgtype.UUID        `json:"patient_id"`
+	Condition     string             `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      string             `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListMedicalHistory(ctx context.Context, arg ListMedicalHistoryParams) ([]ListMedicalHistoryRow, error) {
+	rows, err := q.db.Query(ctx, listMedicalHistory,
+		arg.PatientID,
+		arg.StatusID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMedicalHistoryRow{}
+	for rows.Next() {
+		var i ListMedicalHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PatientID,
+			&i.Condition,
+			&i.DiagnosedDate,
+			&i.StatusID,
+			&i.Notes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMedicalHistoryForErasure = `-- name: ListMedicalHistoryForErasure :many
+SELECT id, condition, diagnosed_date, status_id, notes, created_at, deleted_at
+FROM medical_history
+WHERE patient_id = $1
+ORDER BY created_at
+`
+
+type ListMedicalHistoryForErasureRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	Condition     string             `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      string             `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	DeletedAt     pgtype.Timestamptz `json:"deleted_at"`
+}
+
+func (q *Queries) ListMedicalHistoryForErasure(ctx context.Context, patientID pgtype.UUID) ([]ListMedicalHistoryForErasureRow, error) {
+	rows, err := q.db.Query(ctx, listMedicalHistoryForErasure, patientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMedicalHistoryForErasureRow{}
+	for rows.Next() {
+		var i ListMedicalHistoryForErasureRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Condition,
+			&i.DiagnosedDate,
+			&i.StatusID,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMedicalHistoryNotes = `-- name: ListMedicalHistoryNotes :many
+SELECT id, notes FROM medical_history
+WHERE id > $1 AND notes IS NOT NULL
+ORDER BY id
+LIMIT $2
+`
+
+type ListMedicalHistoryNotesParams struct {
+	ID    pgtype.UUID `json:"id"`
+	Limit int32       `json:"limit"`
+}
+
+type ListMedicalHistoryNotesRow struct {
+	ID    pgtype.UUID `json:"id"`
+	Notes pgtype.Text `json:"notes"`
+}
+
+func (q *Queries) ListMedicalHistoryNotes(ctx context.Context, arg ListMedicalHistoryNotesParams) ([]ListMedicalHistoryNotesRow, error) {
+	rows, err := q.db.Query(ctx, listMedicalHistoryNotes, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMedicalHistoryNotesRow{}
+	for rows.Next() {
+		var i ListMedicalHistoryNotesRow
+		if err := rows.Scan(&i.ID, &i.Notes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPatientBiometrics = `-- name: ListPatientBiometrics :many
+SELECT 
+    bd.id,
+    bd.type_id,
+    rt.unit_type,
+    bd.value,
+    bd.secondary_value,
+    bd.measured_at,
+    bd.source
+FROM biometric_data bd
+JOIN ref_biometric_types rt ON rt.id = bd.type_id
+WHERE bd.patient_id = $1
+ORDER BY bd.measured_at, bd.type_id
+`
+
+type ListPatientBiometricsRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	TypeID         string             `json:"type_id"`
+	UnitType       string             `json:"unit_type"`
+	Value          pgtype.Numeric     `json:"value"`
+	SecondaryValue pgtype.Numeric     `json:"secondary_value"`
+	MeasuredAt     pgtype.Timestamptz `json:"measured_at"`
+	Source         pgtype.Text        `json:"source"`
+}
+
+func (q *Queries) ListPatientBiometrics(ctx context.Context, patientID pgtype.UUID) ([]ListPatientBiometricsRow, error) {
+	rows, err := q.db.Query(ctx, listPatientBiometrics, patientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPatientBiometricsRow{}
+	for rows.Next() {
+		var i ListPatientBiometricsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TypeID,
+			&i.UnitType,
+			&i.Value,
+			&i.SecondaryValue,
+			&i.MeasuredAt,
+			&i.Source,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPatientChatMessages = `-- name: ListPatientChatMessages :many
+SELECT 
+    cm.id,
+    cm.chat_session_id,
+    cm.sender_id,
+    cm.message_type,
+    cm.content,
+    cm.parent_message_id,
+    cm.created_at
+FROM chat_messages cm
+JOIN chat_sessions cs ON cs.id = cm.chat_session_id
+WHERE cs.patient_id = $1
+ORDER BY cm.created_at
+`
+
+type ListPatientChatMessagesRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	ChatSessionID   pgtype.UUID        `json:"chat_session_id"`
+	SenderID        pgtype.UUID        `json:"sender_id"`
+	MessageType     string             `json:"message_type"`
+	Content         string             `json:"content"`
+	ParentMessageID pgtype.UUID        `json:"parent_message_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListPatientChatMessages(ctx context.Context, patientID pgtype.UUID) ([]ListPatientChatMessagesRow, error) {
+	rows, err := q.db.Query(ctx, listPatientChatMessages, patientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPatientChatMessagesRow{}
+	for rows.Next() {
+		var i ListPatientChatMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChatSessionID,
+			&i.SenderID,
+			&i.MessageType,
+			&i.Content,
+			&i.ParentMessageID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPatientChatSessions = `-- name: ListPatientChatSessions :many
+SELECT id, status, created_at, closed_at
+FROM chat_sessions
+WHERE patient_id = $1
+ORDER BY created_at
+`
+
+type ListPatientChatSessionsRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Status    string             `json:"status"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ClosedAt  pgtype.Timestamptz `json:"closed_at"`
+}
+
+func (q *Queries) ListPatientChatSessions(ctx context.Context, patientID pgtype.UUID) ([]ListPatientChatSessionsRow, error) {
+	rows, err := q.db.Query(ctx, listPatientChatSessions, patientID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPatientChatSessionsRow{}
+	for rows.Next() {
+		var i ListPatientChatSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.ClosedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPatients = `-- name: ListPatients :many
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    p.age,
+    p.gender,
+    p.created_at
+FROM users u
+JOIN patients p ON p.user_id = u.id
+WHERE p.deleted_at IS NULL
+ORDER BY p.created_at, u.id
+LIMIT $1 OFFSET $2
+`
+
+type ListPatientsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ListPatientsRow struct {
+	ID        pgtype.UUID        `json:"id"`
+	Email     string             `json:"email"`
+	Name      string             `json:"name"`
+	Age       int32              `json:"age"`
+	Gender    string             `json:"gender"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) ListPatients(ctx context.Context, arg ListPatientsParams) ([]ListPatientsRow, error) {
+	rows, err := q.db.Query(ctx, listPatients, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPatientsRow{}
+	for rows.Next() {
+		var i ListPatientsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.Age,
+			&i.Gender,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromptExperimentArms = `-- name: ListPromptExperimentArms :many
+SELECT version, template, experiment_weight
+FROM ref_prompt_templates
+WHERE experiment_weight > 0
+ORDER BY version
+`
+
+type ListPromptExperimentArmsRow struct {
+	Version          string `json:"version"`
+	Template         string `json:"template"`
+	ExperimentWeight int32  `json:"experiment_weight"`
+}
+
+func (q *Queries) ListPromptExperimentArms(ctx context.Context) ([]ListPromptExperimentArmsRow, error) {
+	rows, err := q.db.Query(ctx, listPromptExperimentArms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPromptExperimentArmsRow{}
+	for rows.Next() {
+		var i ListPromptExperimentArmsRow
+		if err := rows.Scan(&i.Version, &i.Template, &i.ExperimentWeight); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPromptTemplates = `-- name: ListPromptTemplates :many
+SELECT version, template, description, is_active, created_at, updated_at, experiment_weight FROM ref_prompt_templates
+ORDER BY created_at DESC, version
+`
+
+func (q *Queries) ListPromptTemplates(ctx context.Context) ([]RefPromptTemplate, error) {
+	rows, err := q.db.Query(ctx, listPromptTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RefPromptTemplate{}
+	for rows.Next() {
+		var i RefPromptTemplate
+		if err := rows.Scan(
+			&i.Version,
+			&i.Template,
+			&i.Description,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExperimentWeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentBiometrics = `-- name: ListRecentBiometrics :many
+SELECT value, secondary_value, measured_at
+FROM biometric_data
+WHERE patient_id = $1
+AND type_id = $2
+AND measured_at >= $3
+ORDER BY measured_at
+`
+
+type ListRecentBiometricsParams struct {
+	PatientID  pgtype.UUID        `json:"patient_id"`
+	TypeID     string             `json:"type_id"`
+	MeasuredAt pgtype.Timestamptz `json:"measured_at"`
+}
+
+type ListRecentBiometricsRow struct {
+	Value          pgtype.Numeric     `json:"value"`
+	SecondaryValue pgtype.Numeric     `json:"secondary_value"`
+	MeasuredAt     pgtype.Timestamptz `json:"measured_at"`
+}
+
+func (q *Queries) ListRecentBiometrics(ctx context.Context, arg ListRecentBiometricsParams) ([]ListRecentBiometricsRow, error) {
+	rows, err := q.db.Query(ctx, listRecentBiometrics, arg.PatientID, arg.TypeID, arg.MeasuredAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRecentBiometricsRow{}
+	for rows.Next() {
+		var i ListRecentBiometricsRow
+		if err := rows.Scan(&i.Value, &i.SecondaryValue, &i.MeasuredAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAuditLog = `-- name: LockAuditLog :exec
+SELECT pg_advisory_xact_lock(7301)
+`
+
+// Serializes appends to the audit chain until the transaction ends
+func (q *Queries) LockAuditLog(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockAuditLog)
+	return err
 }
 
-func (q *Queries) GetPendingReviewsCount(ctx context.Context, arg GetPendingReviewsCountParams) (int64, error) {
-	row := q.db.QueryRow(ctx, getPendingReviewsCount,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
-		arg.Column5,
-	)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+const markMessageDelivered = `-- name: MarkMessageDelivered :exec
+UPDATE message_deliveries
+SET status = 'DELIVERY_STATUS_DELIVERED', delivered_at = CURRENT_TIMESTAMP
+WHERE message_id = $1 AND status = 'DELIVERY_STATUS_QUEUED'
+`
+
+func (q *Queries) MarkMessageDelivered(ctx context.Context, messageID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markMessageDelivered, messageID)
+	return err
 }
 
-const getQuestionStatus = `-- name: GetQuestionStatus :one
-SELECT 
-    q.id, q.patient_id, q.question_text, q.question_type, q.department, q.urgency_level, q.status, q.created_at, q.answered_at, q.answered_by,
-    a.answer_text,
-    a.doctor_id as answered_by
-FROM questions q
-LEFT JOIN answers a ON q.id = a.question_id
-WHERE q.id = $1 AND q.patient_id = $2
-LIMIT 1
+const markMessageRead = `-- name: MarkMessageRead :exec
+UPDATE message_deliveries
+SET status = 'DELIVERY_STATUS_READ',
+    delivered_at = COALESCE(delivered_at, CURRENT_TIMESTAMP),
+    read_at = CURRENT_TIMESTAMP
+WHERE message_id = $1 AND status <> 'DELIVERY_STATUS_READ'
 `
 
-type GetQuestionStatusParams struct {
-	ID        pgtype.UUID `json:"id"`
-	PatientID pgtype.UUID `json:"patient_id"`
+// Reading a message implies it was delivered
+func (q *Queries) MarkMessageRead(ctx context.Context, messageID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markMessageRead, messageID)
+	return err
 }
 
-type GetQuestionStatusRow struct {
-	ID           pgtype.UUID        `json:"id"`
-	PatientID    pgtype.UUID        `json:"patient_id"`
-	QuestionText string             `json:"question_text"`
-	QuestionType string             `json:"question_type"`
-	Department   string             `json:"department"`
-	UrgencyLevel pgtype.Int4        `json:"urgency_level"`
-	Status       string             `json:"status"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	AnsweredAt   pgtype.Timestamptz `json:"answered_at"`
-	AnsweredBy   pgtype.UUID        `json:"answered_by"`
-	AnswerText   pgtype.Text        `json:"answer_text"`
-	AnsweredBy_2 pgtype.UUID        `json:"answered_by_2"`
+const recordAIInteractionSLABreach = `-- name: RecordAIInteractionSLABreach :execrows
+UPDATE ai_interactions
+SET 
+    review_due_at = $2,
+    sla_breached_at = $3,
+    escalated_to = $4
+WHERE chat_message_id = $1
+AND sla_breached_at IS NULL
+`
+
+type RecordAIInteractionSLABreachParams struct {
+	ChatMessageID pgtype.UUID        `json:"chat_message_id"`
+	ReviewDueAt   pgtype.Timestamptz `json:"review_due_at"`
+	SlaBreachedAt pgtype.Timestamptz `json:"sla_breached_at"`
+	EscalatedTo   pgtype.UUID        `json:"escalated_to"`
 }
 
-func (q *Queries) GetQuestionStatus(ctx context.Context, arg GetQuestionStatusParams) (GetQuestionStatusRow, error) {
-	row := q.db.QueryRow(ctx, getQuestionStatus, arg.ID, arg.PatientID)
-	var i GetQuestionStatusRow
-	err := row.Scan(
-		&i.ID,
-		&i.PatientID,
-		&i.QuestionText,
-		&i.QuestionType,
-		&i.Department,
-		&i.UrgencyLevel,
-		&i.Status,
-		&i.CreatedAt,
-		&i.AnsweredAt,
-		&i.AnsweredBy,
-		&i.AnswerText,
-		&i.AnsweredBy_2,
+func (q *Queries) RecordAIInteractionSLABreach(ctx context.Context, arg RecordAIInteractionSLABreachParams) (int64, error) {
+	result, err := q.db.Exec(ctx, recordAIInteractionSLABreach,
+		arg.ChatMessageID,
+		arg.ReviewDueAt,
+		arg.SlaBreachedAt,
+		arg.EscalatedTo,
 	)
-	return i, err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const saveAIDraftAnswer = `-- name: SaveAIDraftAnswer :one
@@ -684,6 +3033,84 @@ func (q *Queries) SaveAIDraftAnswer(ctx context.Context, arg SaveAIDraftAnswerPa
 	return i, err
 }
 
+const setPromptExperimentWeight = `-- name: SetPromptExperimentWeight :execrows
+UPDATE ref_prompt_templates
+SET 
+    experiment_weight = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE version = $1
+`
+
+type SetPromptExperimentWeightParams struct {
+	Version          string `json:"version"`
+	ExperimentWeight int32  `json:"experiment_weight"`
+}
+
+func (q *Queries) SetPromptExperimentWeight(ctx context.Context, arg SetPromptExperimentWeightParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPromptExperimentWeight, arg.Version, arg.ExperimentWeight)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteDoctor = `-- name: SoftDeleteDoctor :execrows
+UPDATE doctors
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteDoctor(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteDoctor, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeleteMedicalHistory = `-- name: SoftDeleteMedicalHistory :execrows
+UPDATE medical_history
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeleteMedicalHistory(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeleteMedicalHistory, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeletePatient = `-- name: SoftDeletePatient :execrows
+UPDATE patients
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeletePatient(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, softDeletePatient, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const softDeletePatientMedicalHistory = `-- name: SoftDeletePatientMedicalHistory :exec
+UPDATE medical_history
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE patient_id = $1
+AND deleted_at IS NULL
+`
+
+func (q *Queries) SoftDeletePatientMedicalHistory(ctx context.Context, patientID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, softDeletePatientMedicalHistory, patientID)
+	return err
+}
+
 const submitReview = `-- name: SubmitReview :one
 WITH updated_question AS (
     UPDATE questions
@@ -741,6 +3168,259 @@ func (q *Queries) SubmitReview(ctx context.Context, arg SubmitReviewParams) (Ans
 	return i, err
 }
 
+const updateAIInteractionModifiedContent = `-- name: UpdateAIInteractionModifiedContent :execrows
+UPDATE ai_interactions
+SET modified_content = $1
+WHERE id = $2 AND modified_content = $3
+`
+
+type UpdateAIInteractionModifiedContentParams struct {
+	ModifiedContent    pgtype.Text `json:"modified_content"`
+	ID                 pgtype.UUID `json:"id"`
+	OldModifiedContent pgtype.Text `json:"old_modified_content"`
+}
+
+func (q *Queries) UpdateAIInteractionModifiedContent(ctx context.Context, arg UpdateAIInteractionModifiedContentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAIInteractionModifiedContent, arg.ModifiedContent, arg.ID, arg.OldModifiedContent)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateAIInteractionPromptComponents = `-- name: UpdateAIInteractionPromptComponents :execrows
+UPDATE ai_interactions
+SET prompt_components = $1
+WHERE id = $2 AND prompt_components = $3
+`
+
+type UpdateAIInteractionPromptComponentsParams struct {
+	PromptComponents    []byte      `json:"prompt_components"`
+	ID                  pgtype.UUID `json:"id"`
+	OldPromptComponents []byte      `json:"old_prompt_components"`
+}
+
+func (q *Queries) UpdateAIInteractionPromptComponents(ctx context.Context, arg UpdateAIInteractionPromptComponentsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAIInteractionPromptComponents, arg.PromptComponents, arg.ID, arg.OldPromptComponents)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateAIInteractionResponse = `-- name: UpdateAIInteractionResponse :execrows
+UPDATE ai_interactions
+SET ai_response = $1
+WHERE id = $2 AND ai_response = $3
+`
+
+type UpdateAIInteractionResponseParams struct {
+	AiResponse    string      `json:"ai_response"`
+	ID            pgtype.UUID `json:"id"`
+	OldAiResponse string      `json:"old_ai_response"`
+}
+
+func (q *Queries) UpdateAIInteractionResponse(ctx context.Context, arg UpdateAIInteractionResponseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAIInteractionResponse, arg.AiResponse, arg.ID, arg.OldAiResponse)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateAIInteractionReview = `-- name: UpdateAIInteractionReview :execrows
+UPDATE ai_interactions
+SET 
+    review_status = $2,
+    review_comment = $3,
+    modified_content = $4,
+    reviewed_at = CURRENT_TIMESTAMP,
+    reviewed_by = (SELECT user_id FROM doctors WHERE user_id = $5)
+WHERE chat_message_id = $1
+AND chat_message_id IN (SELECT id FROM chat_messages WHERE chat_session_id = $6)
+`
+
+type UpdateAIInteractionReviewParams struct {
+	ChatMessageID   pgtype.UUID `json:"chat_message_id"`
+	ReviewStatus    pgtype.Text `json:"review_status"`
+	ReviewComment   pgtype.Text `json:"review_comment"`
+	ModifiedContent pgtype.Text `json:"modified_content"`
+	ReviewedBy      pgtype.UUID `json:"reviewed_by"`
+	ChatSessionID   pgtype.UUID `json:"chat_session_id"`
+}
+
+// Reviews by users who are not doctors are stored without a reviewer. Only
+// drafts of the reviewer's chat session are updated.
+func (q *Queries) UpdateAIInteractionReview(ctx context.Context, arg UpdateAIInteractionReviewParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateAIInteractionReview,
+		arg.ChatMessageID,
+		arg.ReviewStatus,
+		arg.ReviewComment,
+		arg.ModifiedContent,
+		arg.ReviewedBy,
+		arg.ChatSessionID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateChatMessageContent = `-- name: UpdateChatMessageContent :execrows
+UPDATE chat_messages
+SET content = $1
+WHERE id = $2 AND content = $3
+`
+
+type UpdateChatMessageContentParams struct {
+	Content    string      `json:"content"`
+	ID         pgtype.UUID `json:"id"`
+	OldContent string      `json:"old_content"`
+}
+
+func (q *Queries) UpdateChatMessageContent(ctx context.Context, arg UpdateChatMessageContentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateChatMessageContent, arg.Content, arg.ID, arg.OldContent)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateChatSessionStatus = `-- name: UpdateChatSessionStatus :exec
+UPDATE chat_sessions 
+SET 
+    status = $2,
+    closed_at = CASE WHEN $2 = 'CHAT_SESSION_STATUS_CLOSED' THEN CURRENT_TIMESTAMP ELSE NULL END
+WHERE id = $1
+`
+
+type UpdateChatSessionStatusParams struct {
+	ID     pgtype.UUID `json:"id"`
+	Status string      `json:"status"`
+}
+
+func (q *Queries) UpdateChatSessionStatus(ctx context.Context, arg UpdateChatSessionStatusParams) error {
+	_, err := q.db.Exec(ctx, updateChatSessionStatus, arg.ID, arg.Status)
+	return err
+}
+
+const updateDoctor = `-- name: UpdateDoctor :one
+UPDATE doctors
+SET 
+    department_id = COALESCE($1, department_id),
+    specialization = CASE WHEN $2::boolean THEN $3::text[] ELSE specialization END,
+    years_of_experience = COALESCE($4, years_of_experience)
+WHERE user_id = $5
+AND deleted_at IS NULL
+RETURNING user_id, department_id, specialization, years_of_experience, created_at
+`
+
+type UpdateDoctorParams struct {
+	DepartmentID      pgtype.Text `json:"department_id"`
+	SetSpecialization bool        `json:"set_specialization"`
+	Specialization    []string    `json:"specialization"`
+	YearsOfExperience pgtype.Int4 `json:"years_of_experience"`
+	UserID            pgtype.UUID `json:"user_id"`
+}
+
+type UpdateDoctorRow struct {
+	UserID            pgtype.UUID        `json:"user_id"`
+	DepartmentID      pgtype.Text        `json:"department_id"`
+	Specialization    []string           `json:"specialization"`
+	YearsOfExperience pgtype.Int4        `json:"years_of_experience"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) UpdateDoctor(ctx context.Context, arg UpdateDoctorParams) (UpdateDoctorRow, error) {
+	row := q.db.QueryRow(ctx, updateDoctor,
+		arg.DepartmentID,
+		arg.SetSpecialization,
+		arg.Specialization,
+		arg.YearsOfExperience,
+		arg.UserID,
+	)
+	var i UpdateDoctorRow
+	err := row.Scan(
+		&i.UserID,
+		&i.DepartmentID,
+		&i.Specialization,
+		&i.YearsOfExperience,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateMedicalHistory = `-- name: UpdateMedicalHistory :one
+UPDATE medical_history
+SET 
+    condition = COALESCE($1, condition),
+    diagnosed_date = COALESCE($2, diagnosed_date),
+    status_id = COALESCE($3, status_id),
+    notes = COALESCE($4, notes)
+WHERE id = $5
+AND deleted_at IS NULL
+RETURNING id, patient_id, condition, diagnosed_date, status_id, notes, created_at
+`
+
+type UpdateMedicalHistoryParams struct {
+	Condition     pgtype.Text        `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      pgtype.Text        `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+	ID            pgtype.UUID        `json:"id"`
+}
+
+type UpdateMedicalHistoryRow struct {
+	ID            pgtype.UUID        `json:"id"`
+	PatientID     pgtype.UUID        `json:"patient_id"`
+	Condition     string             `json:"condition"`
+	DiagnosedDate pgtype.Timestamptz `json:"diagnosed_date"`
+	StatusID      string             `json:"status_id"`
+	Notes         pgtype.Text        `json:"notes"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) UpdateMedicalHistory(ctx context.Context, arg UpdateMedicalHistoryParams) (UpdateMedicalHistoryRow, error) {
+	row := q.db.QueryRow(ctx, updateMedicalHistory,
+		arg.Condition,
+		arg.DiagnosedDate,
+		arg.StatusID,
+		arg.Notes,
+		arg.ID,
+	)
+	var i UpdateMedicalHistoryRow
+	err := row.Scan(
+		&i.ID,
+		&i.PatientID,
+		&i.Condition,
+		&i.DiagnosedDate,
+		&i.StatusID,
+		&i.Notes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateMedicalHistoryNotes = `-- name: UpdateMedicalHistoryNotes :execrows
+UPDATE medical_history
+SET notes = $1
+WHERE id = $2 AND notes = $3
+`
+
+type UpdateMedicalHistoryNotesParams struct {
+	Notes    pgtype.Text `json:"notes"`
+	ID       pgtype.UUID `json:"id"`
+	OldNotes pgtype.Text `json:"old_notes"`
+}
+
+func (q *Queries) UpdateMedicalHistoryNotes(ctx context.Context, arg UpdateMedicalHistoryNotesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateMedicalHistoryNotes, arg.Notes, arg.ID, arg.OldNotes)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateMedicalHistoryStatus = `-- name: UpdateMedicalHistoryStatus :one
 UPDATE medical_history
 SET 
@@ -777,6 +3457,41 @@ func (q *Queries) UpdateMedicalHistoryStatus(ctx context.Context, arg UpdateMedi
 	return i, err
 }
 
+const updatePatient = `-- name: UpdatePatient :one
+UPDATE patients
+SET 
+    age = COALESCE($1, age),
+    gender = COALESCE($2, gender)
+WHERE user_id = $3
+AND deleted_at IS NULL
+RETURNING user_id, age, gender, created_at
+`
+
+type UpdatePatientParams struct {
+	Age    pgtype.Int4 `json:"age"`
+	Gender pgtype.Text `json:"gender"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+type UpdatePatientRow struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	Age       int32              `json:"age"`
+	Gender    string             `json:"gender"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) UpdatePatient(ctx context.Context, arg UpdatePatientParams) (UpdatePatientRow, error) {
+	row := q.db.QueryRow(ctx, updatePatient, arg.Age, arg.Gender, arg.UserID)
+	var i UpdatePatientRow
+	err := row.Scan(
+		&i.UserID,
+		&i.Age,
+		&i.Gender,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const updatePatientDemographics = `-- name: UpdatePatientDemographics :one
 UPDATE patients
 SET
@@ -824,3 +3539,30 @@ func (q *Queries) UpdateQuestionStatus(ctx context.Context, arg UpdateQuestionSt
 	_, err := q.db.Exec(ctx, updateQuestionStatus, arg.ID, arg.Status)
 	return err
 }
+
+const updateUser = `-- name: UpdateUser :one
+UPDATE users
+SET 
+    email = COALESCE($1, email),
+    name = COALESCE($2, name)
+WHERE id = $3
+RETURNING id, email, name, created_at
+`
+
+type UpdateUserParams struct {
+	Email pgtype.Text `json:"email"`
+	Name  pgtype.Text `json:"name"`
+	ID    pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUser, arg.Email, arg.Name, arg.ID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -13,7 +13,16 @@ INSERT INTO users (
     $1, $2
 ) RETURNING *;
 
+-- name: UpdateUser :one
+UPDATE users
+SET 
+    email = COALESCE(sqlc.narg('email'), email),
+    name = COALESCE(sqlc.narg('name'), name)
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
 -- Patient related queries
+-- Creating a deleted patient restores them
 -- name: CreatePatient :one
 INSERT INTO patients (
     user_id,
@@ -21,7 +30,13 @@ INSERT INTO patients (
     gender
 ) VALUES (
     $1, $2, $3
-) RETURNING *;
+) ON CONFLICT (user_id) DO UPDATE
+SET 
+    age = EXCLUDED.age,
+    gender = EXCLUDED.gender,
+    deleted_at = NULL
+WHERE patients.deleted_at IS NOT NULL
+RETURNING user_id, age, gender, created_at;
 
 -- name: GetPatientByUserID :one
 SELECT 
@@ -33,9 +48,40 @@ SELECT
     p.created_at
 FROM users u
 JOIN patients p ON p.user_id = u.id
-WHERE u.id = $1;
+WHERE u.id = $1
+AND p.deleted_at IS NULL;
+
+-- name: ListPatients :many
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    p.age,
+    p.gender,
+    p.created_at
+FROM users u
+JOIN patients p ON p.user_id = u.id
+WHERE p.deleted_at IS NULL
+ORDER BY p.created_at, u.id
+LIMIT $1 OFFSET $2;
+
+-- name: UpdatePatient :one
+UPDATE patients
+SET 
+    age = COALESCE(sqlc.narg('age'), age),
+    gender = COALESCE(sqlc.narg('gender'), gender)
+WHERE user_id = sqlc.arg('user_id')
+AND deleted_at IS NULL
+RETURNING user_id, age, gender, created_at;
+
+-- name: SoftDeletePatient :execrows
+UPDATE patients
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+AND deleted_at IS NULL;
 
 -- Doctor related queries
+-- Creating a deleted doctor restores them
 -- name: CreateDoctor :one
 INSERT INTO doctors (
     user_id,
@@ -44,7 +90,14 @@ INSERT INTO doctors (
     years_of_experience
 ) VALUES (
     $1, $2, $3, $4
-) RETURNING *;
+) ON CONFLICT (user_id) DO UPDATE
+SET 
+    department_id = EXCLUDED.department_id,
+    specialization = EXCLUDED.specialization,
+    years_of_experience = EXCLUDED.years_of_experience,
+    deleted_at = NULL
+WHERE doctors.deleted_at IS NOT NULL
+RETURNING user_id, department_id, specialization, years_of_experience, created_at;
 
 -- name: GetDoctorByUserID :one
 SELECT 
@@ -54,29 +107,100 @@ SELECT
     d.department_id,
     dept.name as department_name,
     d.specialization,
-    d.years_of_experience
+    d.years_of_experience,
+    d.created_at
 FROM users u
 JOIN doctors d ON d.user_id = u.id
 JOIN ref_departments dept ON dept.id = d.department_id
-WHERE u.id = $1;
+WHERE u.id = $1
+AND d.deleted_at IS NULL;
+
+-- name: ListDoctors :many
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    d.department_id,
+    dept.name as department_name,
+    d.specialization,
+    d.years_of_experience,
+    d.created_at
+FROM users u
+JOIN doctors d ON d.user_id = u.id
+JOIN ref_departments dept ON dept.id = d.department_id
+WHERE d.deleted_at IS NULL
+AND (sqlc.narg('department_id')::varchar IS NULL OR d.department_id = sqlc.narg('department_id'))
+ORDER BY d.created_at, u.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: UpdateDoctor :one
+UPDATE doctors
+SET 
+    department_id = COALESCE(sqlc.narg('department_id'), department_id),
+    specialization = CASE WHEN sqlc.arg('set_specialization')::boolean THEN sqlc.arg('specialization')::text[] ELSE specialization END,
+    years_of_experience = COALESCE(sqlc.narg('years_of_experience'), years_of_experience)
+WHERE user_id = sqlc.arg('user_id')
+AND deleted_at IS NULL
+RETURNING user_id, department_id, specialization, years_of_experience, created_at;
+
+-- name: SoftDeleteDoctor :execrows
+UPDATE doctors
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE user_id = $1
+AND deleted_at IS NULL;
 
 -- Medical History
 -- name: AddMedicalHistory :one
 INSERT INTO medical_history (
+    id,
     patient_id,
     condition,
     diagnosed_date,
-    status,
+    status_id,
     notes
 ) VALUES (
-    $1, $2, $3, $4, $5
-) RETURNING *;
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, patient_id, condition, diagnosed_date, status_id, notes, created_at;
 
 -- name: GetPatientMedicalHistory :many
-SELECT * FROM medical_history 
+SELECT id, patient_id, condition, diagnosed_date, status_id, notes, created_at
+FROM medical_history 
 WHERE patient_id = $1 
+AND deleted_at IS NULL
 ORDER BY diagnosed_date DESC;
 
+-- name: ListMedicalHistory :many
+SELECT id, patient_id, condition, diagnosed_date, status_id, notes, created_at
+FROM medical_history
+WHERE patient_id = sqlc.arg('patient_id')
+AND deleted_at IS NULL
+AND (sqlc.narg('status_id')::varchar IS NULL OR status_id = sqlc.narg('status_id'))
+ORDER BY diagnosed_date DESC NULLS LAST, created_at DESC, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: UpdateMedicalHistory :one
+UPDATE medical_history
+SET 
+    condition = COALESCE(sqlc.narg('condition'), condition),
+    diagnosed_date = COALESCE(sqlc.narg('diagnosed_date'), diagnosed_date),
+    status_id = COALESCE(sqlc.narg('status_id'), status_id),
+    notes = COALESCE(sqlc.narg('notes'), notes)
+WHERE id = sqlc.arg('id')
+AND deleted_at IS NULL
+RETURNING id, patient_id, condition, diagnosed_date, status_id, notes, created_at;
+
+-- name: SoftDeleteMedicalHistory :execrows
+UPDATE medical_history
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE id = $1
+AND deleted_at IS NULL;
+
+-- name: SoftDeletePatientMedicalHistory :exec
+UPDATE medical_history
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE patient_id = $1
+AND deleted_at IS NULL;
+
 -- name: GetActiveConditions :many
 SELECT * FROM medical_history 
 WHERE patient_id = $1 
@@ -94,11 +218,47 @@ INSERT INTO biometric_data (
     $1, $2, $3, COALESCE($4, CURRENT_TIMESTAMP)
 ) RETURNING *;
 
+-- name: IngestBiometricReading :execrows
+INSERT INTO biometric_data (
+    patient_id,
+    type_id,
+    value,
+    secondary_value,
+    measured_at,
+    source
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) ON CONFLICT (patient_id, type_id, measured_at) DO NOTHING;
+
+-- name: ListRecentBiometrics :many
+SELECT value, secondary_value, measured_at
+FROM biometric_data
+WHERE patient_id = $1
+AND type_id = $2
+AND measured_at >= $3
+ORDER BY measured_at;
+
+-- name: ListPatientBiometrics :many
+SELECT 
+    bd.id,
+    bd.type_id,
+    rt.unit_type,
+    bd.value,
+    bd.secondary_value,
+    bd.measured_at,
+    bd.source
+FROM biometric_data bd
+JOIN ref_biometric_types rt ON rt.id = bd.type_id
+WHERE bd.patient_id = $1
+ORDER BY bd.measured_at, bd.type_id;
+
 -- name: GetLatestBiometrics :many
 SELECT 
+    bd.type_id,
     rt.name as type_name,
     rt.unit_type,
     bd.value,
+    bd.secondary_value,
     bd.measured_at
 FROM (
     SELECT DISTINCT ON (type_id) *
@@ -119,6 +279,16 @@ SELECT * FROM ref_departments
 WHERE active = true 
 ORDER BY name;
 
+-- name: ListActiveGenders :many
+SELECT * FROM ref_gender 
+WHERE active = true 
+ORDER BY name;
+
+-- name: ListActiveConditionStatuses :many
+SELECT * FROM ref_medical_condition_status 
+WHERE active = true 
+ORDER BY name;
+
 -- name: GetActivePromptTemplate :one
 SELECT version, template 
 FROM ref_prompt_templates 
@@ -126,6 +296,54 @@ WHERE is_active = true
 ORDER BY created_at DESC 
 LIMIT 1;
 
+-- Prompt Template Management
+-- name: CreatePromptTemplate :one
+INSERT INTO ref_prompt_templates (
+    version,
+    template,
+    description,
+    is_active
+) VALUES (
+    $1, $2, $3, false
+) RETURNING *;
+
+-- name: GetPromptTemplate :one
+SELECT * FROM ref_prompt_templates
+WHERE version = $1;
+
+-- name: ListPromptTemplates :many
+SELECT * FROM ref_prompt_templates
+ORDER BY created_at DESC, version;
+
+-- Activating a version deactivates the others
+-- name: ActivatePromptTemplate :exec
+UPDATE ref_prompt_templates
+SET 
+    is_active = (version = $1),
+    updated_at = CURRENT_TIMESTAMP
+WHERE is_active = true
+OR version = $1;
+
+-- name: ClearPromptExperiment :exec
+UPDATE ref_prompt_templates
+SET 
+    experiment_weight = 0,
+    updated_at = CURRENT_TIMESTAMP
+WHERE experiment_weight > 0;
+
+-- name: SetPromptExperimentWeight :execrows
+UPDATE ref_prompt_templates
+SET 
+    experiment_weight = $2,
+    updated_at = CURRENT_TIMESTAMP
+WHERE version = $1;
+
+-- name: ListPromptExperimentArms :many
+SELECT version, template, experiment_weight
+FROM ref_prompt_templates
+WHERE experiment_weight > 0
+ORDER BY version;
+
 -- Chat Session Management
 -- name: CreateChatSession :one
 INSERT INTO chat_sessions (
@@ -143,6 +361,10 @@ SET
     closed_at = CASE WHEN $2 = 'CHAT_SESSION_STATUS_CLOSED' THEN CURRENT_TIMESTAMP ELSE NULL END
 WHERE id = $1;
 
+-- name: GetChatSession :one
+SELECT * FROM chat_sessions 
+WHERE id = $1;
+
 -- name: GetActiveChatSession :one
 SELECT * FROM chat_sessions 
 WHERE patient_id = $1 
@@ -153,6 +375,7 @@ LIMIT 1;
 -- Chat Messages
 -- name: CreateChatMessage :one
 INSERT INTO chat_messages (
+    id,
     chat_session_id,
     sender_id,
     content,
@@ -160,9 +383,21 @@ INSERT INTO chat_messages (
     parent_message_id,
     metadata
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
+-- Drafts are stored under the message ID they were published with
+-- name: CreateDraftMessage :exec
+INSERT INTO chat_messages (
+    id,
+    chat_session_id,
+    sender_id,
+    content,
+    message_type
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
 -- name: GetChatHistory :many
 SELECT 
     cm.id,
@@ -224,7 +459,7 @@ JOIN patients p ON p.user_id = u.id
 WHERE u.id = $1;
 
 -- AI Interactions
--- name: CreateAIInteraction :one
+-- name: CreateAIInteraction :exec
 INSERT INTO ai_interactions (
     chat_message_id,
     prompt_template_version,
@@ -234,23 +469,35 @@ INSERT INTO ai_interactions (
     references
 ) VALUES (
     $1, $2, $3, $4, $5, $6
-) RETURNING *;
+);
 
--- name: UpdateAIInteractionReview :one
+-- Reviews by users who are not doctors are stored without a reviewer. Only
+-- drafts of the reviewer's chat session are updated.
+-- name: UpdateAIInteractionReview :execrows
 UPDATE ai_interactions
 SET 
     review_status = $2,
     review_comment = $3,
     modified_content = $4,
     reviewed_at = CURRENT_TIMESTAMP,
-    reviewed_by = $5
+    reviewed_by = (SELECT user_id FROM doctors WHERE user_id = $5)
 WHERE chat_message_id = $1
-RETURNING *;
+AND chat_message_id IN (SELECT id FROM chat_messages WHERE chat_session_id = $6);
+
+-- name: RecordAIInteractionSLABreach :execrows
+UPDATE ai_interactions
+SET 
+    review_due_at = $2,
+    sla_breached_at = $3,
+    escalated_to = $4
+WHERE chat_message_id = $1
+AND sla_breached_at IS NULL;
 
 -- Training Data Collection
 -- name: GetAITrainingData :many
 SELECT 
     ai.id,
+    ai.chat_message_id,
     ai.prompt_template_version,
     pt.template as prompt_template,
     ai.prompt_components,
@@ -262,13 +509,21 @@ SELECT
     ai.created_at,
     ai.reviewed_at,
     d.user_id as reviewer_id,
-    u.name as reviewer_name
+    u.name as reviewer_name,
+    d.department_id,
+    pu.name as patient_name,
+    pu.email as patient_email
 FROM ai_interactions ai
+JOIN chat_messages cm ON cm.id = ai.chat_message_id
+JOIN chat_sessions cs ON cs.id = cm.chat_session_id
+JOIN users pu ON pu.id = cs.patient_id
 LEFT JOIN ref_prompt_templates pt ON pt.version = ai.prompt_template_version
 LEFT JOIN doctors d ON d.user_id = ai.reviewed_by
 LEFT JOIN users u ON u.id = d.user_id
-WHERE ai.created_at BETWEEN $1 AND $2
+WHERE ai.created_at BETWEEN sqlc.arg('start_time') AND sqlc.arg('end_time')
 AND ai.review_status IS NOT NULL
+AND (sqlc.narg('review_status')::text IS NULL OR ai.review_status = sqlc.narg('review_status'))
+AND (sqlc.narg('department_id')::text IS NULL OR d.department_id = sqlc.narg('department_id'))
 ORDER BY ai.created_at DESC;
 
 -- AI Performance Analytics
@@ -280,4 +535,344 @@ SELECT
     COUNT(CASE WHEN review_status = 'modified' THEN 1 END) as modified_count,
     AVG(confidence_score) as avg_confidence_score
 FROM ai_interactions
-WHERE created_at BETWEEN $1 AND $2;
\ No newline at end of file
+WHERE created_at BETWEEN $1 AND $2;
+
+-- Per template version, drafts of the LLM service's default prompt under ""
+-- name: GetAIInteractionStatsByTemplate :many
+SELECT 
+    COALESCE(prompt_template_version, '')::text as prompt_template_version,
+    COUNT(*) as total_interactions,
+    COUNT(CASE WHEN review_status = 'approved' THEN 1 END) as approved_count,
+    COUNT(CASE WHEN review_status = 'rejected' THEN 1 END) as rejected_count,
+    COUNT(CASE WHEN review_status = 'modified' THEN 1 END) as modified_count,
+    COUNT(CASE WHEN review_status IS NULL THEN 1 END) as pending_count,
+    COALESCE(AVG(confidence_score), 0)::float8 as avg_confidence_score
+FROM ai_interactions
+WHERE created_at BETWEEN sqlc.arg('start_time') AND sqlc.arg('end_time')
+GROUP BY 1
+ORDER BY 1;
+
+-- Draft outcomes, confidence, review latency and the edit distances kept
+-- at de-identification, per UTC bucket of date_trunc and group ('doctor',
+-- 'department' or 'prompt_version'). An empty bucket or group puts every
+-- draft in one.
+-- name: GetDraftQualityStats :many
+SELECT
+    (CASE WHEN sqlc.arg('bucket')::text = '' THEN NULL
+     ELSE date_trunc(sqlc.arg('bucket')::text, ai.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' END)::timestamptz as bucket_start,
+    (CASE sqlc.arg('group_by')::text
+        WHEN 'doctor' THEN COALESCE(ai.reviewed_by::text, '')
+        WHEN 'department' THEN COALESCE(d.department_id, '')
+        WHEN 'prompt_version' THEN COALESCE(ai.prompt_template_version, '')
+        ELSE '' END)::text as group_id,
+    (CASE sqlc.arg('group_by')::text
+        WHEN 'doctor' THEN COALESCE(u.name, '')
+        WHEN 'department' THEN COALESCE(rd.name, '')
+        ELSE '' END)::text as group_name,
+    COUNT(*) as total,
+    COUNT(*) FILTER (WHERE ai.review_status IS NULL OR ai.review_status NOT IN ('approved', 'modified', 'rejected')) as pending,
+    COUNT(*) FILTER (WHERE ai.review_status = 'approved') as accepted,
+    COUNT(*) FILTER (WHERE ai.review_status = 'modified') as modified,
+    COUNT(*) FILTER (WHERE ai.review_status = 'rejected') as rejected,
+    COALESCE(AVG(ai.confidence_score), 0)::float8 as mean_confidence,
+    COALESCE(AVG(EXTRACT(EPOCH FROM ai.reviewed_at - ai.created_at)::float8)
+        FILTER (WHERE ai.review_status IN ('approved', 'modified', 'rejected') AND ai.reviewed_at IS NOT NULL), 0)::float8 as mean_review_latency_seconds,
+    COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ai.reviewed_at - ai.created_at)::float8)
+        FILTER (WHERE ai.review_status IN ('approved', 'modified', 'rejected') AND ai.reviewed_at IS NOT NULL), 0)::float8 as median_review_latency_seconds,
+    COALESCE(SUM(ai.edit_distance)
+        FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NOT NULL), 0)::float8 as edit_distance_sum,
+    COALESCE(SUM(ai.edit_distance::float8 / NULLIF(ai.edit_length, 0))
+        FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NOT NULL), 0)::float8 as normalized_edit_distance_sum,
+    COUNT(ai.edit_distance) FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NOT NULL) as edit_distance_count,
+    COUNT(*) FILTER (WHERE ai.review_status IN ('approved', 'modified') AND ai.deidentified_at IS NULL) as unscored_count
+FROM ai_interactions ai
+LEFT JOIN doctors d ON d.user_id = ai.reviewed_by
+LEFT JOIN users u ON u.id = d.user_id
+LEFT JOIN ref_departments rd ON rd.id = d.department_id
+WHERE ai.created_at >= sqlc.arg('start_time')
+AND ai.created_at < sqlc.arg('end_time')
+GROUP BY 1, 2, 3
+ORDER BY 1, 2;
+
+-- A random sample of up to sample_size of the accepted and modified drafts
+-- of each group that still have their texts, bucketed and grouped as in
+-- GetDraftQualityStats, to estimate their edit distance from
+-- name: ListAIInteractionsForAnalytics :many
+SELECT bucket_start, group_id, chat_message_id, ai_response, review_status, modified_content
+FROM (
+    SELECT drafts.*,
+        row_number() OVER (PARTITION BY bucket_start, group_id ORDER BY random()) as sample_rank
+    FROM (
+        SELECT
+            (CASE WHEN sqlc.arg('bucket')::text = '' THEN NULL
+             ELSE date_trunc(sqlc.arg('bucket')::text, ai.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' END)::timestamptz as bucket_start,
+            (CASE sqlc.arg('group_by')::text
+                WHEN 'doctor' THEN COALESCE(ai.reviewed_by::text, '')
+                WHEN 'department' THEN COALESCE(d.department_id, '')
+                WHEN 'prompt_version' THEN COALESCE(ai.prompt_template_version, '')
+                ELSE '' END)::text as group_id,
+            ai.chat_message_id,
+            ai.ai_response,
+            ai.review_status,
+            ai.modified_content
+        FROM ai_interactions ai
+        LEFT JOIN doctors d ON d.user_id = ai.reviewed_by
+        WHERE ai.created_at >= sqlc.arg('start_time')
+        AND ai.created_at < sqlc.arg('end_time')
+        AND ai.review_status IN ('approved', 'modified')
+        AND ai.deidentified_at IS NULL
+    ) drafts
+) sampled
+WHERE sample_rank <= sqlc.arg('sample_size');
+
+-- Serializes appends to the audit chain until the transaction ends
+-- name: LockAuditLog :exec
+SELECT pg_advisory_xact_lock(7301);
+
+-- name: GetLastAuditEvent :one
+SELECT seq, hash FROM audit_events
+ORDER BY seq DESC
+LIMIT 1;
+
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    occurred_at, action, actor_role, actor_id, patient_id, session_id, resource, details, prev_hash, hash
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+)
+RETURNING seq;
+
+-- Events of a patient or an actor, oldest first
+-- name: ListAuditEvents :many
+SELECT * FROM audit_events
+WHERE (sqlc.narg('patient_id')::uuid IS NULL OR patient_id = sqlc.narg('patient_id'))
+AND (sqlc.narg('actor_id')::text IS NULL OR actor_id = sqlc.narg('actor_id'))
+AND occurred_at >= sqlc.arg('start_time')
+AND occurred_at < sqlc.arg('end_time')
+ORDER BY seq
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- Events of the chain after seq, for verification
+-- name: ListAuditEventsAfter :many
+SELECT * FROM audit_events
+WHERE seq > $1
+ORDER BY seq
+LIMIT $2;
+
+-- name: CreateDataKey :one
+INSERT INTO encryption_data_keys (key_id, wrapped_key)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: GetDataKey :one
+SELECT * FROM encryption_data_keys
+WHERE id = $1;
+
+-- Newest data key wrapped by a key encryption key
+-- name: GetLatestDataKey :one
+SELECT * FROM encryption_data_keys
+WHERE key_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- Re-encryption batches, in ID order. Updates only apply if the value was
+-- not changed since it was read.
+-- name: ListChatMessageContent :many
+SELECT id, content FROM chat_messages
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: UpdateChatMessageContent :execrows
+UPDATE chat_messages
+SET content = sqlc.arg('content')
+WHERE id = sqlc.arg('id') AND content = sqlc.arg('old_content');
+
+-- name: ListAIInteractionResponses :many
+SELECT id, chat_message_id, ai_response FROM ai_interactions
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: UpdateAIInteractionResponse :execrows
+UPDATE ai_interactions
+SET ai_response = sqlc.arg('ai_response')
+WHERE id = sqlc.arg('id') AND ai_response = sqlc.arg('old_ai_response');
+
+-- name: ListAIInteractionModifiedContent :many
+SELECT id, chat_message_id, modified_content FROM ai_interactions
+WHERE id > $1 AND modified_content IS NOT NULL
+ORDER BY id
+LIMIT $2;
+
+-- name: UpdateAIInteractionModifiedContent :execrows
+UPDATE ai_interactions
+SET modified_content = sqlc.arg('modified_content')
+WHERE id = sqlc.arg('id') AND modified_content = sqlc.arg('old_modified_content');
+
+-- name: ListAIInteractionPromptComponents :many
+SELECT id, chat_message_id, prompt_components FROM ai_interactions
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
+
+-- name: UpdateAIInteractionPromptComponents :execrows
+UPDATE ai_interactions
+SET prompt_components = sqlc.arg('prompt_components')
+WHERE id = sqlc.arg('id') AND prompt_components = sqlc.arg('old_prompt_components');
+
+-- name: ListMedicalHistoryNotes :many
+SELECT id, notes FROM medical_history
+WHERE id > $1 AND notes IS NOT NULL
+ORDER BY id
+LIMIT $2;
+
+-- name: UpdateMedicalHistoryNotes :execrows
+UPDATE medical_history
+SET notes = sqlc.arg('notes')
+WHERE id = sqlc.arg('id') AND notes = sqlc.arg('old_notes');
+
+-- Sessions with messages but none since the cutoff
+-- name: ListInactiveChatSessions :many
+SELECT cs.id FROM chat_sessions cs
+WHERE cs.created_at < sqlc.arg('cutoff')
+AND EXISTS (SELECT 1 FROM chat_messages cm WHERE cm.chat_session_id = cs.id)
+AND NOT EXISTS (
+    SELECT 1 FROM chat_messages cm
+    WHERE cm.chat_session_id = cs.id AND cm.created_at >= sqlc.arg('cutoff')
+)
+LIMIT sqlc.arg('limit');
+
+-- name: DeleteSessionMessages :execrows
+DELETE FROM chat_messages
+WHERE chat_session_id = ANY(sqlc.arg('session_ids')::uuid[]);
+
+-- AI interactions still holding draft text, created before a cutoff or
+-- belonging to the given sessions
+-- name: ListAIInteractionsToDeidentify :many
+SELECT ai.id, ai.chat_message_id, ai.ai_response, ai.review_status, ai.modified_content
+FROM ai_interactions ai
+LEFT JOIN chat_messages cm ON cm.id = ai.chat_message_id
+WHERE ai.deidentified_at IS NULL
+AND (sqlc.narg('created_before')::timestamptz IS NULL OR ai.created_at < sqlc.narg('created_before'))
+AND (sqlc.narg('session_ids')::uuid[] IS NULL OR cm.chat_session_id = ANY(sqlc.narg('session_ids')::uuid[]))
+ORDER BY ai.created_at
+LIMIT sqlc.arg('limit');
+
+-- name: DeidentifyAIInteraction :exec
+UPDATE ai_interactions
+SET 
+    chat_message_id = NULL,
+    prompt_components = '{}',
+    ai_response = '',
+    review_comment = NULL,
+    modified_content = NULL,
+    edit_distance = sqlc.narg('edit_distance'),
+    edit_length = sqlc.narg('edit_length'),
+    deidentified_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id');
+
+-- name: ClearChatMessageContent :exec
+UPDATE chat_messages
+SET content = '', metadata = NULL
+WHERE id = $1;
+
+-- Readings measured before the cutoff
+-- name: DeleteBiometricsBefore :execrows
+DELETE FROM biometric_data
+WHERE id IN (
+    SELECT id FROM biometric_data
+    WHERE measured_at < sqlc.arg('cutoff')
+    LIMIT sqlc.arg('limit')
+);
+
+-- Patients are locked until the erasure commits, deleted ones included
+-- name: GetPatientForErasure :one
+SELECT 
+    u.id,
+    u.email,
+    u.name,
+    p.age,
+    p.gender,
+    p.created_at,
+    p.deleted_at,
+    p.erased_at
+FROM users u
+JOIN patients p ON p.user_id = u.id
+WHERE u.id = $1
+FOR UPDATE OF p;
+
+-- name: ListMedicalHistoryForErasure :many
+SELECT id, condition, diagnosed_date, status_id, notes, created_at, deleted_at
+FROM medical_history
+WHERE patient_id = $1
+ORDER BY created_at;
+
+-- name: ListPatientChatSessions :many
+SELECT id, status, created_at, closed_at
+FROM chat_sessions
+WHERE patient_id = $1
+ORDER BY created_at;
+
+-- name: ListPatientChatMessages :many
+SELECT 
+    cm.id,
+    cm.chat_session_id,
+    cm.sender_id,
+    cm.message_type,
+    cm.content,
+    cm.parent_message_id,
+    cm.created_at
+FROM chat_messages cm
+JOIN chat_sessions cs ON cs.id = cm.chat_session_id
+WHERE cs.patient_id = $1
+ORDER BY cm.created_at;
+
+-- name: DeletePatientMedicalHistory :execrows
+DELETE FROM medical_history
+WHERE patient_id = $1;
+
+-- name: DeletePatientBiometrics :execrows
+DELETE FROM biometric_data
+WHERE patient_id = $1;
+
+-- name: AnonymizeUser :exec
+UPDATE users
+SET 
+    name = 'Erased patient',
+    email = 'erased-' || id || '@erased.invalid'
+WHERE id = $1;
+
+-- Ages over 89 are identifying and are kept as 90
+-- name: ErasePatient :exec
+UPDATE patients
+SET 
+    age = LEAST(age, 90),
+    deleted_at = COALESCE(deleted_at, CURRENT_TIMESTAMP),
+    erased_at = CURRENT_TIMESTAMP
+WHERE user_id = $1;
+
+-- Delivery tracking
+-- Messages between patient and doctor are stored under the ID their
+-- recipient acknowledges, with a queued delivery
+-- name: CreateTrackedMessage :exec
+WITH message AS (
+    INSERT INTO chat_messages (id, chat_session_id, sender_id, content, message_type)
+    VALUES (sqlc.arg('id'), sqlc.arg('chat_session_id'), sqlc.arg('sender_id'), sqlc.arg('content'), sqlc.arg('message_type'))
+    RETURNING id
+)
+INSERT INTO message_deliveries (message_id, recipient_role, status)
+SELECT id, sqlc.arg('recipient_role'), 'DELIVERY_STATUS_QUEUED' FROM message;
+
+-- name: MarkMessageDelivered :exec
+UPDATE message_deliveries
+SET status = 'DELIVERY_STATUS_DELIVERED', delivered_at = CURRENT_TIMESTAMP
+WHERE message_id = $1 AND status = 'DELIVERY_STATUS_QUEUED';
+
+-- Reading a message implies it was delivered
+-- name: MarkMessageRead :exec
+UPDATE message_deliveries
+SET status = 'DELIVERY_STATUS_READ',
+    delivered_at = COALESCE(delivered_at, CURRENT_TIMESTAMP),
+    read_at = CURRENT_TIMESTAMP
+WHERE message_id = $1 AND status <> 'DELIVERY_STATUS_READ';
@@ -0,0 +1,8 @@
+package db
+
+// Review statuses of ai_interactions
+const (
+	ReviewApproved = "approved"
+	ReviewModified = "modified"
+	ReviewRejected = "rejected"
+)
@@ -0,0 +1,7 @@
+-- Review deadlines and escalation of AI drafts
+ALTER TABLE ai_interactions
+    ADD COLUMN review_due_at TIMESTAMPTZ,
+    ADD COLUMN sla_breached_at TIMESTAMPTZ,
+    ADD COLUMN escalated_to UUID REFERENCES doctors(user_id);
+
+CREATE INDEX idx_ai_interactions_sla_breached ON ai_interactions(sla_breached_at) WHERE sla_breached_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Sender of SYSTEM messages in chat transcripts
+INSERT INTO users (id, email, name) VALUES
+    ('00000000-0000-0000-0000-000000000000', 'system@llm-qa-system.local', 'System');
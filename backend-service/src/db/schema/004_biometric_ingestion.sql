@@ -0,0 +1,14 @@
+-- Readings from wearables: diastolic pressure, the device that took them, and
+-- at most one reading per patient, type and time so retried batches are skipped
+ALTER TABLE biometric_data
+    ADD COLUMN secondary_value NUMERIC,
+    ADD COLUMN source VARCHAR(100);
+
+DELETE FROM biometric_data a
+USING biometric_data b
+WHERE a.patient_id = b.patient_id
+AND a.type_id = b.type_id
+AND a.measured_at = b.measured_at
+AND a.ctid > b.ctid;
+
+CREATE UNIQUE INDEX idx_biometric_data_reading ON biometric_data(patient_id, type_id, measured_at);
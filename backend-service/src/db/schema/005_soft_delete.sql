@@ -0,0 +1,5 @@
+-- Patients, doctors and conditions removed through the management API are
+-- kept, flagged with the time they were deleted
+ALTER TABLE patients ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE doctors ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE medical_history ADD COLUMN deleted_at TIMESTAMPTZ;
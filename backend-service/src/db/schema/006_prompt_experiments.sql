@@ -0,0 +1,6 @@
+-- A/B experiments between prompt template versions: sessions are split across
+-- the versions with a positive weight, in proportion to it
+ALTER TABLE ref_prompt_templates
+    ADD COLUMN experiment_weight INTEGER NOT NULL DEFAULT 0 CHECK (experiment_weight >= 0);
+
+CREATE INDEX idx_ai_interactions_prompt_template ON ai_interactions(prompt_template_version);
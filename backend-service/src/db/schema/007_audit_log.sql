@@ -0,0 +1,33 @@
+-- Append-only record of who accessed or changed what. Each event stores the
+-- SHA-256 hash of its predecessor's hash and its own fields, so editing or
+-- removing an event breaks the chain after it.
+CREATE TABLE audit_events (
+    seq BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    action VARCHAR(50) NOT NULL,        -- e.g. CONNECT, MESSAGE_SEND, ADMIN_ACTION
+    actor_role VARCHAR(20) NOT NULL,    -- patient, doctor, admin or system
+    actor_id VARCHAR(100) NOT NULL,     -- users.id, or a token fingerprint for admins
+    patient_id UUID,
+    session_id VARCHAR(100) NOT NULL,
+    resource VARCHAR(100) NOT NULL,     -- What was acted on, e.g. a message ID or an RPC
+    details JSONB NOT NULL,
+    prev_hash BYTEA NOT NULL,
+    hash BYTEA NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_audit_events_patient ON audit_events(patient_id, occurred_at);
+CREATE INDEX idx_audit_events_actor ON audit_events(actor_id, occurred_at);
+
+CREATE FUNCTION reject_audit_event_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
+
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_event_change();
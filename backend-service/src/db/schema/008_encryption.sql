@@ -0,0 +1,13 @@
+-- Data keys encrypting chat_messages.content, ai_interactions.ai_response and
+-- medical_history.notes. Each is stored wrapped by the key encryption key
+-- key_id of the configured key provider; encrypted values name the data key
+-- they were encrypted with. Values written before encryption was enabled
+-- stay readable as plaintext until re-encrypted.
+CREATE TABLE encryption_data_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    key_id VARCHAR(200) NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_encryption_data_keys_key ON encryption_data_keys(key_id, created_at);
@@ -0,0 +1,16 @@
+-- Retention and erasure. AI interactions outlive their draft text: when it is
+-- removed the interaction is detached from its message and keeps its review
+-- outcome, with the edit distance between draft and sent answer computed
+-- beforehand, for analytics.
+ALTER TABLE ai_interactions
+    ALTER COLUMN chat_message_id DROP NOT NULL,
+    ADD COLUMN deidentified_at TIMESTAMPTZ,
+    ADD COLUMN edit_distance INTEGER,   -- In runes, unset for rejected or unreviewed drafts
+    ADD COLUMN edit_length INTEGER;     -- Runes of the longer of draft and sent answer
+
+-- Patients whose record was erased on request. Their users row is
+-- anonymized and kept, with age and gender, for aggregate statistics.
+ALTER TABLE patients ADD COLUMN erased_at TIMESTAMPTZ;
+
+CREATE INDEX idx_ai_interactions_created ON ai_interactions(created_at) WHERE deidentified_at IS NULL;
+CREATE INDEX idx_chat_sessions_patient ON chat_sessions(patient_id);
@@ -0,0 +1,23 @@
+-- Delivery of messages between patient and doctor, acknowledged by their
+-- recipient's client. Status only moves forward: queued, delivered, read.
+CREATE TABLE ref_delivery_status (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    active BOOLEAN DEFAULT true,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO ref_delivery_status (id, name, description) VALUES
+    ('DELIVERY_STATUS_QUEUED', 'Queued', 'Accepted by the server, not yet acknowledged by the recipient'),
+    ('DELIVERY_STATUS_DELIVERED', 'Delivered', 'Shown to the recipient'),
+    ('DELIVERY_STATUS_READ', 'Read', 'Read by the recipient');
+
+CREATE TABLE message_deliveries (
+    message_id UUID PRIMARY KEY REFERENCES chat_messages(id) ON DELETE CASCADE,
+    recipient_role VARCHAR(20) NOT NULL,   -- patient or doctor
+    status VARCHAR(50) NOT NULL REFERENCES ref_delivery_status(id),
+    queued_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    read_at TIMESTAMPTZ
+);
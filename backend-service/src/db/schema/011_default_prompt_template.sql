@@ -0,0 +1,3 @@
+-- Drafts generated with the LLM service's default prompt, when no template
+-- is active or it could not be loaded, have no template version.
+ALTER TABLE ai_interactions ALTER COLUMN prompt_template_version DROP NOT NULL;
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	MedicalQAService_GenerateDraftAnswer_FullMethodName = "/backend.MedicalQAService/GenerateDraftAnswer"
+	MedicalQAService_TriageQuestion_FullMethodName      = "/backend.MedicalQAService/TriageQuestion"
 )
 
 // MedicalQAServiceClient is the client API for MedicalQAService service.
@@ -30,6 +31,8 @@ const (
 type MedicalQAServiceClient interface {
 	// Generate a draft answer for medical questions
 	GenerateDraftAnswer(ctx context.Context, in *QuestionRequest, opts ...grpc.CallOption) (*QuestionResponse, error)
+	// Classify a question and estimate how urgently it needs a doctor
+	TriageQuestion(ctx context.Context, in *TriageRequest, opts ...grpc.CallOption) (*TriageResponse, error)
 }
 
 type medicalQAServiceClient struct {
@@ -50,6 +53,16 @@ func (c *medicalQAServiceClient) GenerateDraftAnswer(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *medicalQAServiceClient) TriageQuestion(ctx context.Context, in *TriageRequest, opts ...grpc.CallOption) (*TriageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriageResponse)
+	err := c.cc.Invoke(ctx, MedicalQAService_TriageQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MedicalQAServiceServer is the server API for MedicalQAService service.
 // All implementations must embed UnimplementedMedicalQAServiceServer
 // for forward compatibility.
@@ -58,6 +71,8 @@ func (c *medicalQAServiceClient) GenerateDraftAnswer(ctx context.Context, in *Qu
 type MedicalQAServiceServer interface {
 	// Generate a draft answer for medical questions
 	GenerateDraftAnswer(context.Context, *QuestionRequest) (*QuestionResponse, error)
+	// Classify a question and estimate how urgently it needs a doctor
+	TriageQuestion(context.Context, *TriageRequest) (*TriageResponse, error)
 	mustEmbedUnimplementedMedicalQAServiceServer()
 }
 
@@ -71,6 +86,9 @@ type UnimplementedMedicalQAServiceServer struct{}
 func (UnimplementedMedicalQAServiceServer) GenerateDraftAnswer(context.Context, *QuestionRequest) (*QuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateDraftAnswer not implemented")
 }
+func (UnimplementedMedicalQAServiceServer) TriageQuestion(context.Context, *TriageRequest) (*TriageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriageQuestion not implemented")
+}
 func (UnimplementedMedicalQAServiceServer) mustEmbedUnimplementedMedicalQAServiceServer() {}
 func (UnimplementedMedicalQAServiceServer) testEmbeddedByValue()                          {}
 
@@ -110,6 +128,24 @@ func _MedicalQAService_GenerateDraftAnswer_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MedicalQAService_TriageQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalQAServiceServer).TriageQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalQAService_TriageQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalQAServiceServer).TriageQuestion(ctx, req.(*TriageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MedicalQAService_ServiceDesc is the grpc.ServiceDesc for MedicalQAService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +157,1466 @@ var MedicalQAService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateDraftAnswer",
 			Handler:    _MedicalQAService_GenerateDraftAnswer_Handler,
 		},
+		{
+			MethodName: "TriageQuestion",
+			Handler:    _MedicalQAService_TriageQuestion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	BiometricService_IngestBiometrics_FullMethodName = "/backend.BiometricService/IngestBiometrics"
+)
+
+// BiometricServiceClient is the client API for BiometricService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Ingestion of readings from wearables and home devices
+type BiometricServiceClient interface {
+	// Store a batch of readings for one patient, skipping ones already stored
+	IngestBiometrics(ctx context.Context, in *IngestBiometricsRequest, opts ...grpc.CallOption) (*IngestBiometricsResponse, error)
+}
+
+type biometricServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBiometricServiceClient(cc grpc.ClientConnInterface) BiometricServiceClient {
+	return &biometricServiceClient{cc}
+}
+
+func (c *biometricServiceClient) IngestBiometrics(ctx context.Context, in *IngestBiometricsRequest, opts ...grpc.CallOption) (*IngestBiometricsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestBiometricsResponse)
+	err := c.cc.Invoke(ctx, BiometricService_IngestBiometrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BiometricServiceServer is the server API for BiometricService service.
+// All implementations must embed UnimplementedBiometricServiceServer
+// for forward compatibility.
+//
+// Ingestion of readings from wearables and home devices
+type BiometricServiceServer interface {
+	// Store a batch of readings for one patient, skipping ones already stored
+	IngestBiometrics(context.Context, *IngestBiometricsRequest) (*IngestBiometricsResponse, error)
+	mustEmbedUnimplementedBiometricServiceServer()
+}
+
+// UnimplementedBiometricServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBiometricServiceServer struct{}
+
+func (UnimplementedBiometricServiceServer) IngestBiometrics(context.Context, *IngestBiometricsRequest) (*IngestBiometricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IngestBiometrics not implemented")
+}
+func (UnimplementedBiometricServiceServer) mustEmbedUnimplementedBiometricServiceServer() {}
+func (UnimplementedBiometricServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeBiometricServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BiometricServiceServer will
+// result in compilation errors.
+type UnsafeBiometricServiceServer interface {
+	mustEmbedUnimplementedBiometricServiceServer()
+}
+
+func RegisterBiometricServiceServer(s grpc.ServiceRegistrar, srv BiometricServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBiometricServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BiometricService_ServiceDesc, srv)
+}
+
+func _BiometricService_IngestBiometrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestBiometricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiometricServiceServer).IngestBiometrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BiometricService_IngestBiometrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiometricServiceServer).IngestBiometrics(ctx, req.(*IngestBiometricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BiometricService_ServiceDesc is the grpc.ServiceDesc for BiometricService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BiometricService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.BiometricService",
+	HandlerType: (*BiometricServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IngestBiometrics",
+			Handler:    _BiometricService_IngestBiometrics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	PatientService_CreatePatient_FullMethodName = "/backend.PatientService/CreatePatient"
+	PatientService_GetPatient_FullMethodName    = "/backend.PatientService/GetPatient"
+	PatientService_ListPatients_FullMethodName  = "/backend.PatientService/ListPatients"
+	PatientService_UpdatePatient_FullMethodName = "/backend.PatientService/UpdatePatient"
+	PatientService_DeletePatient_FullMethodName = "/backend.PatientService/DeletePatient"
+)
+
+// PatientServiceClient is the client API for PatientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Registration of patients. Deleted patients are kept but no longer listed.
+type PatientServiceClient interface {
+	// Register a patient, reusing the user with the same email if they are not a patient yet
+	CreatePatient(ctx context.Context, in *CreatePatientRequest, opts ...grpc.CallOption) (*Patient, error)
+	GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*Patient, error)
+	ListPatients(ctx context.Context, in *ListPatientsRequest, opts ...grpc.CallOption) (*ListPatientsResponse, error)
+	// Change the fields that are set, leaving the others as they are
+	UpdatePatient(ctx context.Context, in *UpdatePatientRequest, opts ...grpc.CallOption) (*Patient, error)
+	// Soft delete a patient and their medical history
+	DeletePatient(ctx context.Context, in *DeletePatientRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+}
+
+type patientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPatientServiceClient(cc grpc.ClientConnInterface) PatientServiceClient {
+	return &patientServiceClient{cc}
+}
+
+func (c *patientServiceClient) CreatePatient(ctx context.Context, in *CreatePatientRequest, opts ...grpc.CallOption) (*Patient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Patient)
+	err := c.cc.Invoke(ctx, PatientService_CreatePatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*Patient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Patient)
+	err := c.cc.Invoke(ctx, PatientService_GetPatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) ListPatients(ctx context.Context, in *ListPatientsRequest, opts ...grpc.CallOption) (*ListPatientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPatientsResponse)
+	err := c.cc.Invoke(ctx, PatientService_ListPatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) UpdatePatient(ctx context.Context, in *UpdatePatientRequest, opts ...grpc.CallOption) (*Patient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Patient)
+	err := c.cc.Invoke(ctx, PatientService_UpdatePatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) DeletePatient(ctx context.Context, in *DeletePatientRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, PatientService_DeletePatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PatientServiceServer is the server API for PatientService service.
+// All implementations must embed UnimplementedPatientServiceServer
+// for forward compatibility.
+//
+// Registration of patients. Deleted patients are kept but no longer listed.
+type PatientServiceServer interface {
+	// Register a patient, reusing the user with the same email if they are not a patient yet
+	CreatePatient(context.Context, *CreatePatientRequest) (*Patient, error)
+	GetPatient(context.Context, *GetPatientRequest) (*Patient, error)
+	ListPatients(context.Context, *ListPatientsRequest) (*ListPatientsResponse, error)
+	// Change the fields that are set, leaving the others as they are
+	UpdatePatient(context.Context, *UpdatePatientRequest) (*Patient, error)
+	// Soft delete a patient and their medical history
+	DeletePatient(context.Context, *DeletePatientRequest) (*DeleteResponse, error)
+	mustEmbedUnimplementedPatientServiceServer()
+}
+
+// UnimplementedPatientServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPatientServiceServer struct{}
+
+func (UnimplementedPatientServiceServer) CreatePatient(context.Context, *CreatePatientRequest) (*Patient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePatient not implemented")
+}
+func (UnimplementedPatientServiceServer) GetPatient(context.Context, *GetPatientRequest) (*Patient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatient not implemented")
+}
+func (UnimplementedPatientServiceServer) ListPatients(context.Context, *ListPatientsRequest) (*ListPatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPatients not implemented")
+}
+func (UnimplementedPatientServiceServer) UpdatePatient(context.Context, *UpdatePatientRequest) (*Patient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatient not implemented")
+}
+func (UnimplementedPatientServiceServer) DeletePatient(context.Context, *DeletePatientRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePatient not implemented")
+}
+func (UnimplementedPatientServiceServer) mustEmbedUnimplementedPatientServiceServer() {}
+func (UnimplementedPatientServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePatientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PatientServiceServer will
+// result in compilation errors.
+type UnsafePatientServiceServer interface {
+	mustEmbedUnimplementedPatientServiceServer()
+}
+
+func RegisterPatientServiceServer(s grpc.ServiceRegistrar, srv PatientServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPatientServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PatientService_ServiceDesc, srv)
+}
+
+func _PatientService_CreatePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).CreatePatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_CreatePatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).CreatePatient(ctx, req.(*CreatePatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_GetPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).GetPatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_GetPatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).GetPatient(ctx, req.(*GetPatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_ListPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).ListPatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_ListPatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).ListPatients(ctx, req.(*ListPatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_UpdatePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).UpdatePatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_UpdatePatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).UpdatePatient(ctx, req.(*UpdatePatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_DeletePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).DeletePatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_DeletePatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).DeletePatient(ctx, req.(*DeletePatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PatientService_ServiceDesc is the grpc.ServiceDesc for PatientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PatientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.PatientService",
+	HandlerType: (*PatientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePatient",
+			Handler:    _PatientService_CreatePatient_Handler,
+		},
+		{
+			MethodName: "GetPatient",
+			Handler:    _PatientService_GetPatient_Handler,
+		},
+		{
+			MethodName: "ListPatients",
+			Handler:    _PatientService_ListPatients_Handler,
+		},
+		{
+			MethodName: "UpdatePatient",
+			Handler:    _PatientService_UpdatePatient_Handler,
+		},
+		{
+			MethodName: "DeletePatient",
+			Handler:    _PatientService_DeletePatient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	DoctorService_CreateDoctor_FullMethodName = "/backend.DoctorService/CreateDoctor"
+	DoctorService_GetDoctor_FullMethodName    = "/backend.DoctorService/GetDoctor"
+	DoctorService_ListDoctors_FullMethodName  = "/backend.DoctorService/ListDoctors"
+	DoctorService_UpdateDoctor_FullMethodName = "/backend.DoctorService/UpdateDoctor"
+	DoctorService_DeleteDoctor_FullMethodName = "/backend.DoctorService/DeleteDoctor"
+)
+
+// DoctorServiceClient is the client API for DoctorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Registration of doctors. Deleted doctors are kept but can no longer sign in.
+type DoctorServiceClient interface {
+	// Register a doctor, reusing the user with the same email if they are not a doctor yet
+	CreateDoctor(ctx context.Context, in *CreateDoctorRequest, opts ...grpc.CallOption) (*Doctor, error)
+	GetDoctor(ctx context.Context, in *GetDoctorRequest, opts ...grpc.CallOption) (*Doctor, error)
+	ListDoctors(ctx context.Context, in *ListDoctorsRequest, opts ...grpc.CallOption) (*ListDoctorsResponse, error)
+	// Change the fields that are set, leaving the others as they are
+	UpdateDoctor(ctx context.Context, in *UpdateDoctorRequest, opts ...grpc.CallOption) (*Doctor, error)
+	// Soft delete a doctor
+	DeleteDoctor(ctx context.Context, in *DeleteDoctorRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+}
+
+type doctorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDoctorServiceClient(cc grpc.ClientConnInterface) DoctorServiceClient {
+	return &doctorServiceClient{cc}
+}
+
+func (c *doctorServiceClient) CreateDoctor(ctx context.Context, in *CreateDoctorRequest, opts ...grpc.CallOption) (*Doctor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, DoctorService_CreateDoctor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) GetDoctor(ctx context.Context, in *GetDoctorRequest, opts ...grpc.CallOption) (*Doctor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, DoctorService_GetDoctor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) ListDoctors(ctx context.Context, in *ListDoctorsRequest, opts ...grpc.CallOption) (*ListDoctorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDoctorsResponse)
+	err := c.cc.Invoke(ctx, DoctorService_ListDoctors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) UpdateDoctor(ctx context.Context, in *UpdateDoctorRequest, opts ...grpc.CallOption) (*Doctor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Doctor)
+	err := c.cc.Invoke(ctx, DoctorService_UpdateDoctor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) DeleteDoctor(ctx context.Context, in *DeleteDoctorRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, DoctorService_DeleteDoctor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoctorServiceServer is the server API for DoctorService service.
+// All implementations must embed UnimplementedDoctorServiceServer
+// for forward compatibility.
+//
+// Registration of doctors. Deleted doctors are kept but can no longer sign in.
+type DoctorServiceServer interface {
+	// Register a doctor, reusing the user with the same email if they are not a doctor yet
+	CreateDoctor(context.Context, *CreateDoctorRequest) (*Doctor, error)
+	GetDoctor(context.Context, *GetDoctorRequest) (*Doctor, error)
+	ListDoctors(context.Context, *ListDoctorsRequest) (*ListDoctorsResponse, error)
+	// Change the fields that are set, leaving the others as they are
+	UpdateDoctor(context.Context, *UpdateDoctorRequest) (*Doctor, error)
+	// Soft delete a doctor
+	DeleteDoctor(context.Context, *DeleteDoctorRequest) (*DeleteResponse, error)
+	mustEmbedUnimplementedDoctorServiceServer()
+}
+
+// UnimplementedDoctorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDoctorServiceServer struct{}
+
+func (UnimplementedDoctorServiceServer) CreateDoctor(context.Context, *CreateDoctorRequest) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDoctor not implemented")
+}
+func (UnimplementedDoctorServiceServer) GetDoctor(context.Context, *GetDoctorRequest) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctor not implemented")
+}
+func (UnimplementedDoctorServiceServer) ListDoctors(context.Context, *ListDoctorsRequest) (*ListDoctorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctors not implemented")
+}
+func (UnimplementedDoctorServiceServer) UpdateDoctor(context.Context, *UpdateDoctorRequest) (*Doctor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctor not implemented")
+}
+func (UnimplementedDoctorServiceServer) DeleteDoctor(context.Context, *DeleteDoctorRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctor not implemented")
+}
+func (UnimplementedDoctorServiceServer) mustEmbedUnimplementedDoctorServiceServer() {}
+func (UnimplementedDoctorServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDoctorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DoctorServiceServer will
+// result in compilation errors.
+type UnsafeDoctorServiceServer interface {
+	mustEmbedUnimplementedDoctorServiceServer()
+}
+
+func RegisterDoctorServiceServer(s grpc.ServiceRegistrar, srv DoctorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDoctorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DoctorService_ServiceDesc, srv)
+}
+
+func _DoctorService_CreateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDoctorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).CreateDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_CreateDoctor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).CreateDoctor(ctx, req.(*CreateDoctorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_GetDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoctorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_GetDoctor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetDoctor(ctx, req.(*GetDoctorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ListDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDoctorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ListDoctors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_ListDoctors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ListDoctors(ctx, req.(*ListDoctorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_UpdateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDoctorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).UpdateDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_UpdateDoctor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).UpdateDoctor(ctx, req.(*UpdateDoctorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_DeleteDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDoctorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).DeleteDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_DeleteDoctor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).DeleteDoctor(ctx, req.(*DeleteDoctorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DoctorService_ServiceDesc is the grpc.ServiceDesc for DoctorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DoctorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.DoctorService",
+	HandlerType: (*DoctorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDoctor",
+			Handler:    _DoctorService_CreateDoctor_Handler,
+		},
+		{
+			MethodName: "GetDoctor",
+			Handler:    _DoctorService_GetDoctor_Handler,
+		},
+		{
+			MethodName: "ListDoctors",
+			Handler:    _DoctorService_ListDoctors_Handler,
+		},
+		{
+			MethodName: "UpdateDoctor",
+			Handler:    _DoctorService_UpdateDoctor_Handler,
+		},
+		{
+			MethodName: "DeleteDoctor",
+			Handler:    _DoctorService_DeleteDoctor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	MedicalHistoryService_AddCondition_FullMethodName    = "/backend.MedicalHistoryService/AddCondition"
+	MedicalHistoryService_ListConditions_FullMethodName  = "/backend.MedicalHistoryService/ListConditions"
+	MedicalHistoryService_UpdateCondition_FullMethodName = "/backend.MedicalHistoryService/UpdateCondition"
+	MedicalHistoryService_DeleteCondition_FullMethodName = "/backend.MedicalHistoryService/DeleteCondition"
+)
+
+// MedicalHistoryServiceClient is the client API for MedicalHistoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Conditions in patients' medical history
+type MedicalHistoryServiceClient interface {
+	AddCondition(ctx context.Context, in *AddConditionRequest, opts ...grpc.CallOption) (*MedicalCondition, error)
+	ListConditions(ctx context.Context, in *ListConditionsRequest, opts ...grpc.CallOption) (*ListConditionsResponse, error)
+	// Change the fields that are set, leaving the others as they are
+	UpdateCondition(ctx context.Context, in *UpdateConditionRequest, opts ...grpc.CallOption) (*MedicalCondition, error)
+	// Soft delete a condition
+	DeleteCondition(ctx context.Context, in *DeleteConditionRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+}
+
+type medicalHistoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMedicalHistoryServiceClient(cc grpc.ClientConnInterface) MedicalHistoryServiceClient {
+	return &medicalHistoryServiceClient{cc}
+}
+
+func (c *medicalHistoryServiceClient) AddCondition(ctx context.Context, in *AddConditionRequest, opts ...grpc.CallOption) (*MedicalCondition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalCondition)
+	err := c.cc.Invoke(ctx, MedicalHistoryService_AddCondition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalHistoryServiceClient) ListConditions(ctx context.Context, in *ListConditionsRequest, opts ...grpc.CallOption) (*ListConditionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConditionsResponse)
+	err := c.cc.Invoke(ctx, MedicalHistoryService_ListConditions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalHistoryServiceClient) UpdateCondition(ctx context.Context, in *UpdateConditionRequest, opts ...grpc.CallOption) (*MedicalCondition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalCondition)
+	err := c.cc.Invoke(ctx, MedicalHistoryService_UpdateCondition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *medicalHistoryServiceClient) DeleteCondition(ctx context.Context, in *DeleteConditionRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, MedicalHistoryService_DeleteCondition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MedicalHistoryServiceServer is the server API for MedicalHistoryService service.
+// All implementations must embed UnimplementedMedicalHistoryServiceServer
+// for forward compatibility.
+//
+// Conditions in patients' medical history
+type MedicalHistoryServiceServer interface {
+	AddCondition(context.Context, *AddConditionRequest) (*MedicalCondition, error)
+	ListConditions(context.Context, *ListConditionsRequest) (*ListConditionsResponse, error)
+	// Change the fields that are set, leaving the others as they are
+	UpdateCondition(context.Context, *UpdateConditionRequest) (*MedicalCondition, error)
+	// Soft delete a condition
+	DeleteCondition(context.Context, *DeleteConditionRequest) (*DeleteResponse, error)
+	mustEmbedUnimplementedMedicalHistoryServiceServer()
+}
+
+// UnimplementedMedicalHistoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMedicalHistoryServiceServer struct{}
+
+func (UnimplementedMedicalHistoryServiceServer) AddCondition(context.Context, *AddConditionRequest) (*MedicalCondition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCondition not implemented")
+}
+func (UnimplementedMedicalHistoryServiceServer) ListConditions(context.Context, *ListConditionsRequest) (*ListConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConditions not implemented")
+}
+func (UnimplementedMedicalHistoryServiceServer) UpdateCondition(context.Context, *UpdateConditionRequest) (*MedicalCondition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCondition not implemented")
+}
+func (UnimplementedMedicalHistoryServiceServer) DeleteCondition(context.Context, *DeleteConditionRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCondition not implemented")
+}
+func (UnimplementedMedicalHistoryServiceServer) mustEmbedUnimplementedMedicalHistoryServiceServer() {}
+func (UnimplementedMedicalHistoryServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeMedicalHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MedicalHistoryServiceServer will
+// result in compilation errors.
+type UnsafeMedicalHistoryServiceServer interface {
+	mustEmbedUnimplementedMedicalHistoryServiceServer()
+}
+
+func RegisterMedicalHistoryServiceServer(s grpc.ServiceRegistrar, srv MedicalHistoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMedicalHistoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MedicalHistoryService_ServiceDesc, srv)
+}
+
+func _MedicalHistoryService_AddCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalHistoryServiceServer).AddCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalHistoryService_AddCondition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalHistoryServiceServer).AddCondition(ctx, req.(*AddConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalHistoryService_ListConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalHistoryServiceServer).ListConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalHistoryService_ListConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalHistoryServiceServer).ListConditions(ctx, req.(*ListConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalHistoryService_UpdateCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalHistoryServiceServer).UpdateCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalHistoryService_UpdateCondition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalHistoryServiceServer).UpdateCondition(ctx, req.(*UpdateConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MedicalHistoryService_DeleteCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MedicalHistoryServiceServer).DeleteCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MedicalHistoryService_DeleteCondition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MedicalHistoryServiceServer).DeleteCondition(ctx, req.(*DeleteConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MedicalHistoryService_ServiceDesc is the grpc.ServiceDesc for MedicalHistoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MedicalHistoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.MedicalHistoryService",
+	HandlerType: (*MedicalHistoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCondition",
+			Handler:    _MedicalHistoryService_AddCondition_Handler,
+		},
+		{
+			MethodName: "ListConditions",
+			Handler:    _MedicalHistoryService_ListConditions_Handler,
+		},
+		{
+			MethodName: "UpdateCondition",
+			Handler:    _MedicalHistoryService_UpdateCondition_Handler,
+		},
+		{
+			MethodName: "DeleteCondition",
+			Handler:    _MedicalHistoryService_DeleteCondition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	PromptTemplateService_CreatePromptTemplate_FullMethodName   = "/backend.PromptTemplateService/CreatePromptTemplate"
+	PromptTemplateService_GetPromptTemplate_FullMethodName      = "/backend.PromptTemplateService/GetPromptTemplate"
+	PromptTemplateService_ListPromptTemplates_FullMethodName    = "/backend.PromptTemplateService/ListPromptTemplates"
+	PromptTemplateService_ActivatePromptTemplate_FullMethodName = "/backend.PromptTemplateService/ActivatePromptTemplate"
+	PromptTemplateService_SetPromptExperiment_FullMethodName    = "/backend.PromptTemplateService/SetPromptExperiment"
+	PromptTemplateService_GetPromptExperiment_FullMethodName    = "/backend.PromptTemplateService/GetPromptExperiment"
+	PromptTemplateService_GetPromptTemplateStats_FullMethodName = "/backend.PromptTemplateService/GetPromptTemplateStats"
+)
+
+// PromptTemplateServiceClient is the client API for PromptTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Versions of the prompt template drafts are generated with. Templates are
+// never changed, a new version is created instead.
+type PromptTemplateServiceClient interface {
+	CreatePromptTemplate(ctx context.Context, in *CreatePromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error)
+	GetPromptTemplate(ctx context.Context, in *GetPromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error)
+	ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error)
+	// Make a version the one used outside experiments, deactivating the others
+	ActivatePromptTemplate(ctx context.Context, in *ActivatePromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error)
+	// Split sessions across versions by weight, replacing the running experiment. No arms stops it.
+	SetPromptExperiment(ctx context.Context, in *SetPromptExperimentRequest, opts ...grpc.CallOption) (*PromptExperiment, error)
+	GetPromptExperiment(ctx context.Context, in *GetPromptExperimentRequest, opts ...grpc.CallOption) (*PromptExperiment, error)
+	// Review outcomes of the drafts generated with each version
+	GetPromptTemplateStats(ctx context.Context, in *GetPromptTemplateStatsRequest, opts ...grpc.CallOption) (*GetPromptTemplateStatsResponse, error)
+}
+
+type promptTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromptTemplateServiceClient(cc grpc.ClientConnInterface) PromptTemplateServiceClient {
+	return &promptTemplateServiceClient{cc}
+}
+
+func (c *promptTemplateServiceClient) CreatePromptTemplate(ctx context.Context, in *CreatePromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptTemplate)
+	err := c.cc.Invoke(ctx, PromptTemplateService_CreatePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) GetPromptTemplate(ctx context.Context, in *GetPromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptTemplate)
+	err := c.cc.Invoke(ctx, PromptTemplateService_GetPromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) ListPromptTemplates(ctx context.Context, in *ListPromptTemplatesRequest, opts ...grpc.CallOption) (*ListPromptTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptTemplatesResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ListPromptTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) ActivatePromptTemplate(ctx context.Context, in *ActivatePromptTemplateRequest, opts ...grpc.CallOption) (*PromptTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptTemplate)
+	err := c.cc.Invoke(ctx, PromptTemplateService_ActivatePromptTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) SetPromptExperiment(ctx context.Context, in *SetPromptExperimentRequest, opts ...grpc.CallOption) (*PromptExperiment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptExperiment)
+	err := c.cc.Invoke(ctx, PromptTemplateService_SetPromptExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) GetPromptExperiment(ctx context.Context, in *GetPromptExperimentRequest, opts ...grpc.CallOption) (*PromptExperiment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromptExperiment)
+	err := c.cc.Invoke(ctx, PromptTemplateService_GetPromptExperiment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promptTemplateServiceClient) GetPromptTemplateStats(ctx context.Context, in *GetPromptTemplateStatsRequest, opts ...grpc.CallOption) (*GetPromptTemplateStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromptTemplateStatsResponse)
+	err := c.cc.Invoke(ctx, PromptTemplateService_GetPromptTemplateStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromptTemplateServiceServer is the server API for PromptTemplateService service.
+// All implementations must embed UnimplementedPromptTemplateServiceServer
+// for forward compatibility.
+//
+// Versions of the prompt template drafts are generated with. Templates are
+// never changed, a new version is created instead.
+type PromptTemplateServiceServer interface {
+	CreatePromptTemplate(context.Context, *CreatePromptTemplateRequest) (*PromptTemplate, error)
+	GetPromptTemplate(context.Context, *GetPromptTemplateRequest) (*PromptTemplate, error)
+	ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error)
+	// Make a version the one used outside experiments, deactivating the others
+	ActivatePromptTemplate(context.Context, *ActivatePromptTemplateRequest) (*PromptTemplate, error)
+	// Split sessions across versions by weight, replacing the running experiment. No arms stops it.
+	SetPromptExperiment(context.Context, *SetPromptExperimentRequest) (*PromptExperiment, error)
+	GetPromptExperiment(context.Context, *GetPromptExperimentRequest) (*PromptExperiment, error)
+	// Review outcomes of the drafts generated with each version
+	GetPromptTemplateStats(context.Context, *GetPromptTemplateStatsRequest) (*GetPromptTemplateStatsResponse, error)
+	mustEmbedUnimplementedPromptTemplateServiceServer()
+}
+
+// UnimplementedPromptTemplateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromptTemplateServiceServer struct{}
+
+func (UnimplementedPromptTemplateServiceServer) CreatePromptTemplate(context.Context, *CreatePromptTemplateRequest) (*PromptTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) GetPromptTemplate(context.Context, *GetPromptTemplateRequest) (*PromptTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) ListPromptTemplates(context.Context, *ListPromptTemplatesRequest) (*ListPromptTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromptTemplates not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) ActivatePromptTemplate(context.Context, *ActivatePromptTemplateRequest) (*PromptTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivatePromptTemplate not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) SetPromptExperiment(context.Context, *SetPromptExperimentRequest) (*PromptExperiment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPromptExperiment not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) GetPromptExperiment(context.Context, *GetPromptExperimentRequest) (*PromptExperiment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromptExperiment not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) GetPromptTemplateStats(context.Context, *GetPromptTemplateStatsRequest) (*GetPromptTemplateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromptTemplateStats not implemented")
+}
+func (UnimplementedPromptTemplateServiceServer) mustEmbedUnimplementedPromptTemplateServiceServer() {}
+func (UnimplementedPromptTemplateServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafePromptTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromptTemplateServiceServer will
+// result in compilation errors.
+type UnsafePromptTemplateServiceServer interface {
+	mustEmbedUnimplementedPromptTemplateServiceServer()
+}
+
+func RegisterPromptTemplateServiceServer(s grpc.ServiceRegistrar, srv PromptTemplateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPromptTemplateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromptTemplateService_ServiceDesc, srv)
+}
+
+func _PromptTemplateService_CreatePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).CreatePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_CreatePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).CreatePromptTemplate(ctx, req.(*CreatePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_GetPromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).GetPromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_GetPromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).GetPromptTemplate(ctx, req.(*GetPromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_ListPromptTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ListPromptTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ListPromptTemplates(ctx, req.(*ListPromptTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_ActivatePromptTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivatePromptTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).ActivatePromptTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_ActivatePromptTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).ActivatePromptTemplate(ctx, req.(*ActivatePromptTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_SetPromptExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPromptExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).SetPromptExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_SetPromptExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).SetPromptExperiment(ctx, req.(*SetPromptExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_GetPromptExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromptExperimentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).GetPromptExperiment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_GetPromptExperiment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).GetPromptExperiment(ctx, req.(*GetPromptExperimentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromptTemplateService_GetPromptTemplateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromptTemplateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromptTemplateServiceServer).GetPromptTemplateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromptTemplateService_GetPromptTemplateStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromptTemplateServiceServer).GetPromptTemplateStats(ctx, req.(*GetPromptTemplateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromptTemplateService_ServiceDesc is the grpc.ServiceDesc for PromptTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromptTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.PromptTemplateService",
+	HandlerType: (*PromptTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePromptTemplate",
+			Handler:    _PromptTemplateService_CreatePromptTemplate_Handler,
+		},
+		{
+			MethodName: "GetPromptTemplate",
+			Handler:    _PromptTemplateService_GetPromptTemplate_Handler,
+		},
+		{
+			MethodName: "ListPromptTemplates",
+			Handler:    _PromptTemplateService_ListPromptTemplates_Handler,
+		},
+		{
+			MethodName: "ActivatePromptTemplate",
+			Handler:    _PromptTemplateService_ActivatePromptTemplate_Handler,
+		},
+		{
+			MethodName: "SetPromptExperiment",
+			Handler:    _PromptTemplateService_SetPromptExperiment_Handler,
+		},
+		{
+			MethodName: "GetPromptExperiment",
+			Handler:    _PromptTemplateService_GetPromptExperiment_Handler,
+		},
+		{
+			MethodName: "GetPromptTemplateStats",
+			Handler:    _PromptTemplateService_GetPromptTemplateStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	AnalyticsService_GetDraftQuality_FullMethodName = "/backend.AnalyticsService/GetDraftQuality"
+)
+
+// AnalyticsServiceClient is the client API for AnalyticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Quality of AI drafts as judged by the doctors reviewing them
+type AnalyticsServiceClient interface {
+	GetDraftQuality(ctx context.Context, in *DraftQualityRequest, opts ...grpc.CallOption) (*DraftQualityResponse, error)
+}
+
+type analyticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalyticsServiceClient(cc grpc.ClientConnInterface) AnalyticsServiceClient {
+	return &analyticsServiceClient{cc}
+}
+
+func (c *analyticsServiceClient) GetDraftQuality(ctx context.Context, in *DraftQualityRequest, opts ...grpc.CallOption) (*DraftQualityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftQualityResponse)
+	err := c.cc.Invoke(ctx, AnalyticsService_GetDraftQuality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalyticsServiceServer is the server API for AnalyticsService service.
+// All implementations must embed UnimplementedAnalyticsServiceServer
+// for forward compatibility.
+//
+// Quality of AI drafts as judged by the doctors reviewing them
+type AnalyticsServiceServer interface {
+	GetDraftQuality(context.Context, *DraftQualityRequest) (*DraftQualityResponse, error)
+	mustEmbedUnimplementedAnalyticsServiceServer()
+}
+
+// UnimplementedAnalyticsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnalyticsServiceServer struct{}
+
+func (UnimplementedAnalyticsServiceServer) GetDraftQuality(context.Context, *DraftQualityRequest) (*DraftQualityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraftQuality not implemented")
+}
+func (UnimplementedAnalyticsServiceServer) mustEmbedUnimplementedAnalyticsServiceServer() {}
+func (UnimplementedAnalyticsServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalyticsServiceServer will
+// result in compilation errors.
+type UnsafeAnalyticsServiceServer interface {
+	mustEmbedUnimplementedAnalyticsServiceServer()
+}
+
+func RegisterAnalyticsServiceServer(s grpc.ServiceRegistrar, srv AnalyticsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAnalyticsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnalyticsService_ServiceDesc, srv)
+}
+
+func _AnalyticsService_GetDraftQuality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftQualityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServiceServer).GetDraftQuality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyticsService_GetDraftQuality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServiceServer).GetDraftQuality(ctx, req.(*DraftQualityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalyticsService_ServiceDesc is the grpc.ServiceDesc for AnalyticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalyticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.AnalyticsService",
+	HandlerType: (*AnalyticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDraftQuality",
+			Handler:    _AnalyticsService_GetDraftQuality_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "medical_service.proto",
+}
+
+const (
+	AuditService_ListAuditEvents_FullMethodName = "/backend.AuditService/ListAuditEvents"
+	AuditService_VerifyAuditLog_FullMethodName  = "/backend.AuditService/VerifyAuditLog"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Access accounting over the append-only, hash-chained audit log
+type AuditServiceClient interface {
+	// Events of a patient or an actor, oldest first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Recompute the hash chain and report the first event that breaks it
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, AuditService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+//
+// Access accounting over the append-only, hash-chained audit log
+type AuditServiceServer interface {
+	// Events of a patient or an actor, oldest first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Recompute the hash chain and report the first event that breaks it
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAuditServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "backend.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _AuditService_VerifyAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "medical_service.proto",
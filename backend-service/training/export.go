@@ -0,0 +1,199 @@
+// Package training turns doctor-reviewed AI drafts into fine-tuning datasets
+package training
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Dataset formats
+const (
+	// FormatChat writes one conversation per line, ending in the answer the
+	// patient was sent. Rejected drafts are left out.
+	FormatChat = "chat"
+	// FormatPreference writes the doctor's edit as the preferred answer and
+	// the draft as the non-preferred one. Only modified drafts are written.
+	FormatPreference = "preference"
+)
+
+// Filter selects the reviewed drafts to export
+type Filter struct {
+	Start, End   time.Time
+	ReviewStatus string // Any status when empty
+	DepartmentID string // Reviewer's department, any when empty
+}
+
+// Result counts what Export wrote
+type Result struct {
+	Exported int
+	Skipped  int // Drafts the format has no use for, or without a question
+	Flagged  int // Examples held back for review, see ReviewItem
+}
+
+// Message is a chat message of a fine-tuning example
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// ChatExample is a line of a FormatChat dataset
+type ChatExample struct {
+	Messages []Message `json:"messages"`
+}
+
+// PreferenceInput is the conversation a preference pair answers
+type PreferenceInput struct {
+	Messages []Message `json:"messages"`
+}
+
+// PreferenceExample is a line of a FormatPreference dataset
+type PreferenceExample struct {
+	Input              PreferenceInput `json:"input"`
+	PreferredOutput    []Message       `json:"preferred_output"`
+	NonPreferredOutput []Message       `json:"non_preferred_output"`
+}
+
+// ReviewItem is a line of the review file: an example held back from the
+// dataset because its scrubbed text has capitalized words that may be names.
+// A person checks it before it is added by hand.
+type ReviewItem struct {
+	ID           string   `json:"id"` // Of the ai_interactions row
+	Unrecognized []string `json:"unrecognized"`
+	Example      any      `json:"example"`
+}
+
+// promptComponents is the part of ai_interactions.prompt_components an
+// example is built from
+type promptComponents struct {
+	Question string `json:"question"`
+}
+
+// Export writes the reviewed drafts matching filter to w as JSONL in format.
+// The patient's and reviewer's names and other PHI are scrubbed from every
+// text before it is written. Examples left with Unrecognized words are held
+// back: they are written to review as ReviewItems if it is not nil, and
+// dropped otherwise.
+func Export(ctx context.Context, q *db.EncryptedQueries, filter Filter, format string, w, review io.Writer) (Result, error) {
+	var result Result
+	if format != FormatChat && format != FormatPreference {
+		return result, fmt.Errorf("unknown format %q", format)
+	}
+
+	params := db.GetAITrainingDataParams{
+		StartTime: pgtype.Timestamptz{Time: filter.Start, Valid: true},
+		EndTime:   pgtype.Timestamptz{Time: filter.End, Valid: true},
+	}
+	if filter.ReviewStatus != "" {
+		params.ReviewStatus = pgtype.Text{String: filter.ReviewStatus, Valid: true}
+	}
+	if filter.DepartmentID != "" {
+		params.DepartmentID = pgtype.Text{String: filter.DepartmentID, Valid: true}
+	}
+	rows, err := q.GetAITrainingData(ctx, params)
+	if err != nil {
+		return result, fmt.Errorf("failed to load reviewed drafts: %v", err)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	var reviewEnc *json.Encoder
+	if review != nil {
+		reviewEnc = json.NewEncoder(review)
+		reviewEnc.SetEscapeHTML(false)
+	}
+	for _, row := range rows {
+		example, unrecognized, ok, err := buildExample(row, format)
+		if err != nil {
+			return result, err
+		}
+		if !ok {
+			result.Skipped++
+			continue
+		}
+		if len(unrecognized) > 0 {
+			result.Flagged++
+			if reviewEnc == nil {
+				continue
+			}
+			item := ReviewItem{ID: pg.ToUUID(row.ID).String(), Unrecognized: unrecognized, Example: example}
+			if err := reviewEnc.Encode(item); err != nil {
+				return result, fmt.Errorf("failed to write review item: %v", err)
+			}
+			continue
+		}
+		if err := enc.Encode(example); err != nil {
+			return result, fmt.Errorf("failed to write example: %v", err)
+		}
+		result.Exported++
+	}
+	return result, nil
+}
+
+// buildExample returns the scrubbed example of a reviewed draft with the
+// words of it that may be names, or false if the format has no use for it
+func buildExample(row db.GetAITrainingDataRow, format string) (any, []string, bool, error) {
+	var components promptComponents
+	if err := json.Unmarshal(row.PromptComponents, &components); err != nil {
+		return nil, nil, false, fmt.Errorf("invalid prompt components of %x: %v", row.ID.Bytes, err)
+	}
+	if strings.TrimSpace(components.Question) == "" {
+		return nil, nil, false, nil
+	}
+
+	scrubber := NewScrubber(row.PatientName, emailName(row.PatientEmail), row.ReviewerName.String)
+	question := Message{Role: "user", Content: scrubber.Scrub(components.Question)}
+	prompt := []Message{question}
+	if row.PromptTemplate.Valid {
+		prompt = append([]Message{{Role: "system", Content: row.PromptTemplate.String}}, prompt...)
+	}
+	draft := Message{Role: "assistant", Content: scrubber.Scrub(row.AiResponse)}
+
+	switch {
+	case format == FormatChat && row.ReviewStatus.String == db.ReviewApproved:
+		return ChatExample{Messages: append(prompt, draft)}, unrecognizedIn(question, draft), true, nil
+	case format == FormatChat && row.ReviewStatus.String == db.ReviewModified:
+		final := Message{Role: "assistant", Content: scrubber.Scrub(row.ModifiedContent.String)}
+		return ChatExample{Messages: append(prompt, final)}, unrecognizedIn(question, final), true, nil
+	case format == FormatPreference && row.ReviewStatus.String == db.ReviewModified:
+		final := Message{Role: "assistant", Content: scrubber.Scrub(row.ModifiedContent.String)}
+		return PreferenceExample{
+			Input:              PreferenceInput{Messages: prompt},
+			PreferredOutput:    []Message{final},
+			NonPreferredOutput: []Message{draft},
+		}, unrecognizedIn(question, final, draft), true, nil
+	default:
+		return nil, nil, false, nil
+	}
+}
+
+// unrecognizedIn returns the Unrecognized words of messages, each once. The
+// system prompt is written by staff and not checked.
+func unrecognizedIn(messages ...Message) []string {
+	seen := make(map[string]bool)
+	var words []string
+	for _, m := range messages {
+		for _, word := range Unrecognized(m.Content) {
+			if !seen[word] {
+				seen[word] = true
+				words = append(words, word)
+			}
+		}
+	}
+	return words
+}
+
+// emailName returns the part of an email address before the @, which often
+// holds the person's name
+func emailName(email string) string {
+	name, _, _ := strings.Cut(email, "@")
+	return strings.NewReplacer(".", " ", "_", " ", "-", " ").Replace(name)
+}
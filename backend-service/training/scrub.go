@@ -0,0 +1,147 @@
+package training
+
+import (
+	_ "embed"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Placeholders that replace scrubbed PHI
+const (
+	NamePlaceholder    = "[NAME]"
+	DatePlaceholder    = "[DATE]"
+	EmailPlaceholder   = "[EMAIL]"
+	PhonePlaceholder   = "[PHONE]"
+	URLPlaceholder     = "[URL]"
+	IDPlaceholder      = "[ID]"
+	AgePlaceholder     = "[AGE]"
+	AddressPlaceholder = "[ADDRESS]"
+)
+
+const month = `(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)`
+
+// scrubRules are applied in order, identifiers with a fixed shape first so
+// the looser patterns after them do not split them
+var scrubRules = []struct {
+	pattern     *regexp.Regexp
+	placeholder string
+}{
+	{regexp.MustCompile(`(?i)\b[A-Z0-9._%+-]+@[A-Z0-9.-]+\.[A-Z]{2,}\b`), EmailPlaceholder},
+	{regexp.MustCompile(`(?i)\bhttps?://\S+`), URLPlaceholder},
+	{regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`), IDPlaceholder},
+	{regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}(?::\d{2}(?:\.\d+)?)?(?:Z|[+-]\d{2}:?\d{2})?)?\b`), DatePlaceholder},
+	{regexp.MustCompile(`\b\d{1,2}[/.-]\d{1,2}[/.-]\d{2,4}\b`), DatePlaceholder},
+	{regexp.MustCompile(`(?i)\b` + month + `\.?\s+\d{1,2}(?:st|nd|rd|th)?(?:,?\s+\d{4})?\b`), DatePlaceholder},
+	{regexp.MustCompile(`(?i)\b\d{1,2}(?:st|nd|rd|th)?\s+(?:of\s+)?` + month + `\.?(?:,?\s+\d{4})?\b`), DatePlaceholder},
+	{regexp.MustCompile(`\b\d{1,6}\s+(?:[A-Z][a-zA-Z]*\.?\s+){1,4}(?:Street|St|Avenue|Ave|Road|Rd|Boulevard|Blvd|Lane|Ln|Drive|Dr|Court|Ct|Place|Pl|Way|Terrace|Circle)\b\.?`), AddressPlaceholder},
+	{regexp.MustCompile(`(?i)\b(?:apt|apartment|suite|unit)\.?\s*#?\s*[A-Z0-9-]*\d[A-Z0-9-]*\b`), AddressPlaceholder},
+	{regexp.MustCompile(`\b[A-Z]{2}\s+\d{5}(?:-\d{4})?\b`), AddressPlaceholder},
+	{regexp.MustCompile(`(?i)\b\d{1,3}[\s-]*(?:years?|yrs?)[\s-]*old\b`), AgePlaceholder},
+	{regexp.MustCompile(`(?i)\b\d{1,3}\s*(?:y/o|yo)\b`), AgePlaceholder},
+	{regexp.MustCompile(`(?i)\b(?:aged?|age of)\s*:?\s*\d{1,3}\b`), AgePlaceholder},
+	{regexp.MustCompile(`(?i)\b(?:(?:early|mid|late)[\s-]+)?\d0s\b`), AgePlaceholder},
+	{regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`), IDPlaceholder},
+	{regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{3}\)\s?|\b\d{3}[\s.-])\d{3}[\s.-]\d{4}\b`), PhonePlaceholder},
+	{regexp.MustCompile(`(?i)\b(?:MRN|medical record|record|account|acct|member|policy|patient)\s*(?:number|no\.?|#|id)?\s*:?\s*#?[A-Z0-9-]*\d[A-Z0-9-]{3,}\b`), IDPlaceholder},
+	{regexp.MustCompile(`\b\d{6,}\b`), IDPlaceholder},
+	{regexp.MustCompile(`\b(?:Dr|Mr|Mrs|Ms|Miss|Mx|Prof)\.?\s+[A-Z][a-zA-Z'-]+(?:\s+[A-Z][a-zA-Z'-]+)?`), NamePlaceholder},
+	{regexp.MustCompile(`(\b(?i:husband|wife|partner|son|daughter|child|mother|mom|mum|father|dad|brother|sister|grandmother|grandfather|aunt|uncle|cousin|friend|neighbou?r|colleague|boss|caregiver)(?:'s)?,?\s+(?:(?i:is\s+)?(?i:named|called)\s+)?)[A-Z][a-zA-Z'-]+(?:\s+[A-Z][a-zA-Z'-]+)?`), "${1}" + NamePlaceholder},
+}
+
+// Scrubber removes PHI from free text: the names it is given, names after a
+// title such as "Dr." or a relation such as "my wife", and dates, ages,
+// addresses, email addresses, phone numbers, URLs and identifiers. Other
+// names are not recognized; Unrecognized finds the words that may be one.
+type Scrubber struct {
+	names *regexp.Regexp
+}
+
+// NewScrubber returns a Scrubber that also removes each of names and the
+// words they are made of
+func NewScrubber(names ...string) *Scrubber {
+	seen := make(map[string]bool)
+	var words []string
+	add := func(w string) {
+		w = strings.TrimSpace(w)
+		if len([]rune(w)) < 2 || seen[strings.ToLower(w)] {
+			return
+		}
+		seen[strings.ToLower(w)] = true
+		words = append(words, regexp.QuoteMeta(w))
+	}
+	for _, name := range names {
+		add(name)
+		for _, part := range strings.Fields(name) {
+			add(strings.Trim(part, ".,"))
+		}
+	}
+	if len(words) == 0 {
+		return &Scrubber{}
+	}
+
+	// Longest first, so full names are replaced whole
+	sort.Slice(words, func(i, j int) bool { return len(words[i]) > len(words[j]) })
+	return &Scrubber{names: regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`)}
+}
+
+// Scrub returns text with PHI replaced by placeholders
+func (s *Scrubber) Scrub(text string) string {
+	for _, rule := range scrubRules {
+		text = rule.pattern.ReplaceAllString(text, rule.placeholder)
+	}
+	if s.names != nil {
+		text = s.names.ReplaceAllString(text, NamePlaceholder)
+	}
+	return text
+}
+
+//go:embed vocabulary.txt
+var vocabularyFile string
+
+// vocabulary holds the words that may appear capitalized in scrubbed text
+var vocabulary = func() map[string]bool {
+	words := make(map[string]bool)
+	for _, line := range strings.Split(vocabularyFile, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, word := range strings.Fields(line) {
+			words[word] = true
+		}
+	}
+	return words
+}()
+
+var wordPattern = regexp.MustCompile(`\p{L}[\p{L}'’-]*`)
+
+// Unrecognized returns the capitalized words of text outside the vocabulary,
+// which may be names the Scrubber missed. Text with any is not safe to share
+// without review.
+func Unrecognized(text string) []string {
+	var words []string
+	for _, word := range wordPattern.FindAllString(text, -1) {
+		word = strings.TrimRight(strings.ReplaceAll(word, "’", "'"), "'-")
+		if !unicode.IsUpper([]rune(word)[0]) || knownWord(word) {
+			continue
+		}
+		words = append(words, word)
+	}
+	return words
+}
+
+// knownWord reports whether word, or each part of a hyphenated word, is in
+// the vocabulary, a possessive 's aside
+func knownWord(word string) bool {
+	word = strings.ToLower(word)
+	if vocabulary[word] {
+		return true
+	}
+	for _, part := range strings.Split(strings.TrimSuffix(word, "'s"), "-") {
+		if part != "" && !vocabulary[part] {
+			return false
+		}
+	}
+	return true
+}
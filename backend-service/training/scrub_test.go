@@ -0,0 +1,108 @@
+package training
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"llm-qa-system/backend-service/src/db"
+	pg "llm-qa-system/backend-service/utils"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestScrub(t *testing.T) {
+	scrubber := NewScrubber("Jane Doe", emailName("j.doe@example.com"), "Alan Grant")
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"given name", "Jane Doe here, I have a cough", "[NAME] here, I have a cough"},
+		{"part of a given name", "Thanks, Jane", "Thanks, [NAME]"},
+		{"reviewer", "Alan will call you", "[NAME] will call you"},
+		{"title", "Dr. Sattler said to rest", "[NAME] said to rest"},
+		{"relation", "My husband Tom has the same rash", "My husband [NAME] has the same rash"},
+		{"named relation", "my neighbour, called Ellie Sattler, drove me", "my neighbour, called [NAME], drove me"},
+		{"email", "Write to jane.doe@example.com", "Write to [EMAIL]"},
+		{"phone", "Call me at (555) 123-4567", "Call me at [PHONE]"},
+		{"url", "See https://example.com/records/42", "See [URL]"},
+		{"iso date", "Since 2024-06-01 I feel worse", "Since [DATE] I feel worse"},
+		{"written date", "It started on March 3rd, 2024", "It started on [DATE]"},
+		{"record number", "My MRN: 12345678", "My [ID]"},
+		{"ssn", "SSN 123-45-6789", "SSN [ID]"},
+		{"age", "I am 42 years old", "I am [AGE]"},
+		{"hyphenated age", "A 67-year-old with chest pain", "A [AGE] with chest pain"},
+		{"short age", "F, 34 y/o", "F, [AGE]"},
+		{"aged", "Patient aged 81 reports falls", "Patient [AGE] reports falls"},
+		{"decade", "She is in her late 70s", "She is in her [AGE]"},
+		{"street address", "I live at 221 Baker Street, London", "I live at [ADDRESS], London"},
+		{"apartment", "Apt 4B, Springfield IL 62704", "[ADDRESS], Springfield [ADDRESS]"},
+		{"dosage kept", "Take 400 mg every 8 hours for 3 days", "Take 400 mg every 8 hours for 3 days"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrubber.Scrub(tt.text); got != tt.want {
+				t.Fatalf("Scrub(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnrecognized(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"plain", "I have had a headache since Monday. Take ibuprofen and rest.", nil},
+		{"contractions and acronyms", "I'm worried. Don't skip the ECG, it's important.", nil},
+		{"placeholders", "[NAME] was seen on [DATE] at [ADDRESS].", nil},
+		{"hyphenated", "Follow-up in a week. X-ray first.", nil},
+		{"hyphenated name", "Ask Anne-Marie to come along.", []string{"Anne-Marie"}},
+		{"third party", "My son Kevin has a fever too.", []string{"Kevin"}},
+		{"sentence start", "Sarah thinks it is the flu.", []string{"Sarah"}},
+		{"place", "We were in Lisbon last week.", []string{"Lisbon"}},
+		{"possessive", "Mark's cough is worse.", []string{"Mark's"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unrecognized(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Unrecognized(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildExampleFlagsUnrecognizedWords(t *testing.T) {
+	row := func(question, answer string) db.GetAITrainingDataRow {
+		components, _ := json.Marshal(promptComponents{Question: question})
+		return db.GetAITrainingDataRow{
+			ID:               pg.NewUUID(),
+			PromptComponents: components,
+			AiResponse:       answer,
+			ReviewStatus:     pgtype.Text{String: db.ReviewApproved, Valid: true},
+			PatientName:      "Jane Doe",
+		}
+	}
+	tests := []struct {
+		name string
+		row  db.GetAITrainingDataRow
+		want []string
+	}{
+		{"clean", row("Jane Doe here, is a fever of 39 dangerous?", "It can be. Drink water and rest."), nil},
+		{"name in question", row("Is it contagious? Priya at work has it too.", "It may be."), []string{"Priya"}},
+		{"name in answer", row("Is a fever of 39 dangerous?", "Ask Rahul at the pharmacy."), []string{"Rahul"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, unrecognized, ok, err := buildExample(tt.row, FormatChat)
+			if err != nil || !ok {
+				t.Fatalf("buildExample() = %v, %v", ok, err)
+			}
+			if !reflect.DeepEqual(unrecognized, tt.want) {
+				t.Fatalf("buildExample() unrecognized = %q, want %q", unrecognized, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package pg
+
+// EditDistance returns the Levenshtein distance between a and b in runes,
+// and the length of the longer one
+func EditDistance(a, b string) (int, int) {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if a == b {
+		return 0, longest
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)], longest
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"llm-qa-system/backend-service/src/proto"
 	"math/big"
+	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -103,3 +104,10 @@ func Uint64ToNumeric(val uint64) pgtype.Numeric {
 
 	return numeric
 }
+
+// Float64ToNumeric converts a float64 to a postgres numeric
+func Float64ToNumeric(val float64) pgtype.Numeric {
+	var numeric pgtype.Numeric
+	numeric.Scan(strconv.FormatFloat(val, 'f', -1, 64))
+	return numeric
+}
@@ -14,7 +14,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15medical_service.proto\x12\x07\x62\x61\x63kend\x1a\x1fgoogle/protobuf/timestamp.proto\"\x15\n\x04UUID\x12\r\n\x05value\x18\x01 \x01(\x0c\"x\n\x0fQuestionRequest\x12\"\n\x0bquestion_id\x18\x01 \x01(\x0b\x32\r.backend.UUID\x12\x15\n\rquestion_text\x18\x02 \x01(\t\x12*\n\x0cuser_context\x18\x03 \x01(\x0b\x32\x14.backend.UserContext\"\x8f\x01\n\x0bUserContext\x12$\n\tuser_info\x18\x01 \x01(\x0b\x32\x11.backend.UserInfo\x12.\n\x0e\x62iometric_data\x18\x02 \x03(\x0b\x32\x16.backend.BiometricData\x12*\n\x0c\x63hat_history\x18\x03 \x03(\x0b\x32\x14.backend.ChatMessage\"Q\n\x08UserInfo\x12\x0b\n\x03\x61ge\x18\x01 \x01(\t\x12\x1f\n\x06gender\x18\x02 \x01(\x0e\x32\x0f.backend.Gender\x12\x17\n\x0fmedical_history\x18\x03 \x03(\t\"s\n\rBiometricData\x12$\n\x04type\x18\x01 \x01(\x0e\x32\x16.backend.BiometricType\x12\r\n\x05value\x18\x02 \x01(\t\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"j\n\x0b\x43hatMessage\x12\x1b\n\x04role\x18\x01 \x01(\x0e\x32\r.backend.Role\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"z\n\x10QuestionResponse\x12\"\n\x0bquestion_id\x18\x01 \x01(\x0b\x32\r.backend.UUID\x12\x14\n\x0c\x64raft_answer\x18\x02 \x01(\t\x12\x12\n\nreferences\x18\x03 \x03(\t\x12\x18\n\x10\x63onfidence_score\x18\x04 \x01(\x02\"\xda\x01\n\x10WebSocketMessage\x12\"\n\x04type\x18\x01 \x01(\x0e\x32\x14.backend.MessageType\x12#\n\x07message\x18\x02 \x01(\x0b\x32\x10.backend.MessageH\x00\x12)\n\x08\x61i_draft\x18\x03 \x01(\x0b\x32\x15.backend.AIDraftReadyH\x00\x12&\n\x06review\x18\x04 \x01(\x0b\x32\x14.backend.DraftReviewH\x00\x12\x1f\n\x05\x65rror\x18\x05 \x01(\x0b\x32\x0e.backend.ErrorH\x00\x42\t\n\x07payload\"I\n\x07Message\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"z\n\x0c\x41IDraftReady\x12\x12\n\nmessage_id\x18\x01 \x01(\t\x12\x18\n\x10original_message\x18\x02 \x01(\t\x12\r\n\x05\x64raft\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x88\x01\n\x0b\x44raftReview\x12\x12\n\nmessage_id\x18\x01 \x01(\t\x12%\n\x06\x61\x63tion\x18\x02 \x01(\x0e\x32\x15.backend.ReviewAction\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x18\n\x05\x45rror\x12\x0f\n\x07message\x18\x01 \x01(\t*L\n\x04Role\x12\x10\n\x0cROLE_UNKNOWN\x10\x00\x12\x10\n\x0cROLE_PATIENT\x10\x01\x12\x0f\n\x0bROLE_DOCTOR\x10\x02\x12\x0f\n\x0bROLE_SYSTEM\x10\x03*@\n\x06Gender\x12\x12\n\x0eGENDER_UNKNOWN\x10\x00\x12\x0f\n\x0bGENDER_MALE\x10\x01\x12\x11\n\rGENDER_FEMALE\x10\x02*z\n\rBiometricType\x12\x15\n\x11\x42IOMETRIC_UNKNOWN\x10\x00\x12\x18\n\x14\x42IOMETRIC_HEART_RATE\x10\x01\x12\x1a\n\x16\x42IOMETRIC_BLOOD_OXYGEN\x10\x02\x12\x1c\n\x18\x42IOMETRIC_BLOOD_PRESSURE\x10\x03*\x85\x01\n\x0bMessageType\x12\x1c\n\x18MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n\x0fPATIENT_MESSAGE\x10\x01\x12\x12\n\x0e\x44OCTOR_MESSAGE\x10\x02\x12\x12\n\x0e\x41I_DRAFT_READY\x10\x03\x12\x10\n\x0c\x44RAFT_REVIEW\x10\x04\x12\t\n\x05\x45RROR\x10\x05*Q\n\x0cReviewAction\x12\x1d\n\x19REVIEW_ACTION_UNSPECIFIED\x10\x00\x12\n\n\x06\x41\x43\x43\x45PT\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\n\n\x06REJECT\x10\x03\x32`\n\x10MedicalQAService\x12L\n\x13GenerateDraftAnswer\x12\x18.backend.QuestionRequest\x1a\x19.backend.QuestionResponse\"\x00\x42?Z=github.com/supertime1/llm-qa-system/backend-service/src/protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x15medical_service.proto\x12\x07\x62\x61\x63kend\x1a\x1fgoogle/protobuf/timestamp.proto\"\x15\n\x04UUID\x12\r\n\x05value\x18\x01 \x01(\x0c\"\x91\x01\n\x0fQuestionRequest\x12\"\n\x0bquestion_id\x18\x01 \x01(\x0b\x32\r.backend.UUID\x12\x15\n\rquestion_text\x18\x02 \x01(\t\x12*\n\x0cuser_context\x18\x03 \x01(\x0b\x32\x14.backend.UserContext\x12\x17\n\x0fprompt_template\x18\x04 \x01(\t\"\x8f\x01\n\x0bUserContext\x12$\n\tuser_info\x18\x01 \x01(\x0b\x32\x11.backend.UserInfo\x12.\n\x0e\x62iometric_data\x18\x02 \x03(\x0b\x32\x16.backend.BiometricData\x12*\n\x0c\x63hat_history\x18\x03 \x03(\x0b\x32\x14.backend.ChatMessage\"Q\n\x08UserInfo\x12\x0b\n\x03\x61ge\x18\x01 \x01(\t\x12\x1f\n\x06gender\x18\x02 \x01(\x0e\x32\x0f.backend.Gender\x12\x17\n\x0fmedical_history\x18\x03 \x03(\t\"s\n\rBiometricData\x12$\n\x04type\x18\x01 \x01(\x0e\x32\x16.backend.BiometricType\x12\r\n\x05value\x18\x02 \x01(\t\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"j\n\x0b\x43hatMessage\x12\x1b\n\x04role\x18\x01 \x01(\x0e\x32\r.backend.Role\x12\x0f\n\x07\x63ontent\x18\x02 \x01(\t\x12-\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"z\n\x10QuestionResponse\x12\"\n\x0bquestion_id\x18\x01 \x01(\x0b\x32\r.backend.UUID\x12\x14\n\x0c\x64raft_answer\x18\x02 \x01(\t\x12\x12\n\nreferences\x18\x03 \x03(\t\x12\x18\n\x10\x63onfidence_score\x18\x04 \x01(\x02\"J\n\rTriageRequest\x12\"\n\x0bquestion_id\x18\x01 \x01(\x0b\x32\r.backend.UUID\x12\x15\n\rquestion_text\x18\x02 \x01(\t\"\x7f\n\x0eTriageResponse\x12\"\n\x0bquestion_id\x18\x01 \x01(\x0b\x32\r.backend.UUID\x12\x10\n\x08\x63\x61tegory\x18\x02 \x01(\t\x12&\n\x07urgency\x18\x03 \x01(\x0e\x32\x15.backend.UrgencyLevel\x12\x0f\n\x07reasons\x18\x04 \x03(\t\"\x8a\x01\n\x10\x42iometricReading\x12\x0f\n\x07type_id\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01\x12\x17\n\x0fsecondary_value\x18\x03 \x01(\x01\x12\x0c\n\x04unit\x18\x04 \x01(\t\x12/\n\x0bmeasured_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"j\n\x17IngestBiometricsRequest\x12\x12\n\npatient_id\x18\x01 \x01(\t\x12+\n\x08readings\x18\x02 \x03(\x0b\x32\x19.backend.BiometricReading\x12\x0e\n\x06source\x18\x03 \x01(\t\"0\n\x0fRejectedReading\x12\r\n\x05index\x18\x01 \x01(\x05\x12\x0e\n\x06reason\x18\x02 \x01(\t\"l\n\x18IngestBiometricsResponse\x12\x10\n\x08\x61\x63\x63\x65pted\x18\x01 \x01(\x05\x12\x12\n\nduplicates\x18\x02 \x01(\x05\x12*\n\x08rejected\x18\x03 \x03(\x0b\x32\x18.backend.RejectedReading\"\x7f\n\x07Patient\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0b\n\x03\x61ge\x18\x04 \x01(\x05\x12\x0e\n\x06gender\x18\x05 \x01(\t\x12.\n\ncreated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"P\n\x14\x43reatePatientRequest\x12\r\n\x05\x65mail\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0b\n\x03\x61ge\x18\x03 \x01(\x05\x12\x0e\n\x06gender\x18\x04 \x01(\t\"\x1f\n\x11GetPatientRequest\x12\n\n\x02id\x18\x01 \x01(\t\"<\n\x13ListPatientsRequest\x12\x11\n\tpage_size\x18\x01 \x01(\x05\x12\x12\n\npage_token\x18\x02 \x01(\t\"S\n\x14ListPatientsResponse\x12\"\n\x08patients\x18\x01 \x03(\x0b\x32\x10.backend.Patient\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\x96\x01\n\x14UpdatePatientRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\x05\x65mail\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04name\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x10\n\x03\x61ge\x18\x04 \x01(\x05H\x02\x88\x01\x01\x12\x13\n\x06gender\x18\x05 \x01(\tH\x03\x88\x01\x01\x42\x08\n\x06_emailB\x07\n\x05_nameB\x06\n\x04_ageB\t\n\x07_gender\"\"\n\x14\x44\x65letePatientRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\xc6\x01\n\x06\x44octor\x12\n\n\x02id\x18\x01 \x01(\t\x12\r\n\x05\x65mail\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x15\n\rdepartment_id\x18\x04 \x01(\t\x12\x17\n\x0f\x64\x65partment_name\x18\x05 \x01(\t\x12\x16\n\x0especialization\x18\x06 \x03(\t\x12\x1b\n\x13years_of_experience\x18\x07 \x01(\x05\x12.\n\ncreated_at\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"~\n\x13\x43reateDoctorRequest\x12\r\n\x05\x65mail\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x15\n\rdepartment_id\x18\x03 \x01(\t\x12\x16\n\x0especialization\x18\x04 \x03(\t\x12\x1b\n\x13years_of_experience\x18\x05 \x01(\x05\"\x1e\n\x10GetDoctorRequest\x12\n\n\x02id\x18\x01 \x01(\t\"R\n\x12ListDoctorsRequest\x12\x11\n\tpage_size\x18\x01 \x01(\x05\x12\x12\n\npage_token\x18\x02 \x01(\t\x12\x15\n\rdepartment_id\x18\x03 \x01(\t\"P\n\x13ListDoctorsResponse\x12 \n\x07\x64octors\x18\x01 \x03(\x0b\x32\x0f.backend.Doctor\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"!\n\x0fSpecializations\x12\x0e\n\x06values\x18\x01 \x03(\t\"\xf5\x01\n\x13UpdateDoctorRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\x05\x65mail\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x11\n\x04name\x18\x03 \x01(\tH\x01\x88\x01\x01\x12\x1a\n\rdepartment_id\x18\x04 \x01(\tH\x02\x88\x01\x01\x12\x30\n\x0especialization\x18\x05 \x01(\x0b\x32\x18.backend.Specializations\x12 \n\x13years_of_experience\x18\x06 \x01(\x05H\x03\x88\x01\x01\x42\x08\n\x06_emailB\x07\n\x05_nameB\x10\n\x0e_department_idB\x16\n\x14_years_of_experience\"!\n\x13\x44\x65leteDoctorRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\xcb\x01\n\x10MedicalCondition\x12\n\n\x02id\x18\x01 \x01(\t\x12\x12\n\npatient_id\x18\x02 \x01(\t\x12\x11\n\tcondition\x18\x03 \x01(\t\x12\x32\n\x0e\x64iagnosed_date\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tstatus_id\x18\x05 \x01(\t\x12\r\n\x05notes\x18\x06 \x01(\t\x12.\n\ncreated_at\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x92\x01\n\x13\x41\x64\x64\x43onditionRequest\x12\x12\n\npatient_id\x18\x01 \x01(\t\x12\x11\n\tcondition\x18\x02 \x01(\t\x12\x32\n\x0e\x64iagnosed_date\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tstatus_id\x18\x04 \x01(\t\x12\r\n\x05notes\x18\x05 \x01(\t\"e\n\x15ListConditionsRequest\x12\x12\n\npatient_id\x18\x01 \x01(\t\x12\x11\n\tpage_size\x18\x02 \x01(\x05\x12\x12\n\npage_token\x18\x03 \x01(\t\x12\x11\n\tstatus_id\x18\x04 \x01(\t\"`\n\x16ListConditionsResponse\x12-\n\nconditions\x18\x01 \x03(\x0b\x32\x19.backend.MedicalCondition\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\xc2\x01\n\x16UpdateConditionRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x16\n\tcondition\x18\x02 \x01(\tH\x00\x88\x01\x01\x12\x32\n\x0e\x64iagnosed_date\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x16\n\tstatus_id\x18\x04 \x01(\tH\x01\x88\x01\x01\x12\x12\n\x05notes\x18\x05 \x01(\tH\x02\x88\x01\x01\x42\x0c\n\n_conditionB\x0c\n\n_status_idB\x08\n\x06_notes\"$\n\x16\x44\x65leteConditionRequest\x12\n\n\x02id\x18\x01 \x01(\t\"\x10\n\x0e\x44\x65leteResponse\"\xd6\x01\n\x0ePromptTemplate\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x10\n\x08template\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x11\n\tis_active\x18\x04 \x01(\x08\x12\x19\n\x11\x65xperiment_weight\x18\x05 \x01(\x05\x12.\n\ncreated_at\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x1b\x43reatePromptTemplateRequest\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x10\n\x08template\x18\x02 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x03 \x01(\t\x12\x10\n\x08\x61\x63tivate\x18\x04 \x01(\x08\"+\n\x18GetPromptTemplateRequest\x12\x0f\n\x07version\x18\x01 \x01(\t\"\x1c\n\x1aListPromptTemplatesRequest\"I\n\x1bListPromptTemplatesResponse\x12*\n\ttemplates\x18\x01 \x03(\x0b\x32\x17.backend.PromptTemplate\"0\n\x1d\x41\x63tivatePromptTemplateRequest\x12\x0f\n\x07version\x18\x01 \x01(\t\"0\n\rExperimentArm\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x0e\n\x06weight\x18\x02 \x01(\x05\"B\n\x1aSetPromptExperimentRequest\x12$\n\x04\x61rms\x18\x01 \x03(\x0b\x32\x16.backend.ExperimentArm\"8\n\x10PromptExperiment\x12$\n\x04\x61rms\x18\x01 \x03(\x0b\x32\x16.backend.ExperimentArm\"\x1c\n\x1aGetPromptExperimentRequest\"}\n\x1dGetPromptTemplateStatsRequest\x12.\n\nstart_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xbf\x01\n\x13PromptTemplateStats\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x1a\n\x12total_interactions\x18\x02 \x01(\x03\x12\x16\n\x0e\x61pproved_count\x18\x03 \x01(\x03\x12\x16\n\x0erejected_count\x18\x04 \x01(\x03\x12\x16\n\x0emodified_count\x18\x05 \x01(\x03\x12\x15\n\rpending_count\x18\x06 \x01(\x03\x12\x1c\n\x14\x61vg_confidence_score\x18\x07 \x01(\x01\"Q\n\x1eGetPromptTemplateStatsResponse\x12/\n\ttemplates\x18\x01 \x03(\x0b\x32\x1c.backend.PromptTemplateStats\"\xc3\x01\n\x13\x44raftQualityRequest\x12.\n\nstart_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x08group_by\x18\x03 \x01(\x0e\x32\x17.backend.AnalyticsGroup\x12#\n\x06\x62ucket\x18\x04 \x01(\x0e\x32\x13.backend.TimeBucket\"\xa5\x03\n\x11\x44raftQualityStats\x12\x30\n\x0c\x62ucket_start\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05group\x18\x02 \x01(\t\x12\x12\n\ngroup_name\x18\x03 \x01(\t\x12\r\n\x05total\x18\x04 \x01(\x03\x12\x0f\n\x07pending\x18\x05 \x01(\x03\x12\x10\n\x08\x61\x63\x63\x65pted\x18\x06 \x01(\x03\x12\x10\n\x08modified\x18\x07 \x01(\x03\x12\x10\n\x08rejected\x18\x08 \x01(\x03\x12\x13\n\x0b\x61\x63\x63\x65pt_rate\x18\t \x01(\x01\x12\x13\n\x0bmodify_rate\x18\n \x01(\x01\x12\x13\n\x0breject_rate\x18\x0b \x01(\x01\x12\x17\n\x0fmean_confidence\x18\x0c \x01(\x01\x12#\n\x1bmean_review_latency_seconds\x18\r \x01(\x01\x12%\n\x1dmedian_review_latency_seconds\x18\x0e \x01(\x01\x12\x1a\n\x12mean_edit_distance\x18\x0f \x01(\x01\x12%\n\x1dmean_normalized_edit_distance\x18\x10 \x01(\x01\"A\n\x14\x44raftQualityResponse\x12)\n\x05stats\x18\x01 \x03(\x0b\x32\x1a.backend.DraftQualityStats\"\xbe\x02\n\nAuditEvent\x12\x0b\n\x03seq\x18\x01 \x01(\x03\x12/\n\x0boccurred_at\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63tion\x18\x03 \x01(\t\x12\x12\n\nactor_role\x18\x04 \x01(\t\x12\x10\n\x08\x61\x63tor_id\x18\x05 \x01(\t\x12\x12\n\npatient_id\x18\x06 \x01(\t\x12\x12\n\nsession_id\x18\x07 \x01(\t\x12\x10\n\x08resource\x18\x08 \x01(\t\x12\x31\n\x07\x64\x65tails\x18\t \x03(\x0b\x32 .backend.AuditEvent.DetailsEntry\x12\x11\n\tprev_hash\x18\n \x01(\t\x12\x0c\n\x04hash\x18\x0b \x01(\t\x1a.\n\x0c\x44\x65tailsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xc3\x01\n\x16ListAuditEventsRequest\x12\x12\n\npatient_id\x18\x01 \x01(\t\x12\x10\n\x08\x61\x63tor_id\x18\x02 \x01(\t\x12.\n\nstart_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tpage_size\x18\x05 \x01(\x05\x12\x12\n\npage_token\x18\x06 \x01(\t\"W\n\x17ListAuditEventsResponse\x12#\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x13.backend.AuditEvent\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\x17\n\x15VerifyAuditLogRequest\"j\n\x16VerifyAuditLogResponse\x12\r\n\x05valid\x18\x01 \x01(\x08\x12\x16\n\x0e\x65vents_checked\x18\x02 \x01(\x03\x12\x19\n\x11\x66irst_invalid_seq\x18\x03 \x01(\x03\x12\x0e\n\x06reason\x18\x04 \x01(\t\"\x99\x05\n\x10WebSocketMessage\x12\"\n\x04type\x18\x01 \x01(\x0e\x32\x14.backend.MessageType\x12#\n\x07message\x18\x02 \x01(\x0b\x32\x10.backend.MessageH\x00\x12)\n\x08\x61i_draft\x18\x03 \x01(\x0b\x32\x15.backend.AIDraftReadyH\x00\x12&\n\x06review\x18\x04 \x01(\x0b\x32\x14.backend.DraftReviewH\x00\x12\x1f\n\x05\x65rror\x18\x05 \x01(\x0b\x32\x0e.backend.ErrorH\x00\x12\x30\n\nassignment\x18\x06 \x01(\x0b\x32\x1a.backend.SessionAssignmentH\x00\x12.\n\rdoctor_status\x18\x07 \x01(\x0b\x32\x15.backend.DoctorStatusH\x00\x12/\n\nescalation\x18\x08 \x01(\x0b\x32\x19.backend.ReviewEscalationH\x00\x12*\n\x07handoff\x18\t \x01(\x0b\x32\x17.backend.SessionHandoffH\x00\x12*\n\x0bvital_alert\x18\n \x01(\x0b\x32\x13.backend.VitalAlertH\x00\x12.\n\rsession_event\x18\x0b \x01(\x0b\x32\x15.backend.SessionEventH\x00\x12\"\n\x03\x61\x63k\x18\x0c \x01(\x0b\x32\x13.backend.MessageAckH\x00\x12+\n\x07receipt\x18\r \x01(\x0b\x32\x18.backend.DeliveryReceiptH\x00\x12*\n\x06typing\x18\x0e \x01(\x0b\x32\x18.backend.TypingIndicatorH\x00\x12%\n\x08presence\x18\x0f \x01(\x0b\x32\x11.backend.PresenceH\x00\x42\t\n\x07payload\"\x85\x01\n\x07Message\x12\x0f\n\x07\x63ontent\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x12\n\nmessage_id\x18\x03 \x01(\t\x12\x19\n\x11\x63lient_message_id\x18\x04 \x01(\t\x12\x0b\n\x03seq\x18\x05 \x01(\x04\"\xba\x01\n\x0c\x41IDraftReady\x12\x12\n\nmessage_id\x18\x01 \x01(\t\x12\x18\n\x10original_message\x18\x02 \x01(\t\x12\r\n\x05\x64raft\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12&\n\x07urgency\x18\x05 \x01(\x0e\x32\x15.backend.UrgencyLevel\x12\x16\n\x0etriage_reasons\x18\x06 \x03(\t\"\x88\x01\n\x0b\x44raftReview\x12\x12\n\nmessage_id\x18\x01 \x01(\t\x12%\n\x06\x61\x63tion\x18\x02 \x01(\x0e\x32\x15.backend.ReviewAction\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\t\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x18\n\x05\x45rror\x12\x0f\n\x07message\x18\x01 \x01(\t\"\xd6\x01\n\x11SessionAssignment\x12\x12\n\nsession_id\x18\x01 \x01(\t\x12\x15\n\rdepartment_id\x18\x02 \x01(\t\x12&\n\x07urgency\x18\x03 \x01(\x0e\x32\x15.backend.UrgencyLevel\x12-\n\taccept_by\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\twithdrawn\x18\x05 \x01(\x08\x12\x16\n\x0e\x66rom_doctor_id\x18\x06 \x01(\t\x12\x14\n\x0chandoff_note\x18\x07 \x01(\t\"A\n\x0c\x44octorStatus\x12\x31\n\x0c\x61vailability\x18\x01 \x01(\x0e\x32\x1b.backend.DoctorAvailability\"\x86\x02\n\x10ReviewEscalation\x12\x12\n\nsession_id\x18\x01 \x01(\t\x12\x12\n\nmessage_id\x18\x02 \x01(\t\x12&\n\x07urgency\x18\x03 \x01(\x0e\x32\x15.backend.UrgencyLevel\x12-\n\tqueued_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12*\n\x06\x64ue_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rdepartment_id\x18\x06 \x01(\t\x12\x1a\n\x12\x61ssigned_doctor_id\x18\x07 \x01(\t\x12\x14\n\x0c\x65scalated_to\x18\x08 \x01(\t\"4\n\x0eSessionHandoff\x12\x14\n\x0cto_doctor_id\x18\x01 \x01(\t\x12\x0c\n\x04note\x18\x02 \x01(\t\"\xb3\x01\n\x0cSessionEvent\x12\x12\n\nsession_id\x18\x01 \x01(\t\x12\x18\n\x10protocol_version\x18\x02 \x01(\r\x12\x0c\n\x04role\x18\x03 \x01(\t\x12\x16\n\x0eparticipant_id\x18\x04 \x01(\t\x12\x10\n\x08observer\x18\x05 \x01(\x08\x12\x0e\n\x06reason\x18\x06 \x01(\t\x12-\n\ttimestamp\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"I\n\nMessageAck\x12\x12\n\nmessage_id\x18\x01 \x01(\t\x12\'\n\x06status\x18\x02 \x01(\x0e\x32\x17.backend.DeliveryStatus\"\x98\x01\n\x0f\x44\x65liveryReceipt\x12\x12\n\nmessage_id\x18\x01 \x01(\t\x12\x19\n\x11\x63lient_message_id\x18\x02 \x01(\t\x12\'\n\x06status\x18\x03 \x01(\x0e\x32\x17.backend.DeliveryStatus\x12-\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x8a\x01\n\x0fTypingIndicator\x12\x0e\n\x06typing\x18\x01 \x01(\x08\x12\x12\n\nsession_id\x18\x02 \x01(\t\x12\x0c\n\x04role\x18\x03 \x01(\t\x12\x16\n\x0eparticipant_id\x18\x04 \x01(\t\x12-\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x9c\x01\n\x08Presence\x12\'\n\x06status\x18\x01 \x01(\x0e\x32\x17.backend.PresenceStatus\x12\x12\n\nsession_id\x18\x02 \x01(\t\x12\x0c\n\x04role\x18\x03 \x01(\t\x12\x16\n\x0eparticipant_id\x18\x04 \x01(\t\x12-\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xdb\x01\n\nVitalAlert\x12\x12\n\nsession_id\x18\x01 \x01(\t\x12\x0c\n\x04rule\x18\x02 \x01(\t\x12\x0f\n\x07type_id\x18\x03 \x01(\t\x12&\n\x07urgency\x18\x04 \x01(\x0e\x32\x15.backend.UrgencyLevel\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\x12+\n\x08readings\x18\x06 \x03(\x0b\x32\x19.backend.BiometricReading\x12\x30\n\x0ctriggered_at\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp*L\n\x04Role\x12\x10\n\x0cROLE_UNKNOWN\x10\x00\x12\x10\n\x0cROLE_PATIENT\x10\x01\x12\x0f\n\x0bROLE_DOCTOR\x10\x02\x12\x0f\n\x0bROLE_SYSTEM\x10\x03*@\n\x06Gender\x12\x12\n\x0eGENDER_UNKNOWN\x10\x00\x12\x0f\n\x0bGENDER_MALE\x10\x01\x12\x11\n\rGENDER_FEMALE\x10\x02*g\n\x0cUrgencyLevel\x12\x17\n\x13URGENCY_UNSPECIFIED\x10\x00\x12\x13\n\x0fURGENCY_ROUTINE\x10\x01\x12\x12\n\x0eURGENCY_URGENT\x10\x02\x12\x15\n\x11URGENCY_EMERGENCY\x10\x03*\xa6\x02\n\rBiometricType\x12\x15\n\x11\x42IOMETRIC_UNKNOWN\x10\x00\x12\x18\n\x14\x42IOMETRIC_HEART_RATE\x10\x01\x12\x1a\n\x16\x42IOMETRIC_BLOOD_OXYGEN\x10\x02\x12\x1c\n\x18\x42IOMETRIC_BLOOD_PRESSURE\x10\x03\x12\x19\n\x15\x42IOMETRIC_TEMPERATURE\x10\x04\x12\x1b\n\x17\x42IOMETRIC_BLOOD_GLUCOSE\x10\x05\x12\x1e\n\x1a\x42IOMETRIC_RESPIRATORY_RATE\x10\x06\x12\x14\n\x10\x42IOMETRIC_WEIGHT\x10\x07\x12\x14\n\x10\x42IOMETRIC_HEIGHT\x10\x08\x12\x11\n\rBIOMETRIC_BMI\x10\t\x12\x13\n\x0f\x42IOMETRIC_STEPS\x10\n*\x8a\x01\n\x0e\x41nalyticsGroup\x12\x18\n\x14\x41NALYTICS_GROUP_NONE\x10\x00\x12\x1a\n\x16\x41NALYTICS_GROUP_DOCTOR\x10\x01\x12\x1e\n\x1a\x41NALYTICS_GROUP_DEPARTMENT\x10\x02\x12\"\n\x1e\x41NALYTICS_GROUP_PROMPT_VERSION\x10\x03*z\n\nTimeBucket\x12\x14\n\x10TIME_BUCKET_NONE\x10\x00\x12\x14\n\x10TIME_BUCKET_HOUR\x10\x01\x12\x13\n\x0fTIME_BUCKET_DAY\x10\x02\x12\x14\n\x10TIME_BUCKET_WEEK\x10\x03\x12\x15\n\x11TIME_BUCKET_MONTH\x10\x04*\x95\x03\n\x0bMessageType\x12\x1c\n\x18MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n\x0fPATIENT_MESSAGE\x10\x01\x12\x12\n\x0e\x44OCTOR_MESSAGE\x10\x02\x12\x12\n\x0e\x41I_DRAFT_READY\x10\x03\x12\x10\n\x0c\x44RAFT_REVIEW\x10\x04\x12\t\n\x05\x45RROR\x10\x05\x12\x12\n\x0eSYSTEM_MESSAGE\x10\x06\x12\x16\n\x12SESSION_ASSIGNMENT\x10\x07\x12\x11\n\rDOCTOR_STATUS\x10\x08\x12\x15\n\x11REVIEW_ESCALATION\x10\t\x12\x13\n\x0fSESSION_HANDOFF\x10\n\x12\x0f\n\x0bVITAL_ALERT\x10\x0b\x12\x13\n\x0fSESSION_STARTED\x10\x0c\x12\x12\n\x0eSESSION_JOINED\x10\r\x12\x14\n\x10PARTICIPANT_LEFT\x10\x0e\x12\x12\n\x0eSESSION_CLOSED\x10\x0f\x12\x0f\n\x0bMESSAGE_ACK\x10\x10\x12\x14\n\x10\x44\x45LIVERY_RECEIPT\x10\x11\x12\n\n\x06TYPING\x10\x12\x12\x0c\n\x08PRESENCE\x10\x13*R\n\x0ePresenceStatus\x12\x18\n\x14PRESENCE_UNSPECIFIED\x10\x00\x12\x13\n\x0fPRESENCE_ONLINE\x10\x01\x12\x11\n\rPRESENCE_AWAY\x10\x02*q\n\x0e\x44\x65liveryStatus\x12\x1f\n\x1b\x44\x45LIVERY_STATUS_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x44\x45LIVERY_QUEUED\x10\x01\x12\x16\n\x12\x44\x45LIVERY_DELIVERED\x10\x02\x12\x11\n\rDELIVERY_READ\x10\x03*|\n\x12\x44octorAvailability\x12\x1c\n\x18\x41VAILABILITY_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x41VAILABILITY_AVAILABLE\x10\x01\x12\x15\n\x11\x41VAILABILITY_BUSY\x10\x02\x12\x15\n\x11\x41VAILABILITY_AWAY\x10\x03*Q\n\x0cReviewAction\x12\x1d\n\x19REVIEW_ACTION_UNSPECIFIED\x10\x00\x12\n\n\x06\x41\x43\x43\x45PT\x10\x01\x12\n\n\x06MODIFY\x10\x02\x12\n\n\x06REJECT\x10\x03\x32\xa5\x01\n\x10MedicalQAService\x12L\n\x13GenerateDraftAnswer\x12\x18.backend.QuestionRequest\x1a\x19.backend.QuestionResponse\"\x00\x12\x43\n\x0eTriageQuestion\x12\x16.backend.TriageRequest\x1a\x17.backend.TriageResponse\"\x00\x32m\n\x10\x42iometricService\x12Y\n\x10IngestBiometrics\x12 .backend.IngestBiometricsRequest\x1a!.backend.IngestBiometricsResponse\"\x00\x32\xf0\x02\n\x0ePatientService\x12\x42\n\rCreatePatient\x12\x1d.backend.CreatePatientRequest\x1a\x10.backend.Patient\"\x00\x12<\n\nGetPatient\x12\x1a.backend.GetPatientRequest\x1a\x10.backend.Patient\"\x00\x12M\n\x0cListPatients\x12\x1c.backend.ListPatientsRequest\x1a\x1d.backend.ListPatientsResponse\"\x00\x12\x42\n\rUpdatePatient\x12\x1d.backend.UpdatePatientRequest\x1a\x10.backend.Patient\"\x00\x12I\n\rDeletePatient\x12\x1d.backend.DeletePatientRequest\x1a\x17.backend.DeleteResponse\"\x00\x32\xe1\x02\n\rDoctorService\x12?\n\x0c\x43reateDoctor\x12\x1c.backend.CreateDoctorRequest\x1a\x0f.backend.Doctor\"\x00\x12\x39\n\tGetDoctor\x12\x19.backend.GetDoctorRequest\x1a\x0f.backend.Doctor\"\x00\x12J\n\x0bListDoctors\x12\x1b.backend.ListDoctorsRequest\x1a\x1c.backend.ListDoctorsResponse\"\x00\x12?\n\x0cUpdateDoctor\x12\x1c.backend.UpdateDoctorRequest\x1a\x0f.backend.Doctor\"\x00\x12G\n\x0c\x44\x65leteDoctor\x12\x1c.backend.DeleteDoctorRequest\x1a\x17.backend.DeleteResponse\"\x00\x32\xd7\x02\n\x15MedicalHistoryService\x12I\n\x0c\x41\x64\x64\x43ondition\x12\x1c.backend.AddConditionRequest\x1a\x19.backend.MedicalCondition\"\x00\x12S\n\x0eListConditions\x12\x1e.backend.ListConditionsRequest\x1a\x1f.backend.ListConditionsResponse\"\x00\x12O\n\x0fUpdateCondition\x12\x1f.backend.UpdateConditionRequest\x1a\x19.backend.MedicalCondition\"\x00\x12M\n\x0f\x44\x65leteCondition\x12\x1f.backend.DeleteConditionRequest\x1a\x17.backend.DeleteResponse\"\x00\x32\xa3\x05\n\x15PromptTemplateService\x12W\n\x14\x43reatePromptTemplate\x12$.backend.CreatePromptTemplateRequest\x1a\x17.backend.PromptTemplate\"\x00\x12Q\n\x11GetPromptTemplate\x12!.backend.GetPromptTemplateRequest\x1a\x17.backend.PromptTemplate\"\x00\x12\x62\n\x13ListPromptTemplates\x12#.backend.ListPromptTemplatesRequest\x1a$.backend.ListPromptTemplatesResponse\"\x00\x12[\n\x16\x41\x63tivatePromptTemplate\x12&.backend.ActivatePromptTemplateRequest\x1a\x17.backend.PromptTemplate\"\x00\x12W\n\x13SetPromptExperiment\x12#.backend.SetPromptExperimentRequest\x1a\x19.backend.PromptExperiment\"\x00\x12W\n\x13GetPromptExperiment\x12#.backend.GetPromptExperimentRequest\x1a\x19.backend.PromptExperiment\"\x00\x12k\n\x16GetPromptTemplateStats\x12&.backend.GetPromptTemplateStatsRequest\x1a\'.backend.GetPromptTemplateStatsResponse\"\x00\x32\x64\n\x10\x41nalyticsService\x12P\n\x0fGetDraftQuality\x12\x1c.backend.DraftQualityRequest\x1a\x1d.backend.DraftQualityResponse\"\x00\x32\xbb\x01\n\x0c\x41uditService\x12V\n\x0fListAuditEvents\x12\x1f.backend.ListAuditEventsRequest\x1a .backend.ListAuditEventsResponse\"\x00\x12S\n\x0eVerifyAuditLog\x12\x1e.backend.VerifyAuditLogRequest\x1a\x1f.backend.VerifyAuditLogResponse\"\x00\x42?Z=github.com/supertime1/llm-qa-system/backend-service/src/protob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -22,40 +22,188 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'medical_service_pb2', _glob
 if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._options = None
   DESCRIPTOR._serialized_options = b'Z=github.com/supertime1/llm-qa-system/backend-service/src/proto'
-  _globals['_ROLE']._serialized_start=1375
-  _globals['_ROLE']._serialized_end=1451
-  _globals['_GENDER']._serialized_start=1453
-  _globals['_GENDER']._serialized_end=1517
-  _globals['_BIOMETRICTYPE']._serialized_start=1519
-  _globals['_BIOMETRICTYPE']._serialized_end=1641
-  _globals['_MESSAGETYPE']._serialized_start=1644
-  _globals['_MESSAGETYPE']._serialized_end=1777
-  _globals['_REVIEWACTION']._serialized_start=1779
-  _globals['_REVIEWACTION']._serialized_end=1860
+  _globals['_AUDITEVENT_DETAILSENTRY']._options = None
+  _globals['_AUDITEVENT_DETAILSENTRY']._serialized_options = b'8\x01'
+  _globals['_ROLE']._serialized_start=8921
+  _globals['_ROLE']._serialized_end=8997
+  _globals['_GENDER']._serialized_start=8999
+  _globals['_GENDER']._serialized_end=9063
+  _globals['_URGENCYLEVEL']._serialized_start=9065
+  _globals['_URGENCYLEVEL']._serialized_end=9168
+  _globals['_BIOMETRICTYPE']._serialized_start=9171
+  _globals['_BIOMETRICTYPE']._serialized_end=9465
+  _globals['_ANALYTICSGROUP']._serialized_start=9468
+  _globals['_ANALYTICSGROUP']._serialized_end=9606
+  _globals['_TIMEBUCKET']._serialized_start=9608
+  _globals['_TIMEBUCKET']._serialized_end=9730
+  _globals['_MESSAGETYPE']._serialized_start=9733
+  _globals['_MESSAGETYPE']._serialized_end=10138
+  _globals['_PRESENCESTATUS']._serialized_start=10140
+  _globals['_PRESENCESTATUS']._serialized_end=10222
+  _globals['_DELIVERYSTATUS']._serialized_start=10224
+  _globals['_DELIVERYSTATUS']._serialized_end=10337
+  _globals['_DOCTORAVAILABILITY']._serialized_start=10339
+  _globals['_DOCTORAVAILABILITY']._serialized_end=10463
+  _globals['_REVIEWACTION']._serialized_start=10465
+  _globals['_REVIEWACTION']._serialized_end=10546
   _globals['_UUID']._serialized_start=67
   _globals['_UUID']._serialized_end=88
-  _globals['_QUESTIONREQUEST']._serialized_start=90
-  _globals['_QUESTIONREQUEST']._serialized_end=210
-  _globals['_USERCONTEXT']._serialized_start=213
-  _globals['_USERCONTEXT']._serialized_end=356
-  _globals['_USERINFO']._serialized_start=358
-  _globals['_USERINFO']._serialized_end=439
-  _globals['_BIOMETRICDATA']._serialized_start=441
-  _globals['_BIOMETRICDATA']._serialized_end=556
-  _globals['_CHATMESSAGE']._serialized_start=558
-  _globals['_CHATMESSAGE']._serialized_end=664
-  _globals['_QUESTIONRESPONSE']._serialized_start=666
-  _globals['_QUESTIONRESPONSE']._serialized_end=788
-  _globals['_WEBSOCKETMESSAGE']._serialized_start=791
-  _globals['_WEBSOCKETMESSAGE']._serialized_end=1009
-  _globals['_MESSAGE']._serialized_start=1011
-  _globals['_MESSAGE']._serialized_end=1084
-  _globals['_AIDRAFTREADY']._serialized_start=1086
-  _globals['_AIDRAFTREADY']._serialized_end=1208
-  _globals['_DRAFTREVIEW']._serialized_start=1211
-  _globals['_DRAFTREVIEW']._serialized_end=1347
-  _globals['_ERROR']._serialized_start=1349
-  _globals['_ERROR']._serialized_end=1373
-  _globals['_MEDICALQASERVICE']._serialized_start=1862
-  _globals['_MEDICALQASERVICE']._serialized_end=1958
+  _globals['_QUESTIONREQUEST']._serialized_start=91
+  _globals['_QUESTIONREQUEST']._serialized_end=236
+  _globals['_USERCONTEXT']._serialized_start=239
+  _globals['_USERCONTEXT']._serialized_end=382
+  _globals['_USERINFO']._serialized_start=384
+  _globals['_USERINFO']._serialized_end=465
+  _globals['_BIOMETRICDATA']._serialized_start=467
+  _globals['_BIOMETRICDATA']._serialized_end=582
+  _globals['_CHATMESSAGE']._serialized_start=584
+  _globals['_CHATMESSAGE']._serialized_end=690
+  _globals['_QUESTIONRESPONSE']._serialized_start=692
+  _globals['_QUESTIONRESPONSE']._serialized_end=814
+  _globals['_TRIAGEREQUEST']._serialized_start=816
+  _globals['_TRIAGEREQUEST']._serialized_end=890
+  _globals['_TRIAGERESPONSE']._serialized_start=892
+  _globals['_TRIAGERESPONSE']._serialized_end=1019
+  _globals['_BIOMETRICREADING']._serialized_start=1022
+  _globals['_BIOMETRICREADING']._serialized_end=1160
+  _globals['_INGESTBIOMETRICSREQUEST']._serialized_start=1162
+  _globals['_INGESTBIOMETRICSREQUEST']._serialized_end=1268
+  _globals['_REJECTEDREADING']._serialized_start=1270
+  _globals['_REJECTEDREADING']._serialized_end=1318
+  _globals['_INGESTBIOMETRICSRESPONSE']._serialized_start=1320
+  _globals['_INGESTBIOMETRICSRESPONSE']._serialized_end=1428
+  _globals['_PATIENT']._serialized_start=1430
+  _globals['_PATIENT']._serialized_end=1557
+  _globals['_CREATEPATIENTREQUEST']._serialized_start=1559
+  _globals['_CREATEPATIENTREQUEST']._serialized_end=1639
+  _globals['_GETPATIENTREQUEST']._serialized_start=1641
+  _globals['_GETPATIENTREQUEST']._serialized_end=1672
+  _globals['_LISTPATIENTSREQUEST']._serialized_start=1674
+  _globals['_LISTPATIENTSREQUEST']._serialized_end=1734
+  _globals['_LISTPATIENTSRESPONSE']._serialized_start=1736
+  _globals['_LISTPATIENTSRESPONSE']._serialized_end=1819
+  _globals['_UPDATEPATIENTREQUEST']._serialized_start=1822
+  _globals['_UPDATEPATIENTREQUEST']._serialized_end=1972
+  _globals['_DELETEPATIENTREQUEST']._serialized_start=1974
+  _globals['_DELETEPATIENTREQUEST']._serialized_end=2008
+  _globals['_DOCTOR']._serialized_start=2011
+  _globals['_DOCTOR']._serialized_end=2209
+  _globals['_CREATEDOCTORREQUEST']._serialized_start=2211
+  _globals['_CREATEDOCTORREQUEST']._serialized_end=2337
+  _globals['_GETDOCTORREQUEST']._serialized_start=2339
+  _globals['_GETDOCTORREQUEST']._serialized_end=2369
+  _globals['_LISTDOCTORSREQUEST']._serialized_start=2371
+  _globals['_LISTDOCTORSREQUEST']._serialized_end=2453
+  _globals['_LISTDOCTORSRESPONSE']._serialized_start=2455
+  _globals['_LISTDOCTORSRESPONSE']._serialized_end=2535
+  _globals['_SPECIALIZATIONS']._serialized_start=2537
+  _globals['_SPECIALIZATIONS']._serialized_end=2570
+  _globals['_UPDATEDOCTORREQUEST']._serialized_start=2573
+  _globals['_UPDATEDOCTORREQUEST']._serialized_end=2818
+  _globals['_DELETEDOCTORREQUEST']._serialized_start=2820
+  _globals['_DELETEDOCTORREQUEST']._serialized_end=2853
+  _globals['_MEDICALCONDITION']._serialized_start=2856
+  _globals['_MEDICALCONDITION']._serialized_end=3059
+  _globals['_ADDCONDITIONREQUEST']._serialized_start=3062
+  _globals['_ADDCONDITIONREQUEST']._serialized_end=3208
+  _globals['_LISTCONDITIONSREQUEST']._serialized_start=3210
+  _globals['_LISTCONDITIONSREQUEST']._serialized_end=3311
+  _globals['_LISTCONDITIONSRESPONSE']._serialized_start=3313
+  _globals['_LISTCONDITIONSRESPONSE']._serialized_end=3409
+  _globals['_UPDATECONDITIONREQUEST']._serialized_start=3412
+  _globals['_UPDATECONDITIONREQUEST']._serialized_end=3606
+  _globals['_DELETECONDITIONREQUEST']._serialized_start=3608
+  _globals['_DELETECONDITIONREQUEST']._serialized_end=3644
+  _globals['_DELETERESPONSE']._serialized_start=3646
+  _globals['_DELETERESPONSE']._serialized_end=3662
+  _globals['_PROMPTTEMPLATE']._serialized_start=3665
+  _globals['_PROMPTTEMPLATE']._serialized_end=3879
+  _globals['_CREATEPROMPTTEMPLATEREQUEST']._serialized_start=3881
+  _globals['_CREATEPROMPTTEMPLATEREQUEST']._serialized_end=3984
+  _globals['_GETPROMPTTEMPLATEREQUEST']._serialized_start=3986
+  _globals['_GETPROMPTTEMPLATEREQUEST']._serialized_end=4029
+  _globals['_LISTPROMPTTEMPLATESREQUEST']._serialized_start=4031
+  _globals['_LISTPROMPTTEMPLATESREQUEST']._serialized_end=4059
+  _globals['_LISTPROMPTTEMPLATESRESPONSE']._serialized_start=4061
+  _globals['_LISTPROMPTTEMPLATESRESPONSE']._serialized_end=4134
+  _globals['_ACTIVATEPROMPTTEMPLATEREQUEST']._serialized_start=4136
+  _globals['_ACTIVATEPROMPTTEMPLATEREQUEST']._serialized_end=4184
+  _globals['_EXPERIMENTARM']._serialized_start=4186
+  _globals['_EXPERIMENTARM']._serialized_end=4234
+  _globals['_SETPROMPTEXPERIMENTREQUEST']._serialized_start=4236
+  _globals['_SETPROMPTEXPERIMENTREQUEST']._serialized_end=4302
+  _globals['_PROMPTEXPERIMENT']._serialized_start=4304
+  _globals['_PROMPTEXPERIMENT']._serialized_end=4360
+  _globals['_GETPROMPTEXPERIMENTREQUEST']._serialized_start=4362
+  _globals['_GETPROMPTEXPERIMENTREQUEST']._serialized_end=4390
+  _globals['_GETPROMPTTEMPLATESTATSREQUEST']._serialized_start=4392
+  _globals['_GETPROMPTTEMPLATESTATSREQUEST']._serialized_end=4517
+  _globals['_PROMPTTEMPLATESTATS']._serialized_start=4520
+  _globals['_PROMPTTEMPLATESTATS']._serialized_end=4711
+  _globals['_GETPROMPTTEMPLATESTATSRESPONSE']._serialized_start=4713
+  _globals['_GETPROMPTTEMPLATESTATSRESPONSE']._serialized_end=4794
+  _globals['_DRAFTQUALITYREQUEST']._serialized_start=4797
+  _globals['_DRAFTQUALITYREQUEST']._serialized_end=4992
+  _globals['_DRAFTQUALITYSTATS']._serialized_start=4995
+  _globals['_DRAFTQUALITYSTATS']._serialized_end=5416
+  _globals['_DRAFTQUALITYRESPONSE']._serialized_start=5418
+  _globals['_DRAFTQUALITYRESPONSE']._serialized_end=5483
+  _globals['_AUDITEVENT']._serialized_start=5486
+  _globals['_AUDITEVENT']._serialized_end=5804
+  _globals['_AUDITEVENT_DETAILSENTRY']._serialized_start=5758
+  _globals['_AUDITEVENT_DETAILSENTRY']._serialized_end=5804
+  _globals['_LISTAUDITEVENTSREQUEST']._serialized_start=5807
+  _globals['_LISTAUDITEVENTSREQUEST']._serialized_end=6002
+  _globals['_LISTAUDITEVENTSRESPONSE']._serialized_start=6004
+  _globals['_LISTAUDITEVENTSRESPONSE']._serialized_end=6091
+  _globals['_VERIFYAUDITLOGREQUEST']._serialized_start=6093
+  _globals['_VERIFYAUDITLOGREQUEST']._serialized_end=6116
+  _globals['_VERIFYAUDITLOGRESPONSE']._serialized_start=6118
+  _globals['_VERIFYAUDITLOGRESPONSE']._serialized_end=6224
+  _globals['_WEBSOCKETMESSAGE']._serialized_start=6227
+  _globals['_WEBSOCKETMESSAGE']._serialized_end=6892
+  _globals['_MESSAGE']._serialized_start=6895
+  _globals['_MESSAGE']._serialized_end=7028
+  _globals['_AIDRAFTREADY']._serialized_start=7031
+  _globals['_AIDRAFTREADY']._serialized_end=7217
+  _globals['_DRAFTREVIEW']._serialized_start=7220
+  _globals['_DRAFTREVIEW']._serialized_end=7356
+  _globals['_ERROR']._serialized_start=7358
+  _globals['_ERROR']._serialized_end=7382
+  _globals['_SESSIONASSIGNMENT']._serialized_start=7385
+  _globals['_SESSIONASSIGNMENT']._serialized_end=7599
+  _globals['_DOCTORSTATUS']._serialized_start=7601
+  _globals['_DOCTORSTATUS']._serialized_end=7666
+  _globals['_REVIEWESCALATION']._serialized_start=7669
+  _globals['_REVIEWESCALATION']._serialized_end=7931
+  _globals['_SESSIONHANDOFF']._serialized_start=7933
+  _globals['_SESSIONHANDOFF']._serialized_end=7985
+  _globals['_SESSIONEVENT']._serialized_start=7988
+  _globals['_SESSIONEVENT']._serialized_end=8167
+  _globals['_MESSAGEACK']._serialized_start=8169
+  _globals['_MESSAGEACK']._serialized_end=8242
+  _globals['_DELIVERYRECEIPT']._serialized_start=8245
+  _globals['_DELIVERYRECEIPT']._serialized_end=8397
+  _globals['_TYPINGINDICATOR']._serialized_start=8400
+  _globals['_TYPINGINDICATOR']._serialized_end=8538
+  _globals['_PRESENCE']._serialized_start=8541
+  _globals['_PRESENCE']._serialized_end=8697
+  _globals['_VITALALERT']._serialized_start=8700
+  _globals['_VITALALERT']._serialized_end=8919
+  _globals['_MEDICALQASERVICE']._serialized_start=10549
+  _globals['_MEDICALQASERVICE']._serialized_end=10714
+  _globals['_BIOMETRICSERVICE']._serialized_start=10716
+  _globals['_BIOMETRICSERVICE']._serialized_end=10825
+  _globals['_PATIENTSERVICE']._serialized_start=10828
+  _globals['_PATIENTSERVICE']._serialized_end=11196
+  _globals['_DOCTORSERVICE']._serialized_start=11199
+  _globals['_DOCTORSERVICE']._serialized_end=11552
+  _globals['_MEDICALHISTORYSERVICE']._serialized_start=11555
+  _globals['_MEDICALHISTORYSERVICE']._serialized_end=11898
+  _globals['_PROMPTTEMPLATESERVICE']._serialized_start=11901
+  _globals['_PROMPTTEMPLATESERVICE']._serialized_end=12576
+  _globals['_ANALYTICSSERVICE']._serialized_start=12578
+  _globals['_ANALYTICSSERVICE']._serialized_end=12678
+  _globals['_AUDITSERVICE']._serialized_start=12681
+  _globals['_AUDITSERVICE']._serialized_end=12868
 # @@protoc_insertion_point(module_scope)
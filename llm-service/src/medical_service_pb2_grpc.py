@@ -20,6 +20,11 @@ class MedicalQAServiceStub(object):
                 request_serializer=medical__service__pb2.QuestionRequest.SerializeToString,
                 response_deserializer=medical__service__pb2.QuestionResponse.FromString,
                 )
+        self.TriageQuestion = channel.unary_unary(
+                '/backend.MedicalQAService/TriageQuestion',
+                request_serializer=medical__service__pb2.TriageRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.TriageResponse.FromString,
+                )
 
 
 class MedicalQAServiceServicer(object):
@@ -33,6 +38,13 @@ class MedicalQAServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def TriageQuestion(self, request, context):
+        """Classify a question and estimate how urgently it needs a doctor
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_MedicalQAServiceServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -41,6 +53,11 @@ def add_MedicalQAServiceServicer_to_server(servicer, server):
                     request_deserializer=medical__service__pb2.QuestionRequest.FromString,
                     response_serializer=medical__service__pb2.QuestionResponse.SerializeToString,
             ),
+            'TriageQuestion': grpc.unary_unary_rpc_method_handler(
+                    servicer.TriageQuestion,
+                    request_deserializer=medical__service__pb2.TriageRequest.FromString,
+                    response_serializer=medical__service__pb2.TriageResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'backend.MedicalQAService', rpc_method_handlers)
@@ -68,3 +85,1079 @@ class MedicalQAService(object):
             medical__service__pb2.QuestionResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def TriageQuestion(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.MedicalQAService/TriageQuestion',
+            medical__service__pb2.TriageRequest.SerializeToString,
+            medical__service__pb2.TriageResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class BiometricServiceStub(object):
+    """Ingestion of readings from wearables and home devices
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.IngestBiometrics = channel.unary_unary(
+                '/backend.BiometricService/IngestBiometrics',
+                request_serializer=medical__service__pb2.IngestBiometricsRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.IngestBiometricsResponse.FromString,
+                )
+
+
+class BiometricServiceServicer(object):
+    """Ingestion of readings from wearables and home devices
+    """
+
+    def IngestBiometrics(self, request, context):
+        """Store a batch of readings for one patient, skipping ones already stored
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_BiometricServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'IngestBiometrics': grpc.unary_unary_rpc_method_handler(
+                    servicer.IngestBiometrics,
+                    request_deserializer=medical__service__pb2.IngestBiometricsRequest.FromString,
+                    response_serializer=medical__service__pb2.IngestBiometricsResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.BiometricService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class BiometricService(object):
+    """Ingestion of readings from wearables and home devices
+    """
+
+    @staticmethod
+    def IngestBiometrics(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.BiometricService/IngestBiometrics',
+            medical__service__pb2.IngestBiometricsRequest.SerializeToString,
+            medical__service__pb2.IngestBiometricsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class PatientServiceStub(object):
+    """Registration of patients. Deleted patients are kept but no longer listed.
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.CreatePatient = channel.unary_unary(
+                '/backend.PatientService/CreatePatient',
+                request_serializer=medical__service__pb2.CreatePatientRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.Patient.FromString,
+                )
+        self.GetPatient = channel.unary_unary(
+                '/backend.PatientService/GetPatient',
+                request_serializer=medical__service__pb2.GetPatientRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.Patient.FromString,
+                )
+        self.ListPatients = channel.unary_unary(
+                '/backend.PatientService/ListPatients',
+                request_serializer=medical__service__pb2.ListPatientsRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.ListPatientsResponse.FromString,
+                )
+        self.UpdatePatient = channel.unary_unary(
+                '/backend.PatientService/UpdatePatient',
+                request_serializer=medical__service__pb2.UpdatePatientRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.Patient.FromString,
+                )
+        self.DeletePatient = channel.unary_unary(
+                '/backend.PatientService/DeletePatient',
+                request_serializer=medical__service__pb2.DeletePatientRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.DeleteResponse.FromString,
+                )
+
+
+class PatientServiceServicer(object):
+    """Registration of patients. Deleted patients are kept but no longer listed.
+    """
+
+    def CreatePatient(self, request, context):
+        """Register a patient, reusing the user with the same email if they are not a patient yet
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPatient(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListPatients(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdatePatient(self, request, context):
+        """Change the fields that are set, leaving the others as they are
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeletePatient(self, request, context):
+        """Soft delete a patient and their medical history
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_PatientServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'CreatePatient': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreatePatient,
+                    request_deserializer=medical__service__pb2.CreatePatientRequest.FromString,
+                    response_serializer=medical__service__pb2.Patient.SerializeToString,
+            ),
+            'GetPatient': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPatient,
+                    request_deserializer=medical__service__pb2.GetPatientRequest.FromString,
+                    response_serializer=medical__service__pb2.Patient.SerializeToString,
+            ),
+            'ListPatients': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListPatients,
+                    request_deserializer=medical__service__pb2.ListPatientsRequest.FromString,
+                    response_serializer=medical__service__pb2.ListPatientsResponse.SerializeToString,
+            ),
+            'UpdatePatient': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdatePatient,
+                    request_deserializer=medical__service__pb2.UpdatePatientRequest.FromString,
+                    response_serializer=medical__service__pb2.Patient.SerializeToString,
+            ),
+            'DeletePatient': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeletePatient,
+                    request_deserializer=medical__service__pb2.DeletePatientRequest.FromString,
+                    response_serializer=medical__service__pb2.DeleteResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.PatientService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class PatientService(object):
+    """Registration of patients. Deleted patients are kept but no longer listed.
+    """
+
+    @staticmethod
+    def CreatePatient(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PatientService/CreatePatient',
+            medical__service__pb2.CreatePatientRequest.SerializeToString,
+            medical__service__pb2.Patient.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPatient(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PatientService/GetPatient',
+            medical__service__pb2.GetPatientRequest.SerializeToString,
+            medical__service__pb2.Patient.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListPatients(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PatientService/ListPatients',
+            medical__service__pb2.ListPatientsRequest.SerializeToString,
+            medical__service__pb2.ListPatientsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdatePatient(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PatientService/UpdatePatient',
+            medical__service__pb2.UpdatePatientRequest.SerializeToString,
+            medical__service__pb2.Patient.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeletePatient(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PatientService/DeletePatient',
+            medical__service__pb2.DeletePatientRequest.SerializeToString,
+            medical__service__pb2.DeleteResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class DoctorServiceStub(object):
+    """Registration of doctors. Deleted doctors are kept but can no longer sign in.
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.CreateDoctor = channel.unary_unary(
+                '/backend.DoctorService/CreateDoctor',
+                request_serializer=medical__service__pb2.CreateDoctorRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.Doctor.FromString,
+                )
+        self.GetDoctor = channel.unary_unary(
+                '/backend.DoctorService/GetDoctor',
+                request_serializer=medical__service__pb2.GetDoctorRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.Doctor.FromString,
+                )
+        self.ListDoctors = channel.unary_unary(
+                '/backend.DoctorService/ListDoctors',
+                request_serializer=medical__service__pb2.ListDoctorsRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.ListDoctorsResponse.FromString,
+                )
+        self.UpdateDoctor = channel.unary_unary(
+                '/backend.DoctorService/UpdateDoctor',
+                request_serializer=medical__service__pb2.UpdateDoctorRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.Doctor.FromString,
+                )
+        self.DeleteDoctor = channel.unary_unary(
+                '/backend.DoctorService/DeleteDoctor',
+                request_serializer=medical__service__pb2.DeleteDoctorRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.DeleteResponse.FromString,
+                )
+
+
+class DoctorServiceServicer(object):
+    """Registration of doctors. Deleted doctors are kept but can no longer sign in.
+    """
+
+    def CreateDoctor(self, request, context):
+        """Register a doctor, reusing the user with the same email if they are not a doctor yet
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetDoctor(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListDoctors(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateDoctor(self, request, context):
+        """Change the fields that are set, leaving the others as they are
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteDoctor(self, request, context):
+        """Soft delete a doctor
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_DoctorServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'CreateDoctor': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateDoctor,
+                    request_deserializer=medical__service__pb2.CreateDoctorRequest.FromString,
+                    response_serializer=medical__service__pb2.Doctor.SerializeToString,
+            ),
+            'GetDoctor': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetDoctor,
+                    request_deserializer=medical__service__pb2.GetDoctorRequest.FromString,
+                    response_serializer=medical__service__pb2.Doctor.SerializeToString,
+            ),
+            'ListDoctors': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListDoctors,
+                    request_deserializer=medical__service__pb2.ListDoctorsRequest.FromString,
+                    response_serializer=medical__service__pb2.ListDoctorsResponse.SerializeToString,
+            ),
+            'UpdateDoctor': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateDoctor,
+                    request_deserializer=medical__service__pb2.UpdateDoctorRequest.FromString,
+                    response_serializer=medical__service__pb2.Doctor.SerializeToString,
+            ),
+            'DeleteDoctor': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteDoctor,
+                    request_deserializer=medical__service__pb2.DeleteDoctorRequest.FromString,
+                    response_serializer=medical__service__pb2.DeleteResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.DoctorService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class DoctorService(object):
+    """Registration of doctors. Deleted doctors are kept but can no longer sign in.
+    """
+
+    @staticmethod
+    def CreateDoctor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.DoctorService/CreateDoctor',
+            medical__service__pb2.CreateDoctorRequest.SerializeToString,
+            medical__service__pb2.Doctor.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetDoctor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.DoctorService/GetDoctor',
+            medical__service__pb2.GetDoctorRequest.SerializeToString,
+            medical__service__pb2.Doctor.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListDoctors(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.DoctorService/ListDoctors',
+            medical__service__pb2.ListDoctorsRequest.SerializeToString,
+            medical__service__pb2.ListDoctorsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateDoctor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.DoctorService/UpdateDoctor',
+            medical__service__pb2.UpdateDoctorRequest.SerializeToString,
+            medical__service__pb2.Doctor.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteDoctor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.DoctorService/DeleteDoctor',
+            medical__service__pb2.DeleteDoctorRequest.SerializeToString,
+            medical__service__pb2.DeleteResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class MedicalHistoryServiceStub(object):
+    """Conditions in patients' medical history
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.AddCondition = channel.unary_unary(
+                '/backend.MedicalHistoryService/AddCondition',
+                request_serializer=medical__service__pb2.AddConditionRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.MedicalCondition.FromString,
+                )
+        self.ListConditions = channel.unary_unary(
+                '/backend.MedicalHistoryService/ListConditions',
+                request_serializer=medical__service__pb2.ListConditionsRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.ListConditionsResponse.FromString,
+                )
+        self.UpdateCondition = channel.unary_unary(
+                '/backend.MedicalHistoryService/UpdateCondition',
+                request_serializer=medical__service__pb2.UpdateConditionRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.MedicalCondition.FromString,
+                )
+        self.DeleteCondition = channel.unary_unary(
+                '/backend.MedicalHistoryService/DeleteCondition',
+                request_serializer=medical__service__pb2.DeleteConditionRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.DeleteResponse.FromString,
+                )
+
+
+class MedicalHistoryServiceServicer(object):
+    """Conditions in patients' medical history
+    """
+
+    def AddCondition(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListConditions(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpdateCondition(self, request, context):
+        """Change the fields that are set, leaving the others as they are
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteCondition(self, request, context):
+        """Soft delete a condition
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_MedicalHistoryServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'AddCondition': grpc.unary_unary_rpc_method_handler(
+                    servicer.AddCondition,
+                    request_deserializer=medical__service__pb2.AddConditionRequest.FromString,
+                    response_serializer=medical__service__pb2.MedicalCondition.SerializeToString,
+            ),
+            'ListConditions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListConditions,
+                    request_deserializer=medical__service__pb2.ListConditionsRequest.FromString,
+                    response_serializer=medical__service__pb2.ListConditionsResponse.SerializeToString,
+            ),
+            'UpdateCondition': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpdateCondition,
+                    request_deserializer=medical__service__pb2.UpdateConditionRequest.FromString,
+                    response_serializer=medical__service__pb2.MedicalCondition.SerializeToString,
+            ),
+            'DeleteCondition': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteCondition,
+                    request_deserializer=medical__service__pb2.DeleteConditionRequest.FromString,
+                    response_serializer=medical__service__pb2.DeleteResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.MedicalHistoryService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class MedicalHistoryService(object):
+    """Conditions in patients' medical history
+    """
+
+    @staticmethod
+    def AddCondition(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.MedicalHistoryService/AddCondition',
+            medical__service__pb2.AddConditionRequest.SerializeToString,
+            medical__service__pb2.MedicalCondition.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListConditions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.MedicalHistoryService/ListConditions',
+            medical__service__pb2.ListConditionsRequest.SerializeToString,
+            medical__service__pb2.ListConditionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpdateCondition(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.MedicalHistoryService/UpdateCondition',
+            medical__service__pb2.UpdateConditionRequest.SerializeToString,
+            medical__service__pb2.MedicalCondition.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def DeleteCondition(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.MedicalHistoryService/DeleteCondition',
+            medical__service__pb2.DeleteConditionRequest.SerializeToString,
+            medical__service__pb2.DeleteResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class PromptTemplateServiceStub(object):
+    """Versions of the prompt template drafts are generated with. Templates are
+    never changed, a new version is created instead.
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.CreatePromptTemplate = channel.unary_unary(
+                '/backend.PromptTemplateService/CreatePromptTemplate',
+                request_serializer=medical__service__pb2.CreatePromptTemplateRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.PromptTemplate.FromString,
+                )
+        self.GetPromptTemplate = channel.unary_unary(
+                '/backend.PromptTemplateService/GetPromptTemplate',
+                request_serializer=medical__service__pb2.GetPromptTemplateRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.PromptTemplate.FromString,
+                )
+        self.ListPromptTemplates = channel.unary_unary(
+                '/backend.PromptTemplateService/ListPromptTemplates',
+                request_serializer=medical__service__pb2.ListPromptTemplatesRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.ListPromptTemplatesResponse.FromString,
+                )
+        self.ActivatePromptTemplate = channel.unary_unary(
+                '/backend.PromptTemplateService/ActivatePromptTemplate',
+                request_serializer=medical__service__pb2.ActivatePromptTemplateRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.PromptTemplate.FromString,
+                )
+        self.SetPromptExperiment = channel.unary_unary(
+                '/backend.PromptTemplateService/SetPromptExperiment',
+                request_serializer=medical__service__pb2.SetPromptExperimentRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.PromptExperiment.FromString,
+                )
+        self.GetPromptExperiment = channel.unary_unary(
+                '/backend.PromptTemplateService/GetPromptExperiment',
+                request_serializer=medical__service__pb2.GetPromptExperimentRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.PromptExperiment.FromString,
+                )
+        self.GetPromptTemplateStats = channel.unary_unary(
+                '/backend.PromptTemplateService/GetPromptTemplateStats',
+                request_serializer=medical__service__pb2.GetPromptTemplateStatsRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.GetPromptTemplateStatsResponse.FromString,
+                )
+
+
+class PromptTemplateServiceServicer(object):
+    """Versions of the prompt template drafts are generated with. Templates are
+    never changed, a new version is created instead.
+    """
+
+    def CreatePromptTemplate(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPromptTemplate(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListPromptTemplates(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ActivatePromptTemplate(self, request, context):
+        """Make a version the one used outside experiments, deactivating the others
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SetPromptExperiment(self, request, context):
+        """Split sessions across versions by weight, replacing the running experiment. No arms stops it.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPromptExperiment(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetPromptTemplateStats(self, request, context):
+        """Review outcomes of the drafts generated with each version
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_PromptTemplateServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'CreatePromptTemplate': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreatePromptTemplate,
+                    request_deserializer=medical__service__pb2.CreatePromptTemplateRequest.FromString,
+                    response_serializer=medical__service__pb2.PromptTemplate.SerializeToString,
+            ),
+            'GetPromptTemplate': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPromptTemplate,
+                    request_deserializer=medical__service__pb2.GetPromptTemplateRequest.FromString,
+                    response_serializer=medical__service__pb2.PromptTemplate.SerializeToString,
+            ),
+            'ListPromptTemplates': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListPromptTemplates,
+                    request_deserializer=medical__service__pb2.ListPromptTemplatesRequest.FromString,
+                    response_serializer=medical__service__pb2.ListPromptTemplatesResponse.SerializeToString,
+            ),
+            'ActivatePromptTemplate': grpc.unary_unary_rpc_method_handler(
+                    servicer.ActivatePromptTemplate,
+                    request_deserializer=medical__service__pb2.ActivatePromptTemplateRequest.FromString,
+                    response_serializer=medical__service__pb2.PromptTemplate.SerializeToString,
+            ),
+            'SetPromptExperiment': grpc.unary_unary_rpc_method_handler(
+                    servicer.SetPromptExperiment,
+                    request_deserializer=medical__service__pb2.SetPromptExperimentRequest.FromString,
+                    response_serializer=medical__service__pb2.PromptExperiment.SerializeToString,
+            ),
+            'GetPromptExperiment': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPromptExperiment,
+                    request_deserializer=medical__service__pb2.GetPromptExperimentRequest.FromString,
+                    response_serializer=medical__service__pb2.PromptExperiment.SerializeToString,
+            ),
+            'GetPromptTemplateStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetPromptTemplateStats,
+                    request_deserializer=medical__service__pb2.GetPromptTemplateStatsRequest.FromString,
+                    response_serializer=medical__service__pb2.GetPromptTemplateStatsResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.PromptTemplateService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class PromptTemplateService(object):
+    """Versions of the prompt template drafts are generated with. Templates are
+    never changed, a new version is created instead.
+    """
+
+    @staticmethod
+    def CreatePromptTemplate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/CreatePromptTemplate',
+            medical__service__pb2.CreatePromptTemplateRequest.SerializeToString,
+            medical__service__pb2.PromptTemplate.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPromptTemplate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/GetPromptTemplate',
+            medical__service__pb2.GetPromptTemplateRequest.SerializeToString,
+            medical__service__pb2.PromptTemplate.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListPromptTemplates(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/ListPromptTemplates',
+            medical__service__pb2.ListPromptTemplatesRequest.SerializeToString,
+            medical__service__pb2.ListPromptTemplatesResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ActivatePromptTemplate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/ActivatePromptTemplate',
+            medical__service__pb2.ActivatePromptTemplateRequest.SerializeToString,
+            medical__service__pb2.PromptTemplate.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SetPromptExperiment(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/SetPromptExperiment',
+            medical__service__pb2.SetPromptExperimentRequest.SerializeToString,
+            medical__service__pb2.PromptExperiment.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPromptExperiment(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/GetPromptExperiment',
+            medical__service__pb2.GetPromptExperimentRequest.SerializeToString,
+            medical__service__pb2.PromptExperiment.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetPromptTemplateStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.PromptTemplateService/GetPromptTemplateStats',
+            medical__service__pb2.GetPromptTemplateStatsRequest.SerializeToString,
+            medical__service__pb2.GetPromptTemplateStatsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class AnalyticsServiceStub(object):
+    """Quality of AI drafts as judged by the doctors reviewing them
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.GetDraftQuality = channel.unary_unary(
+                '/backend.AnalyticsService/GetDraftQuality',
+                request_serializer=medical__service__pb2.DraftQualityRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.DraftQualityResponse.FromString,
+                )
+
+
+class AnalyticsServiceServicer(object):
+    """Quality of AI drafts as judged by the doctors reviewing them
+    """
+
+    def GetDraftQuality(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_AnalyticsServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'GetDraftQuality': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetDraftQuality,
+                    request_deserializer=medical__service__pb2.DraftQualityRequest.FromString,
+                    response_serializer=medical__service__pb2.DraftQualityResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.AnalyticsService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class AnalyticsService(object):
+    """Quality of AI drafts as judged by the doctors reviewing them
+    """
+
+    @staticmethod
+    def GetDraftQuality(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.AnalyticsService/GetDraftQuality',
+            medical__service__pb2.DraftQualityRequest.SerializeToString,
+            medical__service__pb2.DraftQualityResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+
+class AuditServiceStub(object):
+    """Access accounting over the append-only, hash-chained audit log
+    """
+
+    def __init__(self, channel):
+        """Constructor.
+
+        Args:
+            channel: A grpc.Channel.
+        """
+        self.ListAuditEvents = channel.unary_unary(
+                '/backend.AuditService/ListAuditEvents',
+                request_serializer=medical__service__pb2.ListAuditEventsRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.ListAuditEventsResponse.FromString,
+                )
+        self.VerifyAuditLog = channel.unary_unary(
+                '/backend.AuditService/VerifyAuditLog',
+                request_serializer=medical__service__pb2.VerifyAuditLogRequest.SerializeToString,
+                response_deserializer=medical__service__pb2.VerifyAuditLogResponse.FromString,
+                )
+
+
+class AuditServiceServicer(object):
+    """Access accounting over the append-only, hash-chained audit log
+    """
+
+    def ListAuditEvents(self, request, context):
+        """Events of a patient or an actor, oldest first
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def VerifyAuditLog(self, request, context):
+        """Recompute the hash chain and report the first event that breaks it
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_AuditServiceServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'ListAuditEvents': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListAuditEvents,
+                    request_deserializer=medical__service__pb2.ListAuditEventsRequest.FromString,
+                    response_serializer=medical__service__pb2.ListAuditEventsResponse.SerializeToString,
+            ),
+            'VerifyAuditLog': grpc.unary_unary_rpc_method_handler(
+                    servicer.VerifyAuditLog,
+                    request_deserializer=medical__service__pb2.VerifyAuditLogRequest.FromString,
+                    response_serializer=medical__service__pb2.VerifyAuditLogResponse.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'backend.AuditService', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+
+
+ # This class is part of an EXPERIMENTAL API.
+class AuditService(object):
+    """Access accounting over the append-only, hash-chained audit log
+    """
+
+    @staticmethod
+    def ListAuditEvents(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.AuditService/ListAuditEvents',
+            medical__service__pb2.ListAuditEventsRequest.SerializeToString,
+            medical__service__pb2.ListAuditEventsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def VerifyAuditLog(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/backend.AuditService/VerifyAuditLog',
+            medical__service__pb2.VerifyAuditLogRequest.SerializeToString,
+            medical__service__pb2.VerifyAuditLogResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -9,6 +9,12 @@ from . import medical_service_pb2
 from . import medical_service_pb2_grpc
 from .services.llm_service import LLMService
 
+URGENCY_LEVELS = {
+    "routine": medical_service_pb2.URGENCY_ROUTINE,
+    "urgent": medical_service_pb2.URGENCY_URGENT,
+    "emergency": medical_service_pb2.URGENCY_EMERGENCY,
+}
+
 class MedicalQAService(medical_service_pb2_grpc.MedicalQAServiceServicer):
     def __init__(self, config_path: str = None):
         # Add debug logging for .env file
@@ -64,7 +70,8 @@ class MedicalQAService(medical_service_pb2_grpc.MedicalQAServiceServicer):
 
             answer, confidence_score, references = await self.llm_service.generate_answer(
                 request.question_text,
-                request.user_context
+                request.user_context,
+                request.prompt_template
             )
 
             self.logger.info(f"Generated answer for question: {request.question_id}")
@@ -90,6 +97,24 @@ class MedicalQAService(medical_service_pb2_grpc.MedicalQAServiceServicer):
                 confidence_score=0.0
             )
 
+    async def TriageQuestion(self, request, context):
+        try:
+            category = await self.llm_service.triage_question(request.question_text)
+            urgency, reasons = await self.llm_service.assess_urgency(request.question_text)
+
+            return medical_service_pb2.TriageResponse(
+                question_id=request.question_id,
+                category=category,
+                urgency=URGENCY_LEVELS.get(urgency, medical_service_pb2.URGENCY_UNSPECIFIED),
+                reasons=list(reasons)
+            )
+
+        except Exception as e:
+            self.logger.error(f"Error triaging question {request.question_id}: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(f'Error triaging question: {str(e)}')
+            return medical_service_pb2.TriageResponse(question_id=request.question_id)
+
 def serve():
     # Load config for server
     config_path = os.path.join(os.path.dirname(__file__), '../config/config.yaml')
@@ -3,7 +3,7 @@ from typing import Dict, Tuple
 import json
 from ..utils.prompt_builder import PromptBuilder
 from ..medical_service_pb2 import UserContext
-from ..utils.llm_functions import category_function
+from ..utils.llm_functions import category_function, urgency_function
 class LLMService:
     def __init__(self, config: Dict):
         """
@@ -44,15 +44,43 @@ class LLMService:
             self.logger.error(f"Error processing question {question}: {str(e)}")
             return "taxonomy"
 
+    async def assess_urgency(self, question: str) -> Tuple[str, list]:
+        """
+        Estimate how urgently the question needs a doctor's attention.
+        Args:
+            question: The question text
+        Returns:
+            Tuple[str, list]: (urgency, reasons)
+        """
+        try:
+            messages = [
+                {"role": "system", "content": "You are a PACE center triage nurse. Assess how urgently a doctor needs to see each member message."},
+                {"role": "user", "content": question}
+            ]
+
+            response = await self.client.chat.completions.create(
+                model=self.config['model'],
+                messages=messages,
+                functions=[urgency_function],
+                function_call={"name": "assess_urgency"}
+            )
+
+            result = json.loads(response.choices[0].message.function_call.arguments)
+            return result['urgency'], list(result.get('reasons', []))
+
+        except Exception as e:
+            # The backend falls back to its own rules when urgency is unknown
+            return "", []
 
 
     # TODO: Add a function to generate a response based on the category
-    async def generate_answer(self, question: str, user_context: UserContext) -> Tuple[str, float, list]:
+    async def generate_answer(self, question: str, user_context: UserContext, prompt_template: str = "") -> Tuple[str, float, list]:
         """
         Generate an answer using the OpenAI API
         Args:
             question: The question text
             user_context: UserContext protobuf message containing patient information
+            prompt_template: Instructions of the session's prompt template version
         Returns: 
             Tuple[str, float, list]: (answer, confidence_score, references)
         """
@@ -60,7 +88,7 @@ class LLMService:
             messages = [
                 {
                     "role": "system",
-                    "content": self.prompt_builder.build_system_prompt(user_context)
+                    "content": self.prompt_builder.build_system_prompt(user_context, prompt_template)
                 },
                 {
                     "role": "user",
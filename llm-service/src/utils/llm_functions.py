@@ -23,4 +23,30 @@ category_function = {
         },
         "required": ["category"]
     }
-}
\ No newline at end of file
+}
+
+class UrgencyLevel(Enum):
+    ROUTINE = "routine"
+    URGENT = "urgent"
+    EMERGENCY = "emergency"
+
+urgency_function = {
+    "name": "assess_urgency",
+    "description": "Estimate how urgently a doctor needs to see the patient's message",
+    "parameters": {
+        "type": "object",
+        "properties": {
+            "urgency": {
+                "type": "string",
+                "enum": [level.value for level in UrgencyLevel],
+                "description": "emergency for possible life threats (chest pain, stroke signs, suicidal thoughts, trouble breathing), urgent for problems that need a same-day answer, routine otherwise"
+            },
+            "reasons": {
+                "type": "array",
+                "items": {"type": "string"},
+                "description": "Short clinical reasons for the urgency, without quoting the patient"
+            }
+        },
+        "required": ["urgency", "reasons"]
+    }
+}
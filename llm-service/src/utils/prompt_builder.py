@@ -4,13 +4,21 @@ from google.protobuf.timestamp_pb2 import Timestamp
 from ..medical_service_pb2 import UserContext, BiometricData, ChatMessage
 
 
+DEFAULT_INSTRUCTIONS = "You are a medical AI assistant helping medical professionals such as doctors, nurses, and caregiving professionals to draft answers for medical and caregiving related questions. Please provide accurate medical information based on the following patient context:"
+
+
 class PromptBuilder:
     @staticmethod
-    def build_system_prompt(user_context: UserContext) -> str:
+    def build_system_prompt(user_context: UserContext, template: str = "") -> str:
         """
         Build system prompt from UserContext protobuf message
+        Args:
+            user_context: UserContext protobuf message
+            template: Instructions of the prompt template version picked by the
+                backend, the default instructions when empty
         """
-        return f"""You are a medical AI assistant helping medical professionals such as doctors, nurses, and caregiving professionals to draft answers for medical and caregiving related questions. Please provide accurate medical information based on the following patient context:
+        instructions = template.strip() or DEFAULT_INSTRUCTIONS
+        return f"""{instructions}
 
 Patient Information:
 - Age: {user_context.user_info.age}